@@ -90,6 +90,7 @@ const App = () => {
         endTime: end,
         date,
         userId: localStorage.getItem("userId") ?? "",
+        timeZone: Intl.DateTimeFormat().resolvedOptions().timeZone,
       });
 
       localStorage.setItem("userId", response.userId);
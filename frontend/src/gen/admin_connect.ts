@@ -0,0 +1,279 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file admin.proto (package admin, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { AddDenylistEntryRequest, CancelAppointmentRequest, CountLateCancellationsRequest, CountLateCancellationsResponse, CreateAppointmentTypeRequest, CreateWebhookRequest, CreateWebhookResponse, DeleteAppointmentTypeRequest, DeleteAppointmentTypeResponse, DeleteWebhookRequest, DeleteWebhookResponse, DenylistEntry, EraseUserRequest, EraseUserResponse, ExportUserDataRequest, ExportUserDataResponse, GetAppointmentAsOfRequest, GetScheduleRequest, LegalHold, ListAppointmentsRequest, ListAppointmentsResponse, ListAuditEventsRequest, ListAuditEventsResponse, ListDenylistEntriesRequest, ListDenylistEntriesResponse, ListLegalHoldsRequest, ListLegalHoldsResponse, ListOutboxEventsRequest, ListOutboxEventsResponse, ListOutboxSinksRequest, ListOutboxSinksResponse, ListWebhookDeliveriesRequest, ListWebhookDeliveriesResponse, ListWebhooksRequest, ListWebhooksResponse, OutboxSink, PurgeDeletedAppointmentsRequest, PurgeDeletedAppointmentsResponse, RedeliverWebhookRequest, RemoveDenylistEntryRequest, RemoveDenylistEntryResponse, ReplayOutboxEventsRequest, Schedule, SetLegalHoldRequest, SkipOutboxEventRequest, UpdateAppointmentTypeRequest, UpdateScheduleRequest, UpdateWebhookRequest, VerifyAuditLogRequest, VerifyAuditLogResponse, Webhook, WebhookDelivery } from "./admin_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+import { Appointment, AppointmentType } from "./appointment_pb.js";
+
+/**
+ * @generated from service admin.AdminService
+ */
+export const AdminService = {
+  typeName: "admin.AdminService",
+  methods: {
+    /**
+     * @generated from rpc admin.AdminService.AddDenylistEntry
+     */
+    addDenylistEntry: {
+      name: "AddDenylistEntry",
+      I: AddDenylistEntryRequest,
+      O: DenylistEntry,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.RemoveDenylistEntry
+     */
+    removeDenylistEntry: {
+      name: "RemoveDenylistEntry",
+      I: RemoveDenylistEntryRequest,
+      O: RemoveDenylistEntryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ListDenylistEntries
+     */
+    listDenylistEntries: {
+      name: "ListDenylistEntries",
+      I: ListDenylistEntriesRequest,
+      O: ListDenylistEntriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ListAppointments
+     */
+    listAppointments: {
+      name: "ListAppointments",
+      I: ListAppointmentsRequest,
+      O: ListAppointmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.CreateWebhook
+     */
+    createWebhook: {
+      name: "CreateWebhook",
+      I: CreateWebhookRequest,
+      O: CreateWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ListWebhooks
+     */
+    listWebhooks: {
+      name: "ListWebhooks",
+      I: ListWebhooksRequest,
+      O: ListWebhooksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.UpdateWebhook
+     */
+    updateWebhook: {
+      name: "UpdateWebhook",
+      I: UpdateWebhookRequest,
+      O: Webhook,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.DeleteWebhook
+     */
+    deleteWebhook: {
+      name: "DeleteWebhook",
+      I: DeleteWebhookRequest,
+      O: DeleteWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ListWebhookDeliveries
+     */
+    listWebhookDeliveries: {
+      name: "ListWebhookDeliveries",
+      I: ListWebhookDeliveriesRequest,
+      O: ListWebhookDeliveriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.RedeliverWebhook
+     */
+    redeliverWebhook: {
+      name: "RedeliverWebhook",
+      I: RedeliverWebhookRequest,
+      O: WebhookDelivery,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ListOutboxSinks
+     */
+    listOutboxSinks: {
+      name: "ListOutboxSinks",
+      I: ListOutboxSinksRequest,
+      O: ListOutboxSinksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ListOutboxEvents
+     */
+    listOutboxEvents: {
+      name: "ListOutboxEvents",
+      I: ListOutboxEventsRequest,
+      O: ListOutboxEventsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ReplayOutboxEvents
+     */
+    replayOutboxEvents: {
+      name: "ReplayOutboxEvents",
+      I: ReplayOutboxEventsRequest,
+      O: OutboxSink,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.SkipOutboxEvent
+     */
+    skipOutboxEvent: {
+      name: "SkipOutboxEvent",
+      I: SkipOutboxEventRequest,
+      O: OutboxSink,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ListAuditEvents
+     */
+    listAuditEvents: {
+      name: "ListAuditEvents",
+      I: ListAuditEventsRequest,
+      O: ListAuditEventsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.VerifyAuditLog
+     */
+    verifyAuditLog: {
+      name: "VerifyAuditLog",
+      I: VerifyAuditLogRequest,
+      O: VerifyAuditLogResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.GetAppointmentAsOf
+     */
+    getAppointmentAsOf: {
+      name: "GetAppointmentAsOf",
+      I: GetAppointmentAsOfRequest,
+      O: Appointment,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.PurgeDeletedAppointments
+     */
+    purgeDeletedAppointments: {
+      name: "PurgeDeletedAppointments",
+      I: PurgeDeletedAppointmentsRequest,
+      O: PurgeDeletedAppointmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.SetLegalHold
+     */
+    setLegalHold: {
+      name: "SetLegalHold",
+      I: SetLegalHoldRequest,
+      O: LegalHold,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ListLegalHolds
+     */
+    listLegalHolds: {
+      name: "ListLegalHolds",
+      I: ListLegalHoldsRequest,
+      O: ListLegalHoldsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.ExportUserData
+     */
+    exportUserData: {
+      name: "ExportUserData",
+      I: ExportUserDataRequest,
+      O: ExportUserDataResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.EraseUser
+     */
+    eraseUser: {
+      name: "EraseUser",
+      I: EraseUserRequest,
+      O: EraseUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.GetSchedule
+     */
+    getSchedule: {
+      name: "GetSchedule",
+      I: GetScheduleRequest,
+      O: Schedule,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.UpdateSchedule
+     */
+    updateSchedule: {
+      name: "UpdateSchedule",
+      I: UpdateScheduleRequest,
+      O: Schedule,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.CreateAppointmentType
+     */
+    createAppointmentType: {
+      name: "CreateAppointmentType",
+      I: CreateAppointmentTypeRequest,
+      O: AppointmentType,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.UpdateAppointmentType
+     */
+    updateAppointmentType: {
+      name: "UpdateAppointmentType",
+      I: UpdateAppointmentTypeRequest,
+      O: AppointmentType,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.DeleteAppointmentType
+     */
+    deleteAppointmentType: {
+      name: "DeleteAppointmentType",
+      I: DeleteAppointmentTypeRequest,
+      O: DeleteAppointmentTypeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.CancelAppointment
+     */
+    cancelAppointment: {
+      name: "CancelAppointment",
+      I: CancelAppointmentRequest,
+      O: Appointment,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc admin.AdminService.CountLateCancellations
+     */
+    countLateCancellations: {
+      name: "CountLateCancellations",
+      I: CountLateCancellationsRequest,
+      O: CountLateCancellationsResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,1986 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file admin.proto (package admin, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Duration, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Appointment, AppointmentSchema, AppointmentType, AppointmentTypeSchema, CancellationReason } from "./appointment_pb";
+import { file_appointment } from "./appointment_pb";
+import type { User } from "./user_pb";
+import { file_user } from "./user_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file admin.proto.
+ */
+export const file_admin: GenFile = /*@__PURE__*/
+  fileDesc("CgthZG1pbi5wcm90bxIFYWRtaW4ijQEKDURlbnlsaXN0RW50cnkSCgoCaWQYASABKAkSIQoEa2luZBgCIAEoDjITLmFkbWluLkRlbnlsaXN0S2luZBINCgV2YWx1ZRgDIAEoCRIOCgZyZWFzb24YBCABKAkSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiWwoXQWRkRGVueWxpc3RFbnRyeVJlcXVlc3QSIQoEa2luZBgBIAEoDjITLmFkbWluLkRlbnlsaXN0S2luZBINCgV2YWx1ZRgCIAEoCRIOCgZyZWFzb24YAyABKAkiKAoaUmVtb3ZlRGVueWxpc3RFbnRyeVJlcXVlc3QSCgoCaWQYASABKAkiLgobUmVtb3ZlRGVueWxpc3RFbnRyeVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiHAoaTGlzdERlbnlsaXN0RW50cmllc1JlcXVlc3QiRAobTGlzdERlbnlsaXN0RW50cmllc1Jlc3BvbnNlEiUKB2VudHJpZXMYASADKAsyFC5hZG1pbi5EZW55bGlzdEVudHJ5ImIKF0xpc3RBcHBvaW50bWVudHNSZXF1ZXN0Eg4KBmZpbHRlchgBIAEoCRIQCghvcmRlcl9ieRgCIAEoCRIRCglwYWdlX3NpemUYAyABKAUSEgoKcGFnZV90b2tlbhgEIAEoCSJ3ChhMaXN0QXBwb2ludG1lbnRzUmVzcG9uc2USLgoMYXBwb2ludG1lbnRzGAEgAygLMhguYXBwb2ludG1lbnQuQXBwb2ludG1lbnQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUixwEKB1dlYmhvb2sSCgoCaWQYASABKAkSCwoDdXJsGAIgASgJEhMKC2V2ZW50X3R5cGVzGAMgAygJEg8KB2VuYWJsZWQYBCABKAgSHAoUY29uc2VjdXRpdmVfZmFpbHVyZXMYBSABKAUSLwoLZGlzYWJsZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkgKFENyZWF0ZVdlYmhvb2tSZXF1ZXN0EgsKA3VybBgBIAEoCRITCgtldmVudF90eXBlcxgCIAMoCRIOCgZzZWNyZXQYAyABKAkiSAoVQ3JlYXRlV2ViaG9va1Jlc3BvbnNlEh8KB3dlYmhvb2sYASABKAsyDi5hZG1pbi5XZWJob29rEg4KBnNlY3JldBgCIAEoCSIVChNMaXN0V2ViaG9va3NSZXF1ZXN0IjgKFExpc3RXZWJob29rc1Jlc3BvbnNlEiAKCHdlYmhvb2tzGAEgAygLMg4uYWRtaW4uV2ViaG9vayJVChRVcGRhdGVXZWJob29rUmVxdWVzdBIKCgJpZBgBIAEoCRILCgN1cmwYAiABKAkSEwoLZXZlbnRfdHlwZXMYAyADKAkSDwoHZW5hYmxlZBgEIAEoCCIiChREZWxldGVXZWJob29rUmVxdWVzdBIKCgJpZBgBIAEoCSIoChVEZWxldGVXZWJob29rUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCLZAgoPV2ViaG9va0RlbGl2ZXJ5EgoKAmlkGAEgASgJEhIKCndlYmhvb2tfaWQYAiABKAkSEAoIZXZlbnRfaWQYAyABKAkSEgoKZXZlbnRfdHlwZRgEIAEoCRIsCgZzdGF0dXMYBSABKA4yHC5hZG1pbi5XZWJob29rRGVsaXZlcnlTdGF0dXMSEAoIYXR0ZW1wdHMYBiABKAUSFQoNcmVzcG9uc2VfY29kZRgHIAEoBRISCgpsYXN0X2Vycm9yGAggASgJEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKD25leHRfYXR0ZW1wdF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMZGVsaXZlcmVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJZChxMaXN0V2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0EhIKCndlYmhvb2tfaWQYASABKAkSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiZAodTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVzcG9uc2USKgoKZGVsaXZlcmllcxgBIAMoCzIWLmFkbWluLldlYmhvb2tEZWxpdmVyeRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiLgoXUmVkZWxpdmVyV2ViaG9va1JlcXVlc3QSEwoLZGVsaXZlcnlfaWQYASABKAkirgEKC091dGJveEV2ZW50EgoKAmlkGAEgASgJEhAKCHNlcXVlbmNlGAIgASgDEhYKDmFnZ3JlZ2F0ZV90eXBlGAMgASgJEhQKDGFnZ3JlZ2F0ZV9pZBgEIAEoCRISCgpldmVudF90eXBlGAUgASgJEg8KB3BheWxvYWQYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiyAEKCk91dGJveFNpbmsSDAoEbmFtZRgBIAEoCRIQCghwb3NpdGlvbhgCIAEoAxIPCgdwZW5kaW5nGAMgASgDEhAKCGF0dGVtcHRzGAQgASgFEhIKCmxhc3RfZXJyb3IYBSABKAkSMwoPbmV4dF9hdHRlbXB0X2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIYChZMaXN0T3V0Ym94U2lua3NSZXF1ZXN0IjsKF0xpc3RPdXRib3hTaW5rc1Jlc3BvbnNlEiAKBXNpbmtzGAEgAygLMhEuYWRtaW4uT3V0Ym94U2luayJkChdMaXN0T3V0Ym94RXZlbnRzUmVxdWVzdBIMCgRzaW5rGAEgASgJEhQKDGFnZ3JlZ2F0ZV9pZBgCIAEoCRIRCglwYWdlX3NpemUYAyABKAUSEgoKcGFnZV90b2tlbhgEIAEoCSJXChhMaXN0T3V0Ym94RXZlbnRzUmVzcG9uc2USIgoGZXZlbnRzGAEgAygLMhIuYWRtaW4uT3V0Ym94RXZlbnQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIkAKGVJlcGxheU91dGJveEV2ZW50c1JlcXVlc3QSDAoEc2luaxgBIAEoCRIVCg1mcm9tX2V2ZW50X2lkGAIgASgJIjgKFlNraXBPdXRib3hFdmVudFJlcXVlc3QSDAoEc2luaxgBIAEoCRIQCghldmVudF9pZBgCIAEoCSK4AgoKQXVkaXRFdmVudBIKCgJpZBgBIAEoCRIQCghzZXF1ZW5jZRgCIAEoAxIvCgtvY2N1cnJlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFYWN0b3IYBCABKAkSEAoIYWN0b3JfaXAYBSABKAkSEQoJcHJvY2VkdXJlGAYgASgJEhMKC2VudGl0eV90eXBlGAcgASgJEhEKCWVudGl0eV9pZBgIIAEoCRIOCgZhY3Rpb24YCSABKAkSDgoGYmVmb3JlGAogASgJEg0KBWFmdGVyGAsgASgJEhEKCXByZXZfaGFzaBgMIAEoCRIMCgRoYXNoGA0gASgJEi8KC3JlZGFjdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLIAQoWTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBITCgtlbnRpdHlfdHlwZRgBIAEoCRIRCgllbnRpdHlfaWQYAiABKAkSDQoFYWN0b3IYAyABKAkSKAoEZnJvbRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJgoCdG8YBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXBhZ2Vfc2l6ZRgGIAEoBRISCgpwYWdlX3Rva2VuGAcgASgJIlUKF0xpc3RBdWRpdEV2ZW50c1Jlc3BvbnNlEiEKBmV2ZW50cxgBIAMoCzIRLmFkbWluLkF1ZGl0RXZlbnQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIhcKFVZlcmlmeUF1ZGl0TG9nUmVxdWVzdCJrChZWZXJpZnlBdWRpdExvZ1Jlc3BvbnNlEg0KBXZhbGlkGAEgASgIEg8KB2NoZWNrZWQYAiABKAMSHgoWZmlyc3RfaW52YWxpZF9zZXF1ZW5jZRgDIAEoAxIRCgloZWFkX2hhc2gYBCABKAkiUgoZR2V0QXBwb2ludG1lbnRBc09mUmVxdWVzdBIKCgJpZBgBIAEoCRIpCgVhc19vZhgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiawofUHVyZ2VEZWxldGVkQXBwb2ludG1lbnRzUmVxdWVzdBIXCg9vbGRlcl90aGFuX2RheXMYASABKAUSHgoEbW9kZRgCIAEoDjIQLmFkbWluLlB1cmdlTW9kZRIPCgdkcnlfcnVuGAMgASgIIqkBCiBQdXJnZURlbGV0ZWRBcHBvaW50bWVudHNSZXNwb25zZRIqCgZjdXRvZmYYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEh4KBG1vZGUYAiABKA4yEC5hZG1pbi5QdXJnZU1vZGUSFAoMcHVyZ2VkX2NvdW50GAMgASgFEhIKCmhlbGRfY291bnQYBCABKAUSDwoHZHJ5X3J1bhgFIAEoCCJBCglMZWdhbEhvbGQSEwoLZW50aXR5X3R5cGUYASABKAkSEQoJZW50aXR5X2lkGAIgASgJEgwKBGhvbGQYAyABKAgiSwoTU2V0TGVnYWxIb2xkUmVxdWVzdBITCgtlbnRpdHlfdHlwZRgBIAEoCRIRCgllbnRpdHlfaWQYAiABKAkSDAoEaG9sZBgDIAEoCCIXChVMaXN0TGVnYWxIb2xkc1JlcXVlc3QiOQoWTGlzdExlZ2FsSG9sZHNSZXNwb25zZRIfCgVob2xkcxgBIAMoCzIQLmFkbWluLkxlZ2FsSG9sZCJNChVFeHBvcnRVc2VyRGF0YVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIjCgZmb3JtYXQYAiABKA4yEy5hZG1pbi5FeHBvcnRGb3JtYXQiTgoWRXhwb3J0VXNlckRhdGFSZXNwb25zZRIMCgRkYXRhGAEgASgMEhQKDGNvbnRlbnRfdHlwZRgCIAEoCRIQCghmaWxlbmFtZRgDIAEoCSLqAQoOVXNlckRhdGFFeHBvcnQSGAoEdXNlchgBIAEoCzIKLnVzZXIuVXNlchIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBItCgllcmFzZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KDGFwcG9pbnRtZW50cxgEIAMoCzIYLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50Ei8KC2V4cG9ydGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIjChBFcmFzZVVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiQgoRRXJhc2VVc2VyUmVzcG9uc2USLQoJZXJhc2VkX2F0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKvAwoIU2NoZWR1bGUSEQoJdGltZV96b25lGAEgASgJEi4KCnVwZGF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiQKBnBvbGljeRgDIAEoCzIULmFkbWluLkJvb2tpbmdQb2xpY3kSMAoNYnVmZmVyX2JlZm9yZRgEIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIvCgxidWZmZXJfYWZ0ZXIYBSABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SNQoSd2FpdGxpc3Rfb2ZmZXJfdHRsGAYgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEisKCGhvbGRfdHRsGAcgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEjYKE2NhbmNlbGxhdGlvbl9jdXRvZmYYCCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SOwoYbGF0ZV9jYW5jZWxsYXRpb25fd2luZG93GAkgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIq4CCg1Cb29raW5nUG9saWN5Ei0KCm1pbl9ub3RpY2UYASABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SLgoLbWF4X2FkdmFuY2UYAiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SLwoMbWluX2R1cmF0aW9uGAMgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEi8KDG1heF9kdXJhdGlvbhgEIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIxCg5zbG90X2FsaWdubWVudBgFIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhITCgttYXhfcGVyX2RheRgGIAEoBRIUCgxtYXhfcGVyX3dlZWsYByABKAUiFAoSR2V0U2NoZWR1bGVSZXF1ZXN0ImsKFVVwZGF0ZVNjaGVkdWxlUmVxdWVzdBIhCghzY2hlZHVsZRgBIAEoCzIPLmFkbWluLlNjaGVkdWxlEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzayJWChxDcmVhdGVBcHBvaW50bWVudFR5cGVSZXF1ZXN0EjYKEGFwcG9pbnRtZW50X3R5cGUYASABKAsyHC5hcHBvaW50bWVudC5BcHBvaW50bWVudFR5cGUihwEKHFVwZGF0ZUFwcG9pbnRtZW50VHlwZVJlcXVlc3QSNgoQYXBwb2ludG1lbnRfdHlwZRgBIAEoCzIcLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50VHlwZRIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2siKgocRGVsZXRlQXBwb2ludG1lbnRUeXBlUmVxdWVzdBIKCgJpZBgBIAEoCSIwCh1EZWxldGVBcHBvaW50bWVudFR5cGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIImUKGENhbmNlbEFwcG9pbnRtZW50UmVxdWVzdBIKCgJpZBgBIAEoCRIvCgZyZWFzb24YAiABKA4yHy5hcHBvaW50bWVudC5DYW5jZWxsYXRpb25SZWFzb24SDAoEbm90ZRgDIAEoCSJbCh1Db3VudExhdGVDYW5jZWxsYXRpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEikKBXNpbmNlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIvCh5Db3VudExhdGVDYW5jZWxsYXRpb25zUmVzcG9uc2USDQoFY291bnQYASABKAUqsAEKFVdlYmhvb2tEZWxpdmVyeVN0YXR1cxInCiNXRUJIT09LX0RFTElWRVJZX1NUQVRVU19VTlNQRUNJRklFRBAAEiMKH1dFQkhPT0tfREVMSVZFUllfU1RBVFVTX1BFTkRJTkcQARIlCiFXRUJIT09LX0RFTElWRVJZX1NUQVRVU19TVUNDRUVERUQQAhIiCh5XRUJIT09LX0RFTElWRVJZX1NUQVRVU19GQUlMRUQQAypWCglQdXJnZU1vZGUSGgoWUFVSR0VfTU9ERV9VTlNQRUNJRklFRBAAEhUKEVBVUkdFX01PREVfREVMRVRFEAESFgoSUFVSR0VfTU9ERV9BUkNISVZFEAIqXAoMRXhwb3J0Rm9ybWF0Eh0KGUVYUE9SVF9GT1JNQVRfVU5TUEVDSUZJRUQQABIWChJFWFBPUlRfRk9STUFUX0pTT04QARIVChFFWFBPUlRfRk9STUFUX1pJUBACKlwKDERlbnlsaXN0S2luZBIdChlERU5ZTElTVF9LSU5EX1VOU1BFQ0lGSUVEEAASFwoTREVOWUxJU1RfS0lORF9FTUFJTBABEhQKEERFTllMSVNUX0tJTkRfSVAQAjK6EgoMQWRtaW5TZXJ2aWNlEkgKEEFkZERlbnlsaXN0RW50cnkSHi5hZG1pbi5BZGREZW55bGlzdEVudHJ5UmVxdWVzdBoULmFkbWluLkRlbnlsaXN0RW50cnkSXAoTUmVtb3ZlRGVueWxpc3RFbnRyeRIhLmFkbWluLlJlbW92ZURlbnlsaXN0RW50cnlSZXF1ZXN0GiIuYWRtaW4uUmVtb3ZlRGVueWxpc3RFbnRyeVJlc3BvbnNlElwKE0xpc3REZW55bGlzdEVudHJpZXMSIS5hZG1pbi5MaXN0RGVueWxpc3RFbnRyaWVzUmVxdWVzdBoiLmFkbWluLkxpc3REZW55bGlzdEVudHJpZXNSZXNwb25zZRJTChBMaXN0QXBwb2ludG1lbnRzEh4uYWRtaW4uTGlzdEFwcG9pbnRtZW50c1JlcXVlc3QaHy5hZG1pbi5MaXN0QXBwb2ludG1lbnRzUmVzcG9uc2USSgoNQ3JlYXRlV2ViaG9vaxIbLmFkbWluLkNyZWF0ZVdlYmhvb2tSZXF1ZXN0GhwuYWRtaW4uQ3JlYXRlV2ViaG9va1Jlc3BvbnNlEkcKDExpc3RXZWJob29rcxIaLmFkbWluLkxpc3RXZWJob29rc1JlcXVlc3QaGy5hZG1pbi5MaXN0V2ViaG9va3NSZXNwb25zZRI8Cg1VcGRhdGVXZWJob29rEhsuYWRtaW4uVXBkYXRlV2ViaG9va1JlcXVlc3QaDi5hZG1pbi5XZWJob29rEkoKDURlbGV0ZVdlYmhvb2sSGy5hZG1pbi5EZWxldGVXZWJob29rUmVxdWVzdBocLmFkbWluLkRlbGV0ZVdlYmhvb2tSZXNwb25zZRJiChVMaXN0V2ViaG9va0RlbGl2ZXJpZXMSIy5hZG1pbi5MaXN0V2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0GiQuYWRtaW4uTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVzcG9uc2USSgoQUmVkZWxpdmVyV2ViaG9vaxIeLmFkbWluLlJlZGVsaXZlcldlYmhvb2tSZXF1ZXN0GhYuYWRtaW4uV2ViaG9va0RlbGl2ZXJ5ElAKD0xpc3RPdXRib3hTaW5rcxIdLmFkbWluLkxpc3RPdXRib3hTaW5rc1JlcXVlc3QaHi5hZG1pbi5MaXN0T3V0Ym94U2lua3NSZXNwb25zZRJTChBMaXN0T3V0Ym94RXZlbnRzEh4uYWRtaW4uTGlzdE91dGJveEV2ZW50c1JlcXVlc3QaHy5hZG1pbi5MaXN0T3V0Ym94RXZlbnRzUmVzcG9uc2USSQoSUmVwbGF5T3V0Ym94RXZlbnRzEiAuYWRtaW4uUmVwbGF5T3V0Ym94RXZlbnRzUmVxdWVzdBoRLmFkbWluLk91dGJveFNpbmsSQwoPU2tpcE91dGJveEV2ZW50Eh0uYWRtaW4uU2tpcE91dGJveEV2ZW50UmVxdWVzdBoRLmFkbWluLk91dGJveFNpbmsSUAoPTGlzdEF1ZGl0RXZlbnRzEh0uYWRtaW4uTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBoeLmFkbWluLkxpc3RBdWRpdEV2ZW50c1Jlc3BvbnNlEk0KDlZlcmlmeUF1ZGl0TG9nEhwuYWRtaW4uVmVyaWZ5QXVkaXRMb2dSZXF1ZXN0Gh0uYWRtaW4uVmVyaWZ5QXVkaXRMb2dSZXNwb25zZRJQChJHZXRBcHBvaW50bWVudEFzT2YSIC5hZG1pbi5HZXRBcHBvaW50bWVudEFzT2ZSZXF1ZXN0GhguYXBwb2ludG1lbnQuQXBwb2ludG1lbnQSawoYUHVyZ2VEZWxldGVkQXBwb2ludG1lbnRzEiYuYWRtaW4uUHVyZ2VEZWxldGVkQXBwb2ludG1lbnRzUmVxdWVzdBonLmFkbWluLlB1cmdlRGVsZXRlZEFwcG9pbnRtZW50c1Jlc3BvbnNlEjwKDFNldExlZ2FsSG9sZBIaLmFkbWluLlNldExlZ2FsSG9sZFJlcXVlc3QaEC5hZG1pbi5MZWdhbEhvbGQSTQoOTGlzdExlZ2FsSG9sZHMSHC5hZG1pbi5MaXN0TGVnYWxIb2xkc1JlcXVlc3QaHS5hZG1pbi5MaXN0TGVnYWxIb2xkc1Jlc3BvbnNlEk0KDkV4cG9ydFVzZXJEYXRhEhwuYWRtaW4uRXhwb3J0VXNlckRhdGFSZXF1ZXN0Gh0uYWRtaW4uRXhwb3J0VXNlckRhdGFSZXNwb25zZRI+CglFcmFzZVVzZXISFy5hZG1pbi5FcmFzZVVzZXJSZXF1ZXN0GhguYWRtaW4uRXJhc2VVc2VyUmVzcG9uc2USOQoLR2V0U2NoZWR1bGUSGS5hZG1pbi5HZXRTY2hlZHVsZVJlcXVlc3QaDy5hZG1pbi5TY2hlZHVsZRI/Cg5VcGRhdGVTY2hlZHVsZRIcLmFkbWluLlVwZGF0ZVNjaGVkdWxlUmVxdWVzdBoPLmFkbWluLlNjaGVkdWxlEloKFUNyZWF0ZUFwcG9pbnRtZW50VHlwZRIjLmFkbWluLkNyZWF0ZUFwcG9pbnRtZW50VHlwZVJlcXVlc3QaHC5hcHBvaW50bWVudC5BcHBvaW50bWVudFR5cGUSWgoVVXBkYXRlQXBwb2ludG1lbnRUeXBlEiMuYWRtaW4uVXBkYXRlQXBwb2ludG1lbnRUeXBlUmVxdWVzdBocLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50VHlwZRJiChVEZWxldGVBcHBvaW50bWVudFR5cGUSIy5hZG1pbi5EZWxldGVBcHBvaW50bWVudFR5cGVSZXF1ZXN0GiQuYWRtaW4uRGVsZXRlQXBwb2ludG1lbnRUeXBlUmVzcG9uc2USTgoRQ2FuY2VsQXBwb2ludG1lbnQSHy5hZG1pbi5DYW5jZWxBcHBvaW50bWVudFJlcXVlc3QaGC5hcHBvaW50bWVudC5BcHBvaW50bWVudBJlChZDb3VudExhdGVDYW5jZWxsYXRpb25zEiQuYWRtaW4uQ291bnRMYXRlQ2FuY2VsbGF0aW9uc1JlcXVlc3QaJS5hZG1pbi5Db3VudExhdGVDYW5jZWxsYXRpb25zUmVzcG9uc2VCMVovZ2l0aHViLmNvbS9mb2x1Y29kZS9hcHBvaW50bWVudC1zY2hlZHVsZXIvcHJvdG9iBnByb3RvMw", [file_google_protobuf_timestamp, file_google_protobuf_field_mask, file_google_protobuf_duration, file_appointment, file_user]);
+
+/**
+ * @generated from message admin.DenylistEntry
+ */
+export type DenylistEntry = Message<"admin.DenylistEntry"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: admin.DenylistKind kind = 2;
+   */
+  kind: DenylistKind;
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value: string;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.DenylistEntry.
+ * Use `create(DenylistEntrySchema)` to create a new message.
+ */
+export const DenylistEntrySchema: GenMessage<DenylistEntry> = /*@__PURE__*/
+  messageDesc(file_admin, 0);
+
+/**
+ * @generated from message admin.AddDenylistEntryRequest
+ */
+export type AddDenylistEntryRequest = Message<"admin.AddDenylistEntryRequest"> & {
+  /**
+   * @generated from field: admin.DenylistKind kind = 1;
+   */
+  kind: DenylistKind;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message admin.AddDenylistEntryRequest.
+ * Use `create(AddDenylistEntryRequestSchema)` to create a new message.
+ */
+export const AddDenylistEntryRequestSchema: GenMessage<AddDenylistEntryRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 1);
+
+/**
+ * @generated from message admin.RemoveDenylistEntryRequest
+ */
+export type RemoveDenylistEntryRequest = Message<"admin.RemoveDenylistEntryRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message admin.RemoveDenylistEntryRequest.
+ * Use `create(RemoveDenylistEntryRequestSchema)` to create a new message.
+ */
+export const RemoveDenylistEntryRequestSchema: GenMessage<RemoveDenylistEntryRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 2);
+
+/**
+ * @generated from message admin.RemoveDenylistEntryResponse
+ */
+export type RemoveDenylistEntryResponse = Message<"admin.RemoveDenylistEntryResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message admin.RemoveDenylistEntryResponse.
+ * Use `create(RemoveDenylistEntryResponseSchema)` to create a new message.
+ */
+export const RemoveDenylistEntryResponseSchema: GenMessage<RemoveDenylistEntryResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 3);
+
+/**
+ * @generated from message admin.ListDenylistEntriesRequest
+ */
+export type ListDenylistEntriesRequest = Message<"admin.ListDenylistEntriesRequest"> & {
+};
+
+/**
+ * Describes the message admin.ListDenylistEntriesRequest.
+ * Use `create(ListDenylistEntriesRequestSchema)` to create a new message.
+ */
+export const ListDenylistEntriesRequestSchema: GenMessage<ListDenylistEntriesRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 4);
+
+/**
+ * @generated from message admin.ListDenylistEntriesResponse
+ */
+export type ListDenylistEntriesResponse = Message<"admin.ListDenylistEntriesResponse"> & {
+  /**
+   * @generated from field: repeated admin.DenylistEntry entries = 1;
+   */
+  entries: DenylistEntry[];
+};
+
+/**
+ * Describes the message admin.ListDenylistEntriesResponse.
+ * Use `create(ListDenylistEntriesResponseSchema)` to create a new message.
+ */
+export const ListDenylistEntriesResponseSchema: GenMessage<ListDenylistEntriesResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 5);
+
+/**
+ * @generated from message admin.ListAppointmentsRequest
+ */
+export type ListAppointmentsRequest = Message<"admin.ListAppointmentsRequest"> & {
+  /**
+   * @generated from field: string filter = 1;
+   */
+  filter: string;
+
+  /**
+   * @generated from field: string order_by = 2;
+   */
+  orderBy: string;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message admin.ListAppointmentsRequest.
+ * Use `create(ListAppointmentsRequestSchema)` to create a new message.
+ */
+export const ListAppointmentsRequestSchema: GenMessage<ListAppointmentsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 6);
+
+/**
+ * @generated from message admin.ListAppointmentsResponse
+ */
+export type ListAppointmentsResponse = Message<"admin.ListAppointmentsResponse"> & {
+  /**
+   * @generated from field: repeated appointment.Appointment appointments = 1;
+   */
+  appointments: Appointment[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int32 total_size = 3;
+   */
+  totalSize: number;
+};
+
+/**
+ * Describes the message admin.ListAppointmentsResponse.
+ * Use `create(ListAppointmentsResponseSchema)` to create a new message.
+ */
+export const ListAppointmentsResponseSchema: GenMessage<ListAppointmentsResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 7);
+
+/**
+ * A webhook receives a signed JSON POST for each subscribed event type:
+ * appointment.created, appointment.updated or appointment.cancelled. It is
+ * disabled automatically after repeated failed deliveries.
+ *
+ * @generated from message admin.Webhook
+ */
+export type Webhook = Message<"admin.Webhook"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: repeated string event_types = 3;
+   */
+  eventTypes: string[];
+
+  /**
+   * @generated from field: bool enabled = 4;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: int32 consecutive_failures = 5;
+   */
+  consecutiveFailures: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp disabled_at = 6;
+   */
+  disabledAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.Webhook.
+ * Use `create(WebhookSchema)` to create a new message.
+ */
+export const WebhookSchema: GenMessage<Webhook> = /*@__PURE__*/
+  messageDesc(file_admin, 8);
+
+/**
+ * A secret is generated when none is supplied.
+ *
+ * @generated from message admin.CreateWebhookRequest
+ */
+export type CreateWebhookRequest = Message<"admin.CreateWebhookRequest"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * @generated from field: repeated string event_types = 2;
+   */
+  eventTypes: string[];
+
+  /**
+   * @generated from field: string secret = 3;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message admin.CreateWebhookRequest.
+ * Use `create(CreateWebhookRequestSchema)` to create a new message.
+ */
+export const CreateWebhookRequestSchema: GenMessage<CreateWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 9);
+
+/**
+ * The secret is only returned when the webhook is created.
+ *
+ * @generated from message admin.CreateWebhookResponse
+ */
+export type CreateWebhookResponse = Message<"admin.CreateWebhookResponse"> & {
+  /**
+   * @generated from field: admin.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+
+  /**
+   * @generated from field: string secret = 2;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message admin.CreateWebhookResponse.
+ * Use `create(CreateWebhookResponseSchema)` to create a new message.
+ */
+export const CreateWebhookResponseSchema: GenMessage<CreateWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 10);
+
+/**
+ * @generated from message admin.ListWebhooksRequest
+ */
+export type ListWebhooksRequest = Message<"admin.ListWebhooksRequest"> & {
+};
+
+/**
+ * Describes the message admin.ListWebhooksRequest.
+ * Use `create(ListWebhooksRequestSchema)` to create a new message.
+ */
+export const ListWebhooksRequestSchema: GenMessage<ListWebhooksRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 11);
+
+/**
+ * @generated from message admin.ListWebhooksResponse
+ */
+export type ListWebhooksResponse = Message<"admin.ListWebhooksResponse"> & {
+  /**
+   * @generated from field: repeated admin.Webhook webhooks = 1;
+   */
+  webhooks: Webhook[];
+};
+
+/**
+ * Describes the message admin.ListWebhooksResponse.
+ * Use `create(ListWebhooksResponseSchema)` to create a new message.
+ */
+export const ListWebhooksResponseSchema: GenMessage<ListWebhooksResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 12);
+
+/**
+ * Replaces the URL and event types when set. Enabling a disabled webhook
+ * resets its failure count.
+ *
+ * @generated from message admin.UpdateWebhookRequest
+ */
+export type UpdateWebhookRequest = Message<"admin.UpdateWebhookRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: repeated string event_types = 3;
+   */
+  eventTypes: string[];
+
+  /**
+   * @generated from field: bool enabled = 4;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message admin.UpdateWebhookRequest.
+ * Use `create(UpdateWebhookRequestSchema)` to create a new message.
+ */
+export const UpdateWebhookRequestSchema: GenMessage<UpdateWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 13);
+
+/**
+ * @generated from message admin.DeleteWebhookRequest
+ */
+export type DeleteWebhookRequest = Message<"admin.DeleteWebhookRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message admin.DeleteWebhookRequest.
+ * Use `create(DeleteWebhookRequestSchema)` to create a new message.
+ */
+export const DeleteWebhookRequestSchema: GenMessage<DeleteWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 14);
+
+/**
+ * @generated from message admin.DeleteWebhookResponse
+ */
+export type DeleteWebhookResponse = Message<"admin.DeleteWebhookResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message admin.DeleteWebhookResponse.
+ * Use `create(DeleteWebhookResponseSchema)` to create a new message.
+ */
+export const DeleteWebhookResponseSchema: GenMessage<DeleteWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 15);
+
+/**
+ * @generated from message admin.WebhookDelivery
+ */
+export type WebhookDelivery = Message<"admin.WebhookDelivery"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string webhook_id = 2;
+   */
+  webhookId: string;
+
+  /**
+   * @generated from field: string event_id = 3;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string event_type = 4;
+   */
+  eventType: string;
+
+  /**
+   * @generated from field: admin.WebhookDeliveryStatus status = 5;
+   */
+  status: WebhookDeliveryStatus;
+
+  /**
+   * @generated from field: int32 attempts = 6;
+   */
+  attempts: number;
+
+  /**
+   * @generated from field: int32 response_code = 7;
+   */
+  responseCode: number;
+
+  /**
+   * @generated from field: string last_error = 8;
+   */
+  lastError: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_attempt_at = 10;
+   */
+  nextAttemptAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp delivered_at = 11;
+   */
+  deliveredAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export const WebhookDeliverySchema: GenMessage<WebhookDelivery> = /*@__PURE__*/
+  messageDesc(file_admin, 16);
+
+/**
+ * @generated from message admin.ListWebhookDeliveriesRequest
+ */
+export type ListWebhookDeliveriesRequest = Message<"admin.ListWebhookDeliveriesRequest"> & {
+  /**
+   * @generated from field: string webhook_id = 1;
+   */
+  webhookId: string;
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message admin.ListWebhookDeliveriesRequest.
+ * Use `create(ListWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesRequestSchema: GenMessage<ListWebhookDeliveriesRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 17);
+
+/**
+ * @generated from message admin.ListWebhookDeliveriesResponse
+ */
+export type ListWebhookDeliveriesResponse = Message<"admin.ListWebhookDeliveriesResponse"> & {
+  /**
+   * @generated from field: repeated admin.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message admin.ListWebhookDeliveriesResponse.
+ * Use `create(ListWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesResponseSchema: GenMessage<ListWebhookDeliveriesResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 18);
+
+/**
+ * Sends the delivery's event again as a new delivery.
+ *
+ * @generated from message admin.RedeliverWebhookRequest
+ */
+export type RedeliverWebhookRequest = Message<"admin.RedeliverWebhookRequest"> & {
+  /**
+   * @generated from field: string delivery_id = 1;
+   */
+  deliveryId: string;
+};
+
+/**
+ * Describes the message admin.RedeliverWebhookRequest.
+ * Use `create(RedeliverWebhookRequestSchema)` to create a new message.
+ */
+export const RedeliverWebhookRequestSchema: GenMessage<RedeliverWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 19);
+
+/**
+ * An outbox event is a domain event recorded in the same transaction as the
+ * change it describes.
+ *
+ * @generated from message admin.OutboxEvent
+ */
+export type OutboxEvent = Message<"admin.OutboxEvent"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: int64 sequence = 2;
+   */
+  sequence: bigint;
+
+  /**
+   * @generated from field: string aggregate_type = 3;
+   */
+  aggregateType: string;
+
+  /**
+   * @generated from field: string aggregate_id = 4;
+   */
+  aggregateId: string;
+
+  /**
+   * @generated from field: string event_type = 5;
+   */
+  eventType: string;
+
+  /**
+   * The JSON encoding of the appointment or user after the change.
+   *
+   * @generated from field: string payload = 6;
+   */
+  payload: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.OutboxEvent.
+ * Use `create(OutboxEventSchema)` to create a new message.
+ */
+export const OutboxEventSchema: GenMessage<OutboxEvent> = /*@__PURE__*/
+  messageDesc(file_admin, 20);
+
+/**
+ * An outbox sink receives every event in order. A sink whose last attempt
+ * failed has a last_error and is retried at next_attempt_at.
+ *
+ * @generated from message admin.OutboxSink
+ */
+export type OutboxSink = Message<"admin.OutboxSink"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The sequence of the last event the sink accepted.
+   *
+   * @generated from field: int64 position = 2;
+   */
+  position: bigint;
+
+  /**
+   * @generated from field: int64 pending = 3;
+   */
+  pending: bigint;
+
+  /**
+   * @generated from field: int32 attempts = 4;
+   */
+  attempts: number;
+
+  /**
+   * @generated from field: string last_error = 5;
+   */
+  lastError: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_attempt_at = 6;
+   */
+  nextAttemptAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.OutboxSink.
+ * Use `create(OutboxSinkSchema)` to create a new message.
+ */
+export const OutboxSinkSchema: GenMessage<OutboxSink> = /*@__PURE__*/
+  messageDesc(file_admin, 21);
+
+/**
+ * @generated from message admin.ListOutboxSinksRequest
+ */
+export type ListOutboxSinksRequest = Message<"admin.ListOutboxSinksRequest"> & {
+};
+
+/**
+ * Describes the message admin.ListOutboxSinksRequest.
+ * Use `create(ListOutboxSinksRequestSchema)` to create a new message.
+ */
+export const ListOutboxSinksRequestSchema: GenMessage<ListOutboxSinksRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 22);
+
+/**
+ * @generated from message admin.ListOutboxSinksResponse
+ */
+export type ListOutboxSinksResponse = Message<"admin.ListOutboxSinksResponse"> & {
+  /**
+   * @generated from field: repeated admin.OutboxSink sinks = 1;
+   */
+  sinks: OutboxSink[];
+};
+
+/**
+ * Describes the message admin.ListOutboxSinksResponse.
+ * Use `create(ListOutboxSinksResponseSchema)` to create a new message.
+ */
+export const ListOutboxSinksResponseSchema: GenMessage<ListOutboxSinksResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 23);
+
+/**
+ * Lists events in delivery order. With sink set only the events still
+ * pending for that sink are listed, so a stuck event comes first.
+ *
+ * @generated from message admin.ListOutboxEventsRequest
+ */
+export type ListOutboxEventsRequest = Message<"admin.ListOutboxEventsRequest"> & {
+  /**
+   * @generated from field: string sink = 1;
+   */
+  sink: string;
+
+  /**
+   * @generated from field: string aggregate_id = 2;
+   */
+  aggregateId: string;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message admin.ListOutboxEventsRequest.
+ * Use `create(ListOutboxEventsRequestSchema)` to create a new message.
+ */
+export const ListOutboxEventsRequestSchema: GenMessage<ListOutboxEventsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 24);
+
+/**
+ * @generated from message admin.ListOutboxEventsResponse
+ */
+export type ListOutboxEventsResponse = Message<"admin.ListOutboxEventsResponse"> & {
+  /**
+   * @generated from field: repeated admin.OutboxEvent events = 1;
+   */
+  events: OutboxEvent[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message admin.ListOutboxEventsResponse.
+ * Use `create(ListOutboxEventsResponseSchema)` to create a new message.
+ */
+export const ListOutboxEventsResponseSchema: GenMessage<ListOutboxEventsResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 25);
+
+/**
+ * Rewinds the sink so it receives from_event_id and every later event again.
+ * Without from_event_id the sink is only retried straight away.
+ *
+ * @generated from message admin.ReplayOutboxEventsRequest
+ */
+export type ReplayOutboxEventsRequest = Message<"admin.ReplayOutboxEventsRequest"> & {
+  /**
+   * @generated from field: string sink = 1;
+   */
+  sink: string;
+
+  /**
+   * @generated from field: string from_event_id = 2;
+   */
+  fromEventId: string;
+};
+
+/**
+ * Describes the message admin.ReplayOutboxEventsRequest.
+ * Use `create(ReplayOutboxEventsRequestSchema)` to create a new message.
+ */
+export const ReplayOutboxEventsRequestSchema: GenMessage<ReplayOutboxEventsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 26);
+
+/**
+ * Moves the sink past event_id, which must be the next event pending for it.
+ *
+ * @generated from message admin.SkipOutboxEventRequest
+ */
+export type SkipOutboxEventRequest = Message<"admin.SkipOutboxEventRequest"> & {
+  /**
+   * @generated from field: string sink = 1;
+   */
+  sink: string;
+
+  /**
+   * @generated from field: string event_id = 2;
+   */
+  eventId: string;
+};
+
+/**
+ * Describes the message admin.SkipOutboxEventRequest.
+ * Use `create(SkipOutboxEventRequestSchema)` to create a new message.
+ */
+export const SkipOutboxEventRequestSchema: GenMessage<SkipOutboxEventRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 27);
+
+/**
+ * An audit event records one change to an appointment or user: who made it,
+ * through which procedure, and the entity before and after. Each event's
+ * hash covers the hash of the event before it, so editing or removing an
+ * event breaks the chain from that point on.
+ *
+ * @generated from message admin.AuditEvent
+ */
+export type AuditEvent = Message<"admin.AuditEvent"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: int64 sequence = 2;
+   */
+  sequence: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp occurred_at = 3;
+   */
+  occurredAt?: Timestamp;
+
+  /**
+   * "admin", "user:{id}", "anonymous" or "system".
+   *
+   * @generated from field: string actor = 4;
+   */
+  actor: string;
+
+  /**
+   * @generated from field: string actor_ip = 5;
+   */
+  actorIp: string;
+
+  /**
+   * @generated from field: string procedure = 6;
+   */
+  procedure: string;
+
+  /**
+   * @generated from field: string entity_type = 7;
+   */
+  entityType: string;
+
+  /**
+   * @generated from field: string entity_id = 8;
+   */
+  entityId: string;
+
+  /**
+   * @generated from field: string action = 9;
+   */
+  action: string;
+
+  /**
+   * JSON snapshots of the entity; before is empty for a create.
+   *
+   * @generated from field: string before = 10;
+   */
+  before: string;
+
+  /**
+   * @generated from field: string after = 11;
+   */
+  after: string;
+
+  /**
+   * @generated from field: string prev_hash = 12;
+   */
+  prevHash: string;
+
+  /**
+   * @generated from field: string hash = 13;
+   */
+  hash: string;
+
+  /**
+   * Set once the snapshots have been scrubbed because the user they
+   * describe was erased. The digests still cover the original content.
+   *
+   * @generated from field: google.protobuf.Timestamp redacted_at = 14;
+   */
+  redactedAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export const AuditEventSchema: GenMessage<AuditEvent> = /*@__PURE__*/
+  messageDesc(file_admin, 28);
+
+/**
+ * Lists audit events newest first. Every filter is optional.
+ *
+ * @generated from message admin.ListAuditEventsRequest
+ */
+export type ListAuditEventsRequest = Message<"admin.ListAuditEventsRequest"> & {
+  /**
+   * @generated from field: string entity_type = 1;
+   */
+  entityType: string;
+
+  /**
+   * @generated from field: string entity_id = 2;
+   */
+  entityId: string;
+
+  /**
+   * @generated from field: string actor = 3;
+   */
+  actor: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 4;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 5;
+   */
+  to?: Timestamp;
+
+  /**
+   * @generated from field: int32 page_size = 6;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 7;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message admin.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 29);
+
+/**
+ * @generated from message admin.ListAuditEventsResponse
+ */
+export type ListAuditEventsResponse = Message<"admin.ListAuditEventsResponse"> & {
+  /**
+   * @generated from field: repeated admin.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message admin.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 30);
+
+/**
+ * @generated from message admin.VerifyAuditLogRequest
+ */
+export type VerifyAuditLogRequest = Message<"admin.VerifyAuditLogRequest"> & {
+};
+
+/**
+ * Describes the message admin.VerifyAuditLogRequest.
+ * Use `create(VerifyAuditLogRequestSchema)` to create a new message.
+ */
+export const VerifyAuditLogRequestSchema: GenMessage<VerifyAuditLogRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 31);
+
+/**
+ * When the chain is broken first_invalid_sequence is the first event that
+ * does not match. head_hash can be recorded elsewhere to later prove that the
+ * log up to this point has not been rewritten.
+ *
+ * @generated from message admin.VerifyAuditLogResponse
+ */
+export type VerifyAuditLogResponse = Message<"admin.VerifyAuditLogResponse"> & {
+  /**
+   * @generated from field: bool valid = 1;
+   */
+  valid: boolean;
+
+  /**
+   * @generated from field: int64 checked = 2;
+   */
+  checked: bigint;
+
+  /**
+   * @generated from field: int64 first_invalid_sequence = 3;
+   */
+  firstInvalidSequence: bigint;
+
+  /**
+   * @generated from field: string head_hash = 4;
+   */
+  headHash: string;
+};
+
+/**
+ * Describes the message admin.VerifyAuditLogResponse.
+ * Use `create(VerifyAuditLogResponseSchema)` to create a new message.
+ */
+export const VerifyAuditLogResponseSchema: GenMessage<VerifyAuditLogResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 32);
+
+/**
+ * Returns the appointment as it was recorded at as_of, including a deleted_at
+ * if it had been deleted by then.
+ *
+ * @generated from message admin.GetAppointmentAsOfRequest
+ */
+export type GetAppointmentAsOfRequest = Message<"admin.GetAppointmentAsOfRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp as_of = 2;
+   */
+  asOf?: Timestamp;
+};
+
+/**
+ * Describes the message admin.GetAppointmentAsOfRequest.
+ * Use `create(GetAppointmentAsOfRequestSchema)` to create a new message.
+ */
+export const GetAppointmentAsOfRequestSchema: GenMessage<GetAppointmentAsOfRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 33);
+
+/**
+ * Purges appointments deleted more than older_than_days ago, except those
+ * under a legal hold or belonging to a user under one. Unset fields fall back
+ * to the server's retention policy.
+ *
+ * @generated from message admin.PurgeDeletedAppointmentsRequest
+ */
+export type PurgeDeletedAppointmentsRequest = Message<"admin.PurgeDeletedAppointmentsRequest"> & {
+  /**
+   * @generated from field: int32 older_than_days = 1;
+   */
+  olderThanDays: number;
+
+  /**
+   * @generated from field: admin.PurgeMode mode = 2;
+   */
+  mode: PurgeMode;
+
+  /**
+   * Only count what would be purged.
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message admin.PurgeDeletedAppointmentsRequest.
+ * Use `create(PurgeDeletedAppointmentsRequestSchema)` to create a new message.
+ */
+export const PurgeDeletedAppointmentsRequestSchema: GenMessage<PurgeDeletedAppointmentsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 34);
+
+/**
+ * @generated from message admin.PurgeDeletedAppointmentsResponse
+ */
+export type PurgeDeletedAppointmentsResponse = Message<"admin.PurgeDeletedAppointmentsResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp cutoff = 1;
+   */
+  cutoff?: Timestamp;
+
+  /**
+   * @generated from field: admin.PurgeMode mode = 2;
+   */
+  mode: PurgeMode;
+
+  /**
+   * Appointments purged, or that would be on a dry run.
+   *
+   * @generated from field: int32 purged_count = 3;
+   */
+  purgedCount: number;
+
+  /**
+   * Appointments old enough to purge but kept by a legal hold.
+   *
+   * @generated from field: int32 held_count = 4;
+   */
+  heldCount: number;
+
+  /**
+   * @generated from field: bool dry_run = 5;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message admin.PurgeDeletedAppointmentsResponse.
+ * Use `create(PurgeDeletedAppointmentsResponseSchema)` to create a new message.
+ */
+export const PurgeDeletedAppointmentsResponseSchema: GenMessage<PurgeDeletedAppointmentsResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 35);
+
+/**
+ * entity_type is "user" or "appointment". A hold on a user covers all of
+ * their appointments.
+ *
+ * @generated from message admin.LegalHold
+ */
+export type LegalHold = Message<"admin.LegalHold"> & {
+  /**
+   * @generated from field: string entity_type = 1;
+   */
+  entityType: string;
+
+  /**
+   * @generated from field: string entity_id = 2;
+   */
+  entityId: string;
+
+  /**
+   * @generated from field: bool hold = 3;
+   */
+  hold: boolean;
+};
+
+/**
+ * Describes the message admin.LegalHold.
+ * Use `create(LegalHoldSchema)` to create a new message.
+ */
+export const LegalHoldSchema: GenMessage<LegalHold> = /*@__PURE__*/
+  messageDesc(file_admin, 36);
+
+/**
+ * @generated from message admin.SetLegalHoldRequest
+ */
+export type SetLegalHoldRequest = Message<"admin.SetLegalHoldRequest"> & {
+  /**
+   * @generated from field: string entity_type = 1;
+   */
+  entityType: string;
+
+  /**
+   * @generated from field: string entity_id = 2;
+   */
+  entityId: string;
+
+  /**
+   * @generated from field: bool hold = 3;
+   */
+  hold: boolean;
+};
+
+/**
+ * Describes the message admin.SetLegalHoldRequest.
+ * Use `create(SetLegalHoldRequestSchema)` to create a new message.
+ */
+export const SetLegalHoldRequestSchema: GenMessage<SetLegalHoldRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 37);
+
+/**
+ * @generated from message admin.ListLegalHoldsRequest
+ */
+export type ListLegalHoldsRequest = Message<"admin.ListLegalHoldsRequest"> & {
+};
+
+/**
+ * Describes the message admin.ListLegalHoldsRequest.
+ * Use `create(ListLegalHoldsRequestSchema)` to create a new message.
+ */
+export const ListLegalHoldsRequestSchema: GenMessage<ListLegalHoldsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 38);
+
+/**
+ * @generated from message admin.ListLegalHoldsResponse
+ */
+export type ListLegalHoldsResponse = Message<"admin.ListLegalHoldsResponse"> & {
+  /**
+   * @generated from field: repeated admin.LegalHold holds = 1;
+   */
+  holds: LegalHold[];
+};
+
+/**
+ * Describes the message admin.ListLegalHoldsResponse.
+ * Use `create(ListLegalHoldsResponseSchema)` to create a new message.
+ */
+export const ListLegalHoldsResponseSchema: GenMessage<ListLegalHoldsResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 39);
+
+/**
+ * @generated from message admin.ExportUserDataRequest
+ */
+export type ExportUserDataRequest = Message<"admin.ExportUserDataRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: admin.ExportFormat format = 2;
+   */
+  format: ExportFormat;
+};
+
+/**
+ * Describes the message admin.ExportUserDataRequest.
+ * Use `create(ExportUserDataRequestSchema)` to create a new message.
+ */
+export const ExportUserDataRequestSchema: GenMessage<ExportUserDataRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 40);
+
+/**
+ * data is a UserDataExport as JSON, or for a ZIP archive, user.json and
+ * appointments.json.
+ *
+ * @generated from message admin.ExportUserDataResponse
+ */
+export type ExportUserDataResponse = Message<"admin.ExportUserDataResponse"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: string filename = 3;
+   */
+  filename: string;
+};
+
+/**
+ * Describes the message admin.ExportUserDataResponse.
+ * Use `create(ExportUserDataResponseSchema)` to create a new message.
+ */
+export const ExportUserDataResponseSchema: GenMessage<ExportUserDataResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 41);
+
+/**
+ * Everything stored about a user, including deleted and archived
+ * appointments.
+ *
+ * @generated from message admin.UserDataExport
+ */
+export type UserDataExport = Message<"admin.UserDataExport"> & {
+  /**
+   * @generated from field: user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp erased_at = 3;
+   */
+  erasedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated appointment.Appointment appointments = 4;
+   */
+  appointments: Appointment[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp exported_at = 5;
+   */
+  exportedAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.UserDataExport.
+ * Use `create(UserDataExportSchema)` to create a new message.
+ */
+export const UserDataExportSchema: GenMessage<UserDataExport> = /*@__PURE__*/
+  messageDesc(file_admin, 42);
+
+/**
+ * Anonymizes the user and every copy of their contact details, and frees
+ * their email for a new account. The user record stays behind, marked as
+ * erased, so their appointments cannot be restored with personal data.
+ * Erasing an erased user scrubs again. Users under a legal hold cannot be
+ * erased.
+ *
+ * @generated from message admin.EraseUserRequest
+ */
+export type EraseUserRequest = Message<"admin.EraseUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message admin.EraseUserRequest.
+ * Use `create(EraseUserRequestSchema)` to create a new message.
+ */
+export const EraseUserRequestSchema: GenMessage<EraseUserRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 43);
+
+/**
+ * @generated from message admin.EraseUserResponse
+ */
+export type EraseUserResponse = Message<"admin.EraseUserResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp erased_at = 1;
+   */
+  erasedAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.EraseUserResponse.
+ * Use `create(EraseUserResponseSchema)` to create a new message.
+ */
+export const EraseUserResponseSchema: GenMessage<EraseUserResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 44);
+
+/**
+ * The schedule holds the settings of the calendar appointments are booked
+ * into.
+ *
+ * @generated from message admin.Schedule
+ */
+export type Schedule = Message<"admin.Schedule"> & {
+  /**
+   * IANA time zone, used for users who have not set their own.
+   *
+   * @generated from field: string time_zone = 1;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 2;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: admin.BookingPolicy policy = 3;
+   */
+  policy?: BookingPolicy;
+
+  /**
+   * Time kept free around each new appointment. Appointments keep the
+   * buffers they were booked with when these change.
+   *
+   * @generated from field: google.protobuf.Duration buffer_before = 4;
+   */
+  bufferBefore?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration buffer_after = 5;
+   */
+  bufferAfter?: Duration;
+
+  /**
+   * How long a freed slot is held for someone on the waitlist before it is
+   * offered to the next person.
+   *
+   * @generated from field: google.protobuf.Duration waitlist_offer_ttl = 6;
+   */
+  waitlistOfferTtl?: Duration;
+
+  /**
+   * How long HoldSlot keeps a slot for someone before it is released.
+   *
+   * @generated from field: google.protobuf.Duration hold_ttl = 7;
+   */
+  holdTtl?: Duration;
+
+  /**
+   * How long before it starts a client can no longer cancel an
+   * appointment. Zero lets them cancel any time.
+   *
+   * @generated from field: google.protobuf.Duration cancellation_cutoff = 8;
+   */
+  cancellationCutoff?: Duration;
+
+  /**
+   * How long before it starts a client cancelling an appointment is
+   * flagged as a late cancellation. Zero flags none.
+   *
+   * @generated from field: google.protobuf.Duration late_cancellation_window = 9;
+   */
+  lateCancellationWindow?: Duration;
+};
+
+/**
+ * Describes the message admin.Schedule.
+ * Use `create(ScheduleSchema)` to create a new message.
+ */
+export const ScheduleSchema: GenMessage<Schedule> = /*@__PURE__*/
+  messageDesc(file_admin, 45);
+
+/**
+ * BookingPolicy limits the appointments that can be booked. Unset or zero
+ * fields impose no limit. Update single rules with "policy.<field>" mask
+ * paths, or the whole policy with "policy".
+ *
+ * @generated from message admin.BookingPolicy
+ */
+export type BookingPolicy = Message<"admin.BookingPolicy"> & {
+  /**
+   * How long before it starts an appointment must be booked.
+   *
+   * @generated from field: google.protobuf.Duration min_notice = 1;
+   */
+  minNotice?: Duration;
+
+  /**
+   * How far ahead an appointment can be booked.
+   *
+   * @generated from field: google.protobuf.Duration max_advance = 2;
+   */
+  maxAdvance?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration min_duration = 3;
+   */
+  minDuration?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration max_duration = 4;
+   */
+  maxDuration?: Duration;
+
+  /**
+   * Appointments must start and end on multiples of this from midnight in
+   * the booking's time zone, e.g. 15 minutes.
+   *
+   * @generated from field: google.protobuf.Duration slot_alignment = 5;
+   */
+  slotAlignment?: Duration;
+
+  /**
+   * The most active appointments a user can have starting on one day, or
+   * in one Monday-to-Sunday week, in the booking's time zone.
+   *
+   * @generated from field: int32 max_per_day = 6;
+   */
+  maxPerDay: number;
+
+  /**
+   * @generated from field: int32 max_per_week = 7;
+   */
+  maxPerWeek: number;
+};
+
+/**
+ * Describes the message admin.BookingPolicy.
+ * Use `create(BookingPolicySchema)` to create a new message.
+ */
+export const BookingPolicySchema: GenMessage<BookingPolicy> = /*@__PURE__*/
+  messageDesc(file_admin, 46);
+
+/**
+ * @generated from message admin.GetScheduleRequest
+ */
+export type GetScheduleRequest = Message<"admin.GetScheduleRequest"> & {
+};
+
+/**
+ * Describes the message admin.GetScheduleRequest.
+ * Use `create(GetScheduleRequestSchema)` to create a new message.
+ */
+export const GetScheduleRequestSchema: GenMessage<GetScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 47);
+
+/**
+ * @generated from message admin.UpdateScheduleRequest
+ */
+export type UpdateScheduleRequest = Message<"admin.UpdateScheduleRequest"> & {
+  /**
+   * @generated from field: admin.Schedule schedule = 1;
+   */
+  schedule?: Schedule;
+
+  /**
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message admin.UpdateScheduleRequest.
+ * Use `create(UpdateScheduleRequestSchema)` to create a new message.
+ */
+export const UpdateScheduleRequestSchema: GenMessage<UpdateScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 48);
+
+/**
+ * @generated from message admin.CreateAppointmentTypeRequest
+ */
+export type CreateAppointmentTypeRequest = Message<"admin.CreateAppointmentTypeRequest"> & {
+  /**
+   * @generated from field: appointment.AppointmentType appointment_type = 1;
+   */
+  appointmentType?: AppointmentType;
+};
+
+/**
+ * Describes the message admin.CreateAppointmentTypeRequest.
+ * Use `create(CreateAppointmentTypeRequestSchema)` to create a new message.
+ */
+export const CreateAppointmentTypeRequestSchema: GenMessage<CreateAppointmentTypeRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 49);
+
+/**
+ * @generated from message admin.UpdateAppointmentTypeRequest
+ */
+export type UpdateAppointmentTypeRequest = Message<"admin.UpdateAppointmentTypeRequest"> & {
+  /**
+   * @generated from field: appointment.AppointmentType appointment_type = 1;
+   */
+  appointmentType?: AppointmentType;
+
+  /**
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message admin.UpdateAppointmentTypeRequest.
+ * Use `create(UpdateAppointmentTypeRequestSchema)` to create a new message.
+ */
+export const UpdateAppointmentTypeRequestSchema: GenMessage<UpdateAppointmentTypeRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 50);
+
+/**
+ * Types that have been booked cannot be deleted; deactivate them instead.
+ *
+ * @generated from message admin.DeleteAppointmentTypeRequest
+ */
+export type DeleteAppointmentTypeRequest = Message<"admin.DeleteAppointmentTypeRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message admin.DeleteAppointmentTypeRequest.
+ * Use `create(DeleteAppointmentTypeRequestSchema)` to create a new message.
+ */
+export const DeleteAppointmentTypeRequestSchema: GenMessage<DeleteAppointmentTypeRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 51);
+
+/**
+ * @generated from message admin.DeleteAppointmentTypeResponse
+ */
+export type DeleteAppointmentTypeResponse = Message<"admin.DeleteAppointmentTypeResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message admin.DeleteAppointmentTypeResponse.
+ * Use `create(DeleteAppointmentTypeResponseSchema)` to create a new message.
+ */
+export const DeleteAppointmentTypeResponseSchema: GenMessage<DeleteAppointmentTypeResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 52);
+
+/**
+ * Cancels an appointment as its provider, which the cancellation cutoff does
+ * not stop.
+ *
+ * @generated from message admin.CancelAppointmentRequest
+ */
+export type CancelAppointmentRequest = Message<"admin.CancelAppointmentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: appointment.CancellationReason reason = 2;
+   */
+  reason: CancellationReason;
+
+  /**
+   * @generated from field: string note = 3;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message admin.CancelAppointmentRequest.
+ * Use `create(CancelAppointmentRequestSchema)` to create a new message.
+ */
+export const CancelAppointmentRequestSchema: GenMessage<CancelAppointmentRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 53);
+
+/**
+ * Counts the late cancellations of a user since a time, or ever without one.
+ * Restoring an appointment does not take back its late cancellation.
+ *
+ * @generated from message admin.CountLateCancellationsRequest
+ */
+export type CountLateCancellationsRequest = Message<"admin.CountLateCancellationsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 2;
+   */
+  since?: Timestamp;
+};
+
+/**
+ * Describes the message admin.CountLateCancellationsRequest.
+ * Use `create(CountLateCancellationsRequestSchema)` to create a new message.
+ */
+export const CountLateCancellationsRequestSchema: GenMessage<CountLateCancellationsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 54);
+
+/**
+ * @generated from message admin.CountLateCancellationsResponse
+ */
+export type CountLateCancellationsResponse = Message<"admin.CountLateCancellationsResponse"> & {
+  /**
+   * @generated from field: int32 count = 1;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message admin.CountLateCancellationsResponse.
+ * Use `create(CountLateCancellationsResponseSchema)` to create a new message.
+ */
+export const CountLateCancellationsResponseSchema: GenMessage<CountLateCancellationsResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 55);
+
+/**
+ * @generated from enum admin.WebhookDeliveryStatus
+ */
+export enum WebhookDeliveryStatus {
+  /**
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum admin.WebhookDeliveryStatus.
+ */
+export const WebhookDeliveryStatusSchema: GenEnum<WebhookDeliveryStatus> = /*@__PURE__*/
+  enumDesc(file_admin, 0);
+
+/**
+ * @generated from enum admin.PurgeMode
+ */
+export enum PurgeMode {
+  /**
+   * @generated from enum value: PURGE_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PURGE_MODE_DELETE = 1;
+   */
+  DELETE = 1,
+
+  /**
+   * Moves purged appointments to the archived_appointments table.
+   *
+   * @generated from enum value: PURGE_MODE_ARCHIVE = 2;
+   */
+  ARCHIVE = 2,
+}
+
+/**
+ * Describes the enum admin.PurgeMode.
+ */
+export const PurgeModeSchema: GenEnum<PurgeMode> = /*@__PURE__*/
+  enumDesc(file_admin, 1);
+
+/**
+ * @generated from enum admin.ExportFormat
+ */
+export enum ExportFormat {
+  /**
+   * @generated from enum value: EXPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_JSON = 1;
+   */
+  JSON = 1,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_ZIP = 2;
+   */
+  ZIP = 2,
+}
+
+/**
+ * Describes the enum admin.ExportFormat.
+ */
+export const ExportFormatSchema: GenEnum<ExportFormat> = /*@__PURE__*/
+  enumDesc(file_admin, 2);
+
+/**
+ * @generated from enum admin.DenylistKind
+ */
+export enum DenylistKind {
+  /**
+   * @generated from enum value: DENYLIST_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DENYLIST_KIND_EMAIL = 1;
+   */
+  EMAIL = 1,
+
+  /**
+   * @generated from enum value: DENYLIST_KIND_IP = 2;
+   */
+  IP = 2,
+}
+
+/**
+ * Describes the enum admin.DenylistKind.
+ */
+export const DenylistKindSchema: GenEnum<DenylistKind> = /*@__PURE__*/
+  enumDesc(file_admin, 3);
+
+/**
+ * @generated from service admin.AdminService
+ */
+export const AdminService: GenService<{
+  /**
+   * @generated from rpc admin.AdminService.AddDenylistEntry
+   */
+  addDenylistEntry: {
+    methodKind: "unary";
+    input: typeof AddDenylistEntryRequestSchema;
+    output: typeof DenylistEntrySchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.RemoveDenylistEntry
+   */
+  removeDenylistEntry: {
+    methodKind: "unary";
+    input: typeof RemoveDenylistEntryRequestSchema;
+    output: typeof RemoveDenylistEntryResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ListDenylistEntries
+   */
+  listDenylistEntries: {
+    methodKind: "unary";
+    input: typeof ListDenylistEntriesRequestSchema;
+    output: typeof ListDenylistEntriesResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ListAppointments
+   */
+  listAppointments: {
+    methodKind: "unary";
+    input: typeof ListAppointmentsRequestSchema;
+    output: typeof ListAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.CreateWebhook
+   */
+  createWebhook: {
+    methodKind: "unary";
+    input: typeof CreateWebhookRequestSchema;
+    output: typeof CreateWebhookResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ListWebhooks
+   */
+  listWebhooks: {
+    methodKind: "unary";
+    input: typeof ListWebhooksRequestSchema;
+    output: typeof ListWebhooksResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.UpdateWebhook
+   */
+  updateWebhook: {
+    methodKind: "unary";
+    input: typeof UpdateWebhookRequestSchema;
+    output: typeof WebhookSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.DeleteWebhook
+   */
+  deleteWebhook: {
+    methodKind: "unary";
+    input: typeof DeleteWebhookRequestSchema;
+    output: typeof DeleteWebhookResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ListWebhookDeliveries
+   */
+  listWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListWebhookDeliveriesRequestSchema;
+    output: typeof ListWebhookDeliveriesResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.RedeliverWebhook
+   */
+  redeliverWebhook: {
+    methodKind: "unary";
+    input: typeof RedeliverWebhookRequestSchema;
+    output: typeof WebhookDeliverySchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ListOutboxSinks
+   */
+  listOutboxSinks: {
+    methodKind: "unary";
+    input: typeof ListOutboxSinksRequestSchema;
+    output: typeof ListOutboxSinksResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ListOutboxEvents
+   */
+  listOutboxEvents: {
+    methodKind: "unary";
+    input: typeof ListOutboxEventsRequestSchema;
+    output: typeof ListOutboxEventsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ReplayOutboxEvents
+   */
+  replayOutboxEvents: {
+    methodKind: "unary";
+    input: typeof ReplayOutboxEventsRequestSchema;
+    output: typeof OutboxSinkSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.SkipOutboxEvent
+   */
+  skipOutboxEvent: {
+    methodKind: "unary";
+    input: typeof SkipOutboxEventRequestSchema;
+    output: typeof OutboxSinkSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.VerifyAuditLog
+   */
+  verifyAuditLog: {
+    methodKind: "unary";
+    input: typeof VerifyAuditLogRequestSchema;
+    output: typeof VerifyAuditLogResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.GetAppointmentAsOf
+   */
+  getAppointmentAsOf: {
+    methodKind: "unary";
+    input: typeof GetAppointmentAsOfRequestSchema;
+    output: typeof AppointmentSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.PurgeDeletedAppointments
+   */
+  purgeDeletedAppointments: {
+    methodKind: "unary";
+    input: typeof PurgeDeletedAppointmentsRequestSchema;
+    output: typeof PurgeDeletedAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.SetLegalHold
+   */
+  setLegalHold: {
+    methodKind: "unary";
+    input: typeof SetLegalHoldRequestSchema;
+    output: typeof LegalHoldSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ListLegalHolds
+   */
+  listLegalHolds: {
+    methodKind: "unary";
+    input: typeof ListLegalHoldsRequestSchema;
+    output: typeof ListLegalHoldsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.ExportUserData
+   */
+  exportUserData: {
+    methodKind: "unary";
+    input: typeof ExportUserDataRequestSchema;
+    output: typeof ExportUserDataResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.EraseUser
+   */
+  eraseUser: {
+    methodKind: "unary";
+    input: typeof EraseUserRequestSchema;
+    output: typeof EraseUserResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.GetSchedule
+   */
+  getSchedule: {
+    methodKind: "unary";
+    input: typeof GetScheduleRequestSchema;
+    output: typeof ScheduleSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.UpdateSchedule
+   */
+  updateSchedule: {
+    methodKind: "unary";
+    input: typeof UpdateScheduleRequestSchema;
+    output: typeof ScheduleSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.CreateAppointmentType
+   */
+  createAppointmentType: {
+    methodKind: "unary";
+    input: typeof CreateAppointmentTypeRequestSchema;
+    output: typeof AppointmentTypeSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.UpdateAppointmentType
+   */
+  updateAppointmentType: {
+    methodKind: "unary";
+    input: typeof UpdateAppointmentTypeRequestSchema;
+    output: typeof AppointmentTypeSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.DeleteAppointmentType
+   */
+  deleteAppointmentType: {
+    methodKind: "unary";
+    input: typeof DeleteAppointmentTypeRequestSchema;
+    output: typeof DeleteAppointmentTypeResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.CancelAppointment
+   */
+  cancelAppointment: {
+    methodKind: "unary";
+    input: typeof CancelAppointmentRequestSchema;
+    output: typeof AppointmentSchema;
+  },
+  /**
+   * @generated from rpc admin.AdminService.CountLateCancellations
+   */
+  countLateCancellations: {
+    methodKind: "unary";
+    input: typeof CountLateCancellationsRequestSchema;
+    output: typeof CountLateCancellationsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_admin, 0);
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { Appointment, CreateAppointmentRequest, DeleteAppointmentRequest, DeleteAppointmentResponse, GetAppointmentRequest, GetUserAppointmentRequest, GetUserAppointmentResponse, UpdateAppointmentRequest } from "./appointment_pb.js";
+import { AcceptWaitlistOfferRequest, AcceptWaitlistOfferResponse, Appointment, AppointmentType, ConfirmHoldRequest, CreateAppointmentRequest, DeclineWaitlistOfferRequest, DeleteAppointmentRequest, DeleteAppointmentResponse, GetAppointmentRequest, GetAppointmentTypeRequest, GetGroupSessionRosterRequest, GetGroupSessionRosterResponse, GetUserAppointmentRequest, GetUserAppointmentResponse, GetWaitlistEntryRequest, GroupSession, HoldSlotRequest, ImportCalendarRequest, ImportCalendarResponse, JoinGroupSessionRequest, JoinGroupSessionResponse, JoinWaitlistRequest, LeaveGroupSessionRequest, LeaveWaitlistRequest, ListAppointmentTypesRequest, ListAppointmentTypesResponse, ListDeletedAppointmentsRequest, ListDeletedAppointmentsResponse, ListGroupSessionsRequest, ListGroupSessionsResponse, ListReschedulesRequest, ListReschedulesResponse, Participant, RescheduleAppointmentRequest, RestoreAppointmentRequest, SearchAppointmentsRequest, SearchAppointmentsResponse, SlotHold, UpdateAppointmentRequest, UpdateRsvpStatusRequest, WaitlistEntry } from "./appointment_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -57,6 +57,186 @@ export const AppointmentService = {
       O: DeleteAppointmentResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc appointment.AppointmentService.SearchAppointments
+     */
+    searchAppointments: {
+      name: "SearchAppointments",
+      I: SearchAppointmentsRequest,
+      O: SearchAppointmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.ImportCalendar
+     */
+    importCalendar: {
+      name: "ImportCalendar",
+      I: ImportCalendarRequest,
+      O: ImportCalendarResponse,
+      kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.ListDeletedAppointments
+     */
+    listDeletedAppointments: {
+      name: "ListDeletedAppointments",
+      I: ListDeletedAppointmentsRequest,
+      O: ListDeletedAppointmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.RestoreAppointment
+     */
+    restoreAppointment: {
+      name: "RestoreAppointment",
+      I: RestoreAppointmentRequest,
+      O: Appointment,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.ListAppointmentTypes
+     */
+    listAppointmentTypes: {
+      name: "ListAppointmentTypes",
+      I: ListAppointmentTypesRequest,
+      O: ListAppointmentTypesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.GetAppointmentType
+     */
+    getAppointmentType: {
+      name: "GetAppointmentType",
+      I: GetAppointmentTypeRequest,
+      O: AppointmentType,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.ListGroupSessions
+     */
+    listGroupSessions: {
+      name: "ListGroupSessions",
+      I: ListGroupSessionsRequest,
+      O: ListGroupSessionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.JoinGroupSession
+     */
+    joinGroupSession: {
+      name: "JoinGroupSession",
+      I: JoinGroupSessionRequest,
+      O: JoinGroupSessionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.LeaveGroupSession
+     */
+    leaveGroupSession: {
+      name: "LeaveGroupSession",
+      I: LeaveGroupSessionRequest,
+      O: GroupSession,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.GetGroupSessionRoster
+     */
+    getGroupSessionRoster: {
+      name: "GetGroupSessionRoster",
+      I: GetGroupSessionRosterRequest,
+      O: GetGroupSessionRosterResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.UpdateRsvpStatus
+     */
+    updateRsvpStatus: {
+      name: "UpdateRsvpStatus",
+      I: UpdateRsvpStatusRequest,
+      O: Participant,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.JoinWaitlist
+     */
+    joinWaitlist: {
+      name: "JoinWaitlist",
+      I: JoinWaitlistRequest,
+      O: WaitlistEntry,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.GetWaitlistEntry
+     */
+    getWaitlistEntry: {
+      name: "GetWaitlistEntry",
+      I: GetWaitlistEntryRequest,
+      O: WaitlistEntry,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.LeaveWaitlist
+     */
+    leaveWaitlist: {
+      name: "LeaveWaitlist",
+      I: LeaveWaitlistRequest,
+      O: WaitlistEntry,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.AcceptWaitlistOffer
+     */
+    acceptWaitlistOffer: {
+      name: "AcceptWaitlistOffer",
+      I: AcceptWaitlistOfferRequest,
+      O: AcceptWaitlistOfferResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.DeclineWaitlistOffer
+     */
+    declineWaitlistOffer: {
+      name: "DeclineWaitlistOffer",
+      I: DeclineWaitlistOfferRequest,
+      O: WaitlistEntry,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.HoldSlot
+     */
+    holdSlot: {
+      name: "HoldSlot",
+      I: HoldSlotRequest,
+      O: SlotHold,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.ConfirmHold
+     */
+    confirmHold: {
+      name: "ConfirmHold",
+      I: ConfirmHoldRequest,
+      O: Appointment,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.RescheduleAppointment
+     */
+    rescheduleAppointment: {
+      name: "RescheduleAppointment",
+      I: RescheduleAppointmentRequest,
+      O: Appointment,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc appointment.AppointmentService.ListReschedules
+     */
+    listReschedules: {
+      name: "ListReschedules",
+      I: ListReschedulesRequest,
+      O: ListReschedulesResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -2,17 +2,17 @@
 // @generated from file appointment.proto (package appointment, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Duration, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file appointment.proto.
  */
 export const file_appointment: GenFile = /*@__PURE__*/
-  fileDesc("ChFhcHBvaW50bWVudC5wcm90bxILYXBwb2ludG1lbnQixAIKC0FwcG9pbnRtZW50EgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSPAoTY29udGFjdF9pbmZvcm1hdGlvbhgEIAEoCzIfLmFwcG9pbnRtZW50LkNvbnRhY3RJbmZvcm1hdGlvbhIuCgpzdGFydF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFdGl0bGUYByABKAkSKAoEZGF0ZRgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZGVsZXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiIwoVR2V0QXBwb2ludG1lbnRSZXF1ZXN0EgoKAmlkGAEgASgJIiwKGUdldFVzZXJBcHBvaW50bWVudFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJMChpHZXRVc2VyQXBwb2ludG1lbnRSZXNwb25zZRIuCgxhcHBvaW50bWVudHMYASADKAsyGC5hcHBvaW50bWVudC5BcHBvaW50bWVudCKVAgoYQ3JlYXRlQXBwb2ludG1lbnRSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSPAoTY29udGFjdF9pbmZvcm1hdGlvbhgCIAEoCzIfLmFwcG9pbnRtZW50LkNvbnRhY3RJbmZvcm1hdGlvbhIuCgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLZGVzY3JpcHRpb24YBSABKAkSDQoFdGl0bGUYBiABKAkSKAoEZGF0ZRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiegoYVXBkYXRlQXBwb2ludG1lbnRSZXF1ZXN0Ei0KC2FwcG9pbnRtZW50GAEgASgLMhguYXBwb2ludG1lbnQuQXBwb2ludG1lbnQSLwoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrIiYKGERlbGV0ZUFwcG9pbnRtZW50UmVxdWVzdBIKCgJpZBgBIAEoCSIsChlEZWxldGVBcHBvaW50bWVudFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiMQoSQ29udGFjdEluZm9ybWF0aW9uEgwKBG5hbWUYASABKAkSDQoFZW1haWwYAiABKAky3AMKEkFwcG9pbnRtZW50U2VydmljZRJOCg5HZXRBcHBvaW50bWVudBIiLmFwcG9pbnRtZW50LkdldEFwcG9pbnRtZW50UmVxdWVzdBoYLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50EmYKE0dldFVzZXJBcHBvaW50bWVudHMSJi5hcHBvaW50bWVudC5HZXRVc2VyQXBwb2ludG1lbnRSZXF1ZXN0GicuYXBwb2ludG1lbnQuR2V0VXNlckFwcG9pbnRtZW50UmVzcG9uc2USVAoRQ3JlYXRlQXBwb2ludG1lbnQSJS5hcHBvaW50bWVudC5DcmVhdGVBcHBvaW50bWVudFJlcXVlc3QaGC5hcHBvaW50bWVudC5BcHBvaW50bWVudBJUChFVcGRhdGVBcHBvaW50bWVudBIlLmFwcG9pbnRtZW50LlVwZGF0ZUFwcG9pbnRtZW50UmVxdWVzdBoYLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50EmIKEURlbGV0ZUFwcG9pbnRtZW50EiUuYXBwb2ludG1lbnQuRGVsZXRlQXBwb2ludG1lbnRSZXF1ZXN0GiYuYXBwb2ludG1lbnQuRGVsZXRlQXBwb2ludG1lbnRSZXNwb25zZUIxWi9naXRodWIuY29tL2ZvbHVjb2RlL2FwcG9pbnRtZW50LXNjaGVkdWxlci9wcm90b2IGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_field_mask]);
+  fileDesc("ChFhcHBvaW50bWVudC5wcm90bxILYXBwb2ludG1lbnQiqgUKC0FwcG9pbnRtZW50EgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSPAoTY29udGFjdF9pbmZvcm1hdGlvbhgEIAEoCzIfLmFwcG9pbnRtZW50LkNvbnRhY3RJbmZvcm1hdGlvbhIuCgpzdGFydF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFdGl0bGUYByABKAkSKAoEZGF0ZRgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZGVsZXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJdGltZV96b25lGAwgASgJEjAKDWJ1ZmZlcl9iZWZvcmUYDSABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SLwoMYnVmZmVyX2FmdGVyGA4gASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEhsKE2FwcG9pbnRtZW50X3R5cGVfaWQYDyABKAkSEAoIY2FwYWNpdHkYECABKAUSLgoMcGFydGljaXBhbnRzGBEgAygLMhguYXBwb2ludG1lbnQuUGFydGljaXBhbnQSLwoMY2FuY2VsbGF0aW9uGBIgASgLMhkuYXBwb2ludG1lbnQuQ2FuY2VsbGF0aW9uIiMKFUdldEFwcG9pbnRtZW50UmVxdWVzdBIKCgJpZBgBIAEoCSKHAgoZR2V0VXNlckFwcG9pbnRtZW50UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJEigKBGZyb20YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiYKAnRvGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCgVzY29wZRgGIAEoDjIdLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50U2NvcGUSMgoOc29ydF9kaXJlY3Rpb24YByABKA4yGi5hcHBvaW50bWVudC5Tb3J0RGlyZWN0aW9uImUKGkdldFVzZXJBcHBvaW50bWVudFJlc3BvbnNlEi4KDGFwcG9pbnRtZW50cxgBIAMoCzIYLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSL/AgoYQ3JlYXRlQXBwb2ludG1lbnRSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSPAoTY29udGFjdF9pbmZvcm1hdGlvbhgCIAEoCzIfLmFwcG9pbnRtZW50LkNvbnRhY3RJbmZvcm1hdGlvbhIuCgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLZGVzY3JpcHRpb24YBSABKAkSDQoFdGl0bGUYBiABKAkSKAoEZGF0ZRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJdGltZV96b25lGAggASgJEhsKE2FwcG9pbnRtZW50X3R5cGVfaWQYCSABKAkSEAoIY2FwYWNpdHkYCiABKAUSJgoIaW52aXRlZXMYCyADKAsyFC5hcHBvaW50bWVudC5JbnZpdGVlInoKGFVwZGF0ZUFwcG9pbnRtZW50UmVxdWVzdBItCgthcHBvaW50bWVudBgBIAEoCzIYLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50Ei8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzayJlChhEZWxldGVBcHBvaW50bWVudFJlcXVlc3QSCgoCaWQYASABKAkSLwoGcmVhc29uGAIgASgOMh8uYXBwb2ludG1lbnQuQ2FuY2VsbGF0aW9uUmVhc29uEgwKBG5vdGUYAyABKAkiWwoZRGVsZXRlQXBwb2ludG1lbnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEi0KC2FwcG9pbnRtZW50GAIgASgLMhguYXBwb2ludG1lbnQuQXBwb2ludG1lbnQiWAoeTGlzdERlbGV0ZWRBcHBvaW50bWVudHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiagofTGlzdERlbGV0ZWRBcHBvaW50bWVudHNSZXNwb25zZRIuCgxhcHBvaW50bWVudHMYASADKAsyGC5hcHBvaW50bWVudC5BcHBvaW50bWVudBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiJwoZUmVzdG9yZUFwcG9pbnRtZW50UmVxdWVzdBIKCgJpZBgBIAEoCSJiChlTZWFyY2hBcHBvaW50bWVudHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDQoFcXVlcnkYAiABKAkSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkibAoaU2VhcmNoQXBwb2ludG1lbnRzUmVzcG9uc2USNQoHcmVzdWx0cxgBIAMoCzIkLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50U2VhcmNoUmVzdWx0EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKKAQoXQXBwb2ludG1lbnRTZWFyY2hSZXN1bHQSLQoLYXBwb2ludG1lbnQYASABKAsyGC5hcHBvaW50bWVudC5BcHBvaW50bWVudBIMCgRyYW5rGAIgASgCEhUKDXRpdGxlX3NuaXBwZXQYAyABKAkSGwoTZGVzY3JpcHRpb25fc25pcHBldBgEIAEoCSJIChVJbXBvcnRDYWxlbmRhclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIPCgdkcnlfcnVuGAIgASgIEg0KBWNodW5rGAMgASgMIqMBChZJbXBvcnRDYWxlbmRhclJlc3BvbnNlEi8KB3Jlc3VsdHMYASADKAsyHi5hcHBvaW50bWVudC5JbXBvcnRFdmVudFJlc3VsdBIWCg5pbXBvcnRlZF9jb3VudBgCIAEoBRIXCg9kdXBsaWNhdGVfY291bnQYAyABKAUSFgoOcmVqZWN0ZWRfY291bnQYBCABKAUSDwoHZHJ5X3J1bhgFIAEoCCKKAQoRSW1wb3J0RXZlbnRSZXN1bHQSCwoDdWlkGAEgASgJEg8KB3N1bW1hcnkYAiABKAkSLgoGc3RhdHVzGAMgASgOMh4uYXBwb2ludG1lbnQuSW1wb3J0RXZlbnRTdGF0dXMSFgoOYXBwb2ludG1lbnRfaWQYBCABKAkSDwoHbWVzc2FnZRgFIAEoCSIxChJDb250YWN0SW5mb3JtYXRpb24SDAoEbmFtZRgBIAEoCRINCgVlbWFpbBgCIAEoCSJSChdCb29raW5nUG9saWN5VmlvbGF0aW9ucxI3Cgp2aW9sYXRpb25zGAEgAygLMiMuYXBwb2ludG1lbnQuQm9va2luZ1BvbGljeVZpb2xhdGlvbiJbChZCb29raW5nUG9saWN5VmlvbGF0aW9uEiwKBHJ1bGUYASABKA4yHi5hcHBvaW50bWVudC5Cb29raW5nUG9saWN5UnVsZRITCgtkZXNjcmlwdGlvbhgCIAEoCSLPAgoPQXBwb2ludG1lbnRUeXBlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSKwoIZHVyYXRpb24YBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SMAoNYnVmZmVyX2JlZm9yZRgFIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIvCgxidWZmZXJfYWZ0ZXIYBiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SDQoFY29sb3IYByABKAkSDgoGYWN0aXZlGAggASgIEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjcKG0xpc3RBcHBvaW50bWVudFR5cGVzUmVxdWVzdBIYChBpbmNsdWRlX2luYWN0aXZlGAEgASgIIlcKHExpc3RBcHBvaW50bWVudFR5cGVzUmVzcG9uc2USNwoRYXBwb2ludG1lbnRfdHlwZXMYASADKAsyHC5hcHBvaW50bWVudC5BcHBvaW50bWVudFR5cGUiJwoZR2V0QXBwb2ludG1lbnRUeXBlUmVxdWVzdBIKCgJpZBgBIAEoCSJuCgxHcm91cFNlc3Npb24SLQoLYXBwb2ludG1lbnQYASABKAsyGC5hcHBvaW50bWVudC5BcHBvaW50bWVudBIWCg5hdHRlbmRlZV9jb3VudBgCIAEoBRIXCg9zZWF0c19yZW1haW5pbmcYAyABKAUibgoPU2Vzc2lvbkF0dGVuZGVlEg8KB3VzZXJfaWQYASABKAkSDAoEbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRItCglqb2luZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqsBChhMaXN0R3JvdXBTZXNzaW9uc1JlcXVlc3QSKAoEZnJvbRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJgoCdG8YAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhYKDmF2YWlsYWJsZV9vbmx5GAMgASgIEhEKCXBhZ2Vfc2l6ZRgEIAEoBRISCgpwYWdlX3Rva2VuGAUgASgJImEKGUxpc3RHcm91cFNlc3Npb25zUmVzcG9uc2USKwoIc2Vzc2lvbnMYASADKAsyGS5hcHBvaW50bWVudC5Hcm91cFNlc3Npb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIm8KF0pvaW5Hcm91cFNlc3Npb25SZXF1ZXN0EhYKDmFwcG9pbnRtZW50X2lkGAEgASgJEjwKE2NvbnRhY3RfaW5mb3JtYXRpb24YAiABKAsyHy5hcHBvaW50bWVudC5Db250YWN0SW5mb3JtYXRpb24idgoYSm9pbkdyb3VwU2Vzc2lvblJlc3BvbnNlEioKB3Nlc3Npb24YASABKAsyGS5hcHBvaW50bWVudC5Hcm91cFNlc3Npb24SLgoIYXR0ZW5kZWUYAiABKAsyHC5hcHBvaW50bWVudC5TZXNzaW9uQXR0ZW5kZWUiQwoYTGVhdmVHcm91cFNlc3Npb25SZXF1ZXN0EhYKDmFwcG9pbnRtZW50X2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiNgocR2V0R3JvdXBTZXNzaW9uUm9zdGVyUmVxdWVzdBIWCg5hcHBvaW50bWVudF9pZBgBIAEoCSJ8Ch1HZXRHcm91cFNlc3Npb25Sb3N0ZXJSZXNwb25zZRIqCgdzZXNzaW9uGAEgASgLMhkuYXBwb2ludG1lbnQuR3JvdXBTZXNzaW9uEi8KCWF0dGVuZGVlcxgCIAMoCzIcLmFwcG9pbnRtZW50LlNlc3Npb25BdHRlbmRlZSJzCgdJbnZpdGVlEjwKE2NvbnRhY3RfaW5mb3JtYXRpb24YASABKAsyHy5hcHBvaW50bWVudC5Db250YWN0SW5mb3JtYXRpb24SKgoEcm9sZRgCIAEoDjIcLmFwcG9pbnRtZW50LlBhcnRpY2lwYW50Um9sZSLHAQoLUGFydGljaXBhbnQSDwoHdXNlcl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBWVtYWlsGAMgASgJEioKBHJvbGUYBCABKA4yHC5hcHBvaW50bWVudC5QYXJ0aWNpcGFudFJvbGUSLAoLcnN2cF9zdGF0dXMYBSABKA4yFy5hcHBvaW50bWVudC5Sc3ZwU3RhdHVzEjAKDHJlc3BvbmRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAicAoXVXBkYXRlUnN2cFN0YXR1c1JlcXVlc3QSFgoOYXBwb2ludG1lbnRfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIsCgtyc3ZwX3N0YXR1cxgDIAEoDjIXLmFwcG9pbnRtZW50LlJzdnBTdGF0dXMi0wMKDVdhaXRsaXN0RW50cnkSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRI8ChNjb250YWN0X2luZm9ybWF0aW9uGAMgASgLMh8uYXBwb2ludG1lbnQuQ29udGFjdEluZm9ybWF0aW9uEjAKDHdpbmRvd19zdGFydBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKwoGc3RhdHVzGAYgASgOMhsuYXBwb2ludG1lbnQuV2FpdGxpc3RTdGF0dXMSKQoFb2ZmZXIYByABKAsyGi5hcHBvaW50bWVudC5XYWl0bGlzdE9mZmVyEhYKDmFwcG9pbnRtZW50X2lkGAggASgJEhEKCXRpbWVfem9uZRgJIAEoCRIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgV0aXRsZRgMIAEoCRITCgtkZXNjcmlwdGlvbhgNIAEoCSLPAgoNV2FpdGxpc3RPZmZlchIKCgJpZBgBIAEoCRIQCghlbnRyeV9pZBgCIAEoCRIuCgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoGc3RhdHVzGAUgASgOMiAuYXBwb2ludG1lbnQuV2FpdGxpc3RPZmZlclN0YXR1cxIuCgpleHBpcmVzX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxyZXNwb25kZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wItkBChNKb2luV2FpdGxpc3RSZXF1ZXN0EjwKE2NvbnRhY3RfaW5mb3JtYXRpb24YASABKAsyHy5hcHBvaW50bWVudC5Db250YWN0SW5mb3JtYXRpb24SMAoMd2luZG93X3N0YXJ0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgV0aXRsZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCSIlChdHZXRXYWl0bGlzdEVudHJ5UmVxdWVzdBIKCgJpZBgBIAEoCSIiChRMZWF2ZVdhaXRsaXN0UmVxdWVzdBIKCgJpZBgBIAEoCSIuChpBY2NlcHRXYWl0bGlzdE9mZmVyUmVxdWVzdBIQCghvZmZlcl9pZBgBIAEoCSJ3ChtBY2NlcHRXYWl0bGlzdE9mZmVyUmVzcG9uc2USKQoFZW50cnkYASABKAsyGi5hcHBvaW50bWVudC5XYWl0bGlzdEVudHJ5Ei0KC2FwcG9pbnRtZW50GAIgASgLMhguYXBwb2ludG1lbnQuQXBwb2ludG1lbnQiLwobRGVjbGluZVdhaXRsaXN0T2ZmZXJSZXF1ZXN0EhAKCG9mZmVyX2lkGAEgASgJIqMCCghTbG90SG9sZBIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEjwKE2NvbnRhY3RfaW5mb3JtYXRpb24YAyABKAsyHy5hcHBvaW50bWVudC5Db250YWN0SW5mb3JtYXRpb24SLgoKc3RhcnRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmV4cGlyZXNfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wItoBCg9Ib2xkU2xvdFJlcXVlc3QSPAoTY29udGFjdF9pbmZvcm1hdGlvbhgBIAEoCzIfLmFwcG9pbnRtZW50LkNvbnRhY3RJbmZvcm1hdGlvbhIuCgpzdGFydF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJdGltZV96b25lGAQgASgJEhgKEHJlcGxhY2VzX2hvbGRfaWQYBSABKAkiggEKEkNvbmZpcm1Ib2xkUmVxdWVzdBIPCgdob2xkX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEiYKCGludml0ZWVzGAQgAygLMhQuYXBwb2ludG1lbnQuSW52aXRlZRIPCgd1c2VyX2lkGAUgASgJIpgBChxSZXNjaGVkdWxlQXBwb2ludG1lbnRSZXF1ZXN0EgoKAmlkGAEgASgJEi4KCnN0YXJ0X3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZyZWFzb24YBCABKAkizQIKClJlc2NoZWR1bGUSCgoCaWQYASABKAkSFgoOYXBwb2ludG1lbnRfaWQYAiABKAkSNwoTcHJldmlvdXNfc3RhcnRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoRcHJldmlvdXNfZW5kX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnN0YXJ0X3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVhY3RvchgHIAEoCRIOCgZyZWFzb24YCCABKAkSLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiMAoWTGlzdFJlc2NoZWR1bGVzUmVxdWVzdBIWCg5hcHBvaW50bWVudF9pZBgBIAEoCSJHChdMaXN0UmVzY2hlZHVsZXNSZXNwb25zZRIsCgtyZXNjaGVkdWxlcxgBIAMoCzIXLmFwcG9pbnRtZW50LlJlc2NoZWR1bGUiiwEKDENhbmNlbGxhdGlvbhIvCgZyZWFzb24YASABKA4yHy5hcHBvaW50bWVudC5DYW5jZWxsYXRpb25SZWFzb24SDAoEbm90ZRgCIAEoCRIuCgxjYW5jZWxsZWRfYnkYAyABKA4yGC5hcHBvaW50bWVudC5DYW5jZWxsZWRCeRIMCgRsYXRlGAQgASgIKowBChBBcHBvaW50bWVudFNjb3BlEiEKHUFQUE9JTlRNRU5UX1NDT1BFX1VOU1BFQ0lGSUVEEAASGQoVQVBQT0lOVE1FTlRfU0NPUEVfQUxMEAESHgoaQVBQT0lOVE1FTlRfU0NPUEVfVVBDT01JTkcQAhIaChZBUFBPSU5UTUVOVF9TQ09QRV9QQVNUEAMqbAoNU29ydERpcmVjdGlvbhIeChpTT1JUX0RJUkVDVElPTl9VTlNQRUNJRklFRBAAEhwKGFNPUlRfRElSRUNUSU9OX0FTQ0VORElORxABEh0KGVNPUlRfRElSRUNUSU9OX0RFU0NFTkRJTkcQAirAAQoRSW1wb3J0RXZlbnRTdGF0dXMSIwofSU1QT1JUX0VWRU5UX1NUQVRVU19VTlNQRUNJRklFRBAAEiAKHElNUE9SVF9FVkVOVF9TVEFUVVNfSU1QT1JURUQQARIhCh1JTVBPUlRfRVZFTlRfU1RBVFVTX0RVUExJQ0FURRACEiAKHElNUE9SVF9FVkVOVF9TVEFUVVNfQ09ORkxJQ1QQAxIfChtJTVBPUlRfRVZFTlRfU1RBVFVTX0lOVkFMSUQQBCrAAgoRQm9va2luZ1BvbGljeVJ1bGUSIwofQk9PS0lOR19QT0xJQ1lfUlVMRV9VTlNQRUNJRklFRBAAEiIKHkJPT0tJTkdfUE9MSUNZX1JVTEVfTUlOX05PVElDRRABEiMKH0JPT0tJTkdfUE9MSUNZX1JVTEVfTUFYX0FEVkFOQ0UQAhIkCiBCT09LSU5HX1BPTElDWV9SVUxFX01JTl9EVVJBVElPThADEiQKIEJPT0tJTkdfUE9MSUNZX1JVTEVfTUFYX0RVUkFUSU9OEAQSJgoiQk9PS0lOR19QT0xJQ1lfUlVMRV9TTE9UX0FMSUdOTUVOVBAFEiMKH0JPT0tJTkdfUE9MSUNZX1JVTEVfTUFYX1BFUl9EQVkQBhIkCiBCT09LSU5HX1BPTElDWV9SVUxFX01BWF9QRVJfV0VFSxAHKpEBCg9QYXJ0aWNpcGFudFJvbGUSIAocUEFSVElDSVBBTlRfUk9MRV9VTlNQRUNJRklFRBAAEh4KGlBBUlRJQ0lQQU5UX1JPTEVfT1JHQU5JWkVSEAESHQoZUEFSVElDSVBBTlRfUk9MRV9SRVFVSVJFRBACEh0KGVBBUlRJQ0lQQU5UX1JPTEVfT1BUSU9OQUwQAyqWAQoKUnN2cFN0YXR1cxIbChdSU1ZQX1NUQVRVU19VTlNQRUNJRklFRBAAEhwKGFJTVlBfU1RBVFVTX05FRURTX0FDVElPThABEhgKFFJTVlBfU1RBVFVTX0FDQ0VQVEVEEAISGAoUUlNWUF9TVEFUVVNfREVDTElORUQQAxIZChVSU1ZQX1NUQVRVU19URU5UQVRJVkUQBCqJAQoOV2FpdGxpc3RTdGF0dXMSHwobV0FJVExJU1RfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGwoXV0FJVExJU1RfU1RBVFVTX1dBSVRJTkcQARIaChZXQUlUTElTVF9TVEFUVVNfQk9PS0VEEAISHQoZV0FJVExJU1RfU1RBVFVTX0NBTkNFTExFRBADKu8BChNXYWl0bGlzdE9mZmVyU3RhdHVzEiUKIVdBSVRMSVNUX09GRkVSX1NUQVRVU19VTlNQRUNJRklFRBAAEiEKHVdBSVRMSVNUX09GRkVSX1NUQVRVU19QRU5ESU5HEAESIgoeV0FJVExJU1RfT0ZGRVJfU1RBVFVTX0FDQ0VQVEVEEAISIgoeV0FJVExJU1RfT0ZGRVJfU1RBVFVTX0RFQ0xJTkVEEAMSIQodV0FJVExJU1RfT0ZGRVJfU1RBVFVTX0VYUElSRUQQBBIjCh9XQUlUTElTVF9PRkZFUl9TVEFUVVNfV0lUSERSQVdOEAUq/AEKEkNhbmNlbGxhdGlvblJlYXNvbhIjCh9DQU5DRUxMQVRJT05fUkVBU09OX1VOU1BFQ0lGSUVEEAASKQolQ0FOQ0VMTEFUSU9OX1JFQVNPTl9TQ0hFRFVMRV9DT05GTElDVBABEh8KG0NBTkNFTExBVElPTl9SRUFTT05fSUxMTkVTUxACEigKJENBTkNFTExBVElPTl9SRUFTT05fTk9fTE9OR0VSX05FRURFRBADEiwKKENBTkNFTExBVElPTl9SRUFTT05fUFJPVklERVJfVU5BVkFJTEFCTEUQBBIdChlDQU5DRUxMQVRJT05fUkVBU09OX09USEVSEAUqXwoLQ2FuY2VsbGVkQnkSHAoYQ0FOQ0VMTEVEX0JZX1VOU1BFQ0lGSUVEEAASFwoTQ0FOQ0VMTEVEX0JZX0NMSUVOVBABEhkKFUNBTkNFTExFRF9CWV9QUk9WSURFUhACMpoSChJBcHBvaW50bWVudFNlcnZpY2USTgoOR2V0QXBwb2ludG1lbnQSIi5hcHBvaW50bWVudC5HZXRBcHBvaW50bWVudFJlcXVlc3QaGC5hcHBvaW50bWVudC5BcHBvaW50bWVudBJmChNHZXRVc2VyQXBwb2ludG1lbnRzEiYuYXBwb2ludG1lbnQuR2V0VXNlckFwcG9pbnRtZW50UmVxdWVzdBonLmFwcG9pbnRtZW50LkdldFVzZXJBcHBvaW50bWVudFJlc3BvbnNlElQKEUNyZWF0ZUFwcG9pbnRtZW50EiUuYXBwb2ludG1lbnQuQ3JlYXRlQXBwb2ludG1lbnRSZXF1ZXN0GhguYXBwb2ludG1lbnQuQXBwb2ludG1lbnQSVAoRVXBkYXRlQXBwb2ludG1lbnQSJS5hcHBvaW50bWVudC5VcGRhdGVBcHBvaW50bWVudFJlcXVlc3QaGC5hcHBvaW50bWVudC5BcHBvaW50bWVudBJiChFEZWxldGVBcHBvaW50bWVudBIlLmFwcG9pbnRtZW50LkRlbGV0ZUFwcG9pbnRtZW50UmVxdWVzdBomLmFwcG9pbnRtZW50LkRlbGV0ZUFwcG9pbnRtZW50UmVzcG9uc2USZQoSU2VhcmNoQXBwb2ludG1lbnRzEiYuYXBwb2ludG1lbnQuU2VhcmNoQXBwb2ludG1lbnRzUmVxdWVzdBonLmFwcG9pbnRtZW50LlNlYXJjaEFwcG9pbnRtZW50c1Jlc3BvbnNlElsKDkltcG9ydENhbGVuZGFyEiIuYXBwb2ludG1lbnQuSW1wb3J0Q2FsZW5kYXJSZXF1ZXN0GiMuYXBwb2ludG1lbnQuSW1wb3J0Q2FsZW5kYXJSZXNwb25zZSgBEnQKF0xpc3REZWxldGVkQXBwb2ludG1lbnRzEisuYXBwb2ludG1lbnQuTGlzdERlbGV0ZWRBcHBvaW50bWVudHNSZXF1ZXN0GiwuYXBwb2ludG1lbnQuTGlzdERlbGV0ZWRBcHBvaW50bWVudHNSZXNwb25zZRJWChJSZXN0b3JlQXBwb2ludG1lbnQSJi5hcHBvaW50bWVudC5SZXN0b3JlQXBwb2ludG1lbnRSZXF1ZXN0GhguYXBwb2ludG1lbnQuQXBwb2ludG1lbnQSawoUTGlzdEFwcG9pbnRtZW50VHlwZXMSKC5hcHBvaW50bWVudC5MaXN0QXBwb2ludG1lbnRUeXBlc1JlcXVlc3QaKS5hcHBvaW50bWVudC5MaXN0QXBwb2ludG1lbnRUeXBlc1Jlc3BvbnNlEloKEkdldEFwcG9pbnRtZW50VHlwZRImLmFwcG9pbnRtZW50LkdldEFwcG9pbnRtZW50VHlwZVJlcXVlc3QaHC5hcHBvaW50bWVudC5BcHBvaW50bWVudFR5cGUSYgoRTGlzdEdyb3VwU2Vzc2lvbnMSJS5hcHBvaW50bWVudC5MaXN0R3JvdXBTZXNzaW9uc1JlcXVlc3QaJi5hcHBvaW50bWVudC5MaXN0R3JvdXBTZXNzaW9uc1Jlc3BvbnNlEl8KEEpvaW5Hcm91cFNlc3Npb24SJC5hcHBvaW50bWVudC5Kb2luR3JvdXBTZXNzaW9uUmVxdWVzdBolLmFwcG9pbnRtZW50LkpvaW5Hcm91cFNlc3Npb25SZXNwb25zZRJVChFMZWF2ZUdyb3VwU2Vzc2lvbhIlLmFwcG9pbnRtZW50LkxlYXZlR3JvdXBTZXNzaW9uUmVxdWVzdBoZLmFwcG9pbnRtZW50Lkdyb3VwU2Vzc2lvbhJuChVHZXRHcm91cFNlc3Npb25Sb3N0ZXISKS5hcHBvaW50bWVudC5HZXRHcm91cFNlc3Npb25Sb3N0ZXJSZXF1ZXN0GiouYXBwb2ludG1lbnQuR2V0R3JvdXBTZXNzaW9uUm9zdGVyUmVzcG9uc2USUgoQVXBkYXRlUnN2cFN0YXR1cxIkLmFwcG9pbnRtZW50LlVwZGF0ZVJzdnBTdGF0dXNSZXF1ZXN0GhguYXBwb2ludG1lbnQuUGFydGljaXBhbnQSTAoMSm9pbldhaXRsaXN0EiAuYXBwb2ludG1lbnQuSm9pbldhaXRsaXN0UmVxdWVzdBoaLmFwcG9pbnRtZW50LldhaXRsaXN0RW50cnkSVAoQR2V0V2FpdGxpc3RFbnRyeRIkLmFwcG9pbnRtZW50LkdldFdhaXRsaXN0RW50cnlSZXF1ZXN0GhouYXBwb2ludG1lbnQuV2FpdGxpc3RFbnRyeRJOCg1MZWF2ZVdhaXRsaXN0EiEuYXBwb2ludG1lbnQuTGVhdmVXYWl0bGlzdFJlcXVlc3QaGi5hcHBvaW50bWVudC5XYWl0bGlzdEVudHJ5EmgKE0FjY2VwdFdhaXRsaXN0T2ZmZXISJy5hcHBvaW50bWVudC5BY2NlcHRXYWl0bGlzdE9mZmVyUmVxdWVzdBooLmFwcG9pbnRtZW50LkFjY2VwdFdhaXRsaXN0T2ZmZXJSZXNwb25zZRJcChREZWNsaW5lV2FpdGxpc3RPZmZlchIoLmFwcG9pbnRtZW50LkRlY2xpbmVXYWl0bGlzdE9mZmVyUmVxdWVzdBoaLmFwcG9pbnRtZW50LldhaXRsaXN0RW50cnkSPwoISG9sZFNsb3QSHC5hcHBvaW50bWVudC5Ib2xkU2xvdFJlcXVlc3QaFS5hcHBvaW50bWVudC5TbG90SG9sZBJICgtDb25maXJtSG9sZBIfLmFwcG9pbnRtZW50LkNvbmZpcm1Ib2xkUmVxdWVzdBoYLmFwcG9pbnRtZW50LkFwcG9pbnRtZW50ElwKFVJlc2NoZWR1bGVBcHBvaW50bWVudBIpLmFwcG9pbnRtZW50LlJlc2NoZWR1bGVBcHBvaW50bWVudFJlcXVlc3QaGC5hcHBvaW50bWVudC5BcHBvaW50bWVudBJcCg9MaXN0UmVzY2hlZHVsZXMSIy5hcHBvaW50bWVudC5MaXN0UmVzY2hlZHVsZXNSZXF1ZXN0GiQuYXBwb2ludG1lbnQuTGlzdFJlc2NoZWR1bGVzUmVzcG9uc2VCMVovZ2l0aHViLmNvbS9mb2x1Y29kZS9hcHBvaW50bWVudC1zY2hlZHVsZXIvcHJvdG9iBnByb3RvMw", [file_google_protobuf_timestamp, file_google_protobuf_field_mask, file_google_protobuf_duration]);
 
 /**
  * @generated from message appointment.Appointment
@@ -62,6 +62,67 @@ export type Appointment = Message<"appointment.Appointment"> & {
    * @generated from field: google.protobuf.Timestamp deleted_at = 9;
    */
   deletedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 11;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * The IANA time zone the appointment was booked in, for rendering its
+   * times locally.
+   *
+   * @generated from field: string time_zone = 12;
+   */
+  timeZone: string;
+
+  /**
+   * Time kept free before start_time and after end_time. No other
+   * appointment, nor its buffers, may overlap them.
+   *
+   * @generated from field: google.protobuf.Duration buffer_before = 13;
+   */
+  bufferBefore?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration buffer_after = 14;
+   */
+  bufferAfter?: Duration;
+
+  /**
+   * The type the appointment was booked as, if any.
+   *
+   * @generated from field: string appointment_type_id = 15;
+   */
+  appointmentTypeId: string;
+
+  /**
+   * Seats in a group session, which others join rather than book. Zero
+   * for a private appointment.
+   *
+   * @generated from field: int32 capacity = 16;
+   */
+  capacity: number;
+
+  /**
+   * The organizer who booked it, then required and optional participants
+   * by name.
+   *
+   * @generated from field: repeated appointment.Participant participants = 17;
+   */
+  participants: Participant[];
+
+  /**
+   * Why and by whom the appointment was cancelled, once deleted_at is set.
+   *
+   * @generated from field: appointment.Cancellation cancellation = 18;
+   */
+  cancellation?: Cancellation;
 };
 
 /**
@@ -96,6 +157,36 @@ export type GetUserAppointmentRequest = Message<"appointment.GetUserAppointmentR
    * @generated from field: string user_id = 1;
    */
   userId: string;
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 4;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 5;
+   */
+  to?: Timestamp;
+
+  /**
+   * @generated from field: appointment.AppointmentScope scope = 6;
+   */
+  scope: AppointmentScope;
+
+  /**
+   * @generated from field: appointment.SortDirection sort_direction = 7;
+   */
+  sortDirection: SortDirection;
 };
 
 /**
@@ -113,6 +204,11 @@ export type GetUserAppointmentResponse = Message<"appointment.GetUserAppointment
    * @generated from field: repeated appointment.Appointment appointments = 1;
    */
   appointments: Appointment[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
 };
 
 /**
@@ -160,6 +256,39 @@ export type CreateAppointmentRequest = Message<"appointment.CreateAppointmentReq
    * @generated from field: google.protobuf.Timestamp date = 7;
    */
   date?: Timestamp;
+
+  /**
+   * IANA time zone of the person booking, such as "Africa/Lagos". Defaults
+   * to their saved zone, or else the schedule's. The booking policy judges
+   * days, such as for quotas and slot alignment, in this zone.
+   *
+   * @generated from field: string time_zone = 8;
+   */
+  timeZone: string;
+
+  /**
+   * Books an appointment of this type. end_time must then be left unset:
+   * it follows from the type's duration, and title defaults to its name.
+   *
+   * @generated from field: string appointment_type_id = 9;
+   */
+  appointmentTypeId: string;
+
+  /**
+   * Makes the appointment a group session with this many seats, hosted by
+   * the person booking.
+   *
+   * @generated from field: int32 capacity = 10;
+   */
+  capacity: number;
+
+  /**
+   * People to invite besides the person booking. The booking fails if a
+   * required invitee already has an appointment at the time.
+   *
+   * @generated from field: repeated appointment.Invitee invitees = 11;
+   */
+  invitees: Invitee[];
 };
 
 /**
@@ -192,6 +321,10 @@ export const UpdateAppointmentRequestSchema: GenMessage<UpdateAppointmentRequest
   messageDesc(file_appointment, 5);
 
 /**
+ * Cancels an appointment on behalf of the client who booked it. This fails
+ * with FAILED_PRECONDITION within the schedule's cancellation cutoff of the
+ * start time; a provider can still cancel then through the admin API.
+ *
  * @generated from message appointment.DeleteAppointmentRequest
  */
 export type DeleteAppointmentRequest = Message<"appointment.DeleteAppointmentRequest"> & {
@@ -199,6 +332,16 @@ export type DeleteAppointmentRequest = Message<"appointment.DeleteAppointmentReq
    * @generated from field: string id = 1;
    */
   id: string;
+
+  /**
+   * @generated from field: appointment.CancellationReason reason = 2;
+   */
+  reason: CancellationReason;
+
+  /**
+   * @generated from field: string note = 3;
+   */
+  note: string;
 };
 
 /**
@@ -216,6 +359,13 @@ export type DeleteAppointmentResponse = Message<"appointment.DeleteAppointmentRe
    * @generated from field: bool success = 1;
    */
   success: boolean;
+
+  /**
+   * The cancelled appointment, with its cancellation.
+   *
+   * @generated from field: appointment.Appointment appointment = 2;
+   */
+  appointment?: Appointment;
 };
 
 /**
@@ -225,6 +375,269 @@ export type DeleteAppointmentResponse = Message<"appointment.DeleteAppointmentRe
 export const DeleteAppointmentResponseSchema: GenMessage<DeleteAppointmentResponse> = /*@__PURE__*/
   messageDesc(file_appointment, 7);
 
+/**
+ * @generated from message appointment.ListDeletedAppointmentsRequest
+ */
+export type ListDeletedAppointmentsRequest = Message<"appointment.ListDeletedAppointmentsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message appointment.ListDeletedAppointmentsRequest.
+ * Use `create(ListDeletedAppointmentsRequestSchema)` to create a new message.
+ */
+export const ListDeletedAppointmentsRequestSchema: GenMessage<ListDeletedAppointmentsRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 8);
+
+/**
+ * Most recently deleted first.
+ *
+ * @generated from message appointment.ListDeletedAppointmentsResponse
+ */
+export type ListDeletedAppointmentsResponse = Message<"appointment.ListDeletedAppointmentsResponse"> & {
+  /**
+   * @generated from field: repeated appointment.Appointment appointments = 1;
+   */
+  appointments: Appointment[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message appointment.ListDeletedAppointmentsResponse.
+ * Use `create(ListDeletedAppointmentsResponseSchema)` to create a new message.
+ */
+export const ListDeletedAppointmentsResponseSchema: GenMessage<ListDeletedAppointmentsResponse> = /*@__PURE__*/
+  messageDesc(file_appointment, 9);
+
+/**
+ * Restoring fails with ALREADY_EXISTS if the slot has been booked since; the
+ * error carries the blocking Appointment as a detail.
+ *
+ * @generated from message appointment.RestoreAppointmentRequest
+ */
+export type RestoreAppointmentRequest = Message<"appointment.RestoreAppointmentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message appointment.RestoreAppointmentRequest.
+ * Use `create(RestoreAppointmentRequestSchema)` to create a new message.
+ */
+export const RestoreAppointmentRequestSchema: GenMessage<RestoreAppointmentRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 10);
+
+/**
+ * @generated from message appointment.SearchAppointmentsRequest
+ */
+export type SearchAppointmentsRequest = Message<"appointment.SearchAppointmentsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string query = 2;
+   */
+  query: string;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message appointment.SearchAppointmentsRequest.
+ * Use `create(SearchAppointmentsRequestSchema)` to create a new message.
+ */
+export const SearchAppointmentsRequestSchema: GenMessage<SearchAppointmentsRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 11);
+
+/**
+ * @generated from message appointment.SearchAppointmentsResponse
+ */
+export type SearchAppointmentsResponse = Message<"appointment.SearchAppointmentsResponse"> & {
+  /**
+   * @generated from field: repeated appointment.AppointmentSearchResult results = 1;
+   */
+  results: AppointmentSearchResult[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message appointment.SearchAppointmentsResponse.
+ * Use `create(SearchAppointmentsResponseSchema)` to create a new message.
+ */
+export const SearchAppointmentsResponseSchema: GenMessage<SearchAppointmentsResponse> = /*@__PURE__*/
+  messageDesc(file_appointment, 12);
+
+/**
+ * Snippets are HTML-escaped with matches wrapped in <mark> tags.
+ *
+ * @generated from message appointment.AppointmentSearchResult
+ */
+export type AppointmentSearchResult = Message<"appointment.AppointmentSearchResult"> & {
+  /**
+   * @generated from field: appointment.Appointment appointment = 1;
+   */
+  appointment?: Appointment;
+
+  /**
+   * @generated from field: float rank = 2;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: string title_snippet = 3;
+   */
+  titleSnippet: string;
+
+  /**
+   * @generated from field: string description_snippet = 4;
+   */
+  descriptionSnippet: string;
+};
+
+/**
+ * Describes the message appointment.AppointmentSearchResult.
+ * Use `create(AppointmentSearchResultSchema)` to create a new message.
+ */
+export const AppointmentSearchResultSchema: GenMessage<AppointmentSearchResult> = /*@__PURE__*/
+  messageDesc(file_appointment, 13);
+
+/**
+ * The first message names the user and whether this is a dry run; every
+ * message may carry the next chunk of the .ics file.
+ *
+ * @generated from message appointment.ImportCalendarRequest
+ */
+export type ImportCalendarRequest = Message<"appointment.ImportCalendarRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
+
+  /**
+   * @generated from field: bytes chunk = 3;
+   */
+  chunk: Uint8Array;
+};
+
+/**
+ * Describes the message appointment.ImportCalendarRequest.
+ * Use `create(ImportCalendarRequestSchema)` to create a new message.
+ */
+export const ImportCalendarRequestSchema: GenMessage<ImportCalendarRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 14);
+
+/**
+ * @generated from message appointment.ImportCalendarResponse
+ */
+export type ImportCalendarResponse = Message<"appointment.ImportCalendarResponse"> & {
+  /**
+   * @generated from field: repeated appointment.ImportEventResult results = 1;
+   */
+  results: ImportEventResult[];
+
+  /**
+   * @generated from field: int32 imported_count = 2;
+   */
+  importedCount: number;
+
+  /**
+   * @generated from field: int32 duplicate_count = 3;
+   */
+  duplicateCount: number;
+
+  /**
+   * @generated from field: int32 rejected_count = 4;
+   */
+  rejectedCount: number;
+
+  /**
+   * @generated from field: bool dry_run = 5;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message appointment.ImportCalendarResponse.
+ * Use `create(ImportCalendarResponseSchema)` to create a new message.
+ */
+export const ImportCalendarResponseSchema: GenMessage<ImportCalendarResponse> = /*@__PURE__*/
+  messageDesc(file_appointment, 15);
+
+/**
+ * @generated from message appointment.ImportEventResult
+ */
+export type ImportEventResult = Message<"appointment.ImportEventResult"> & {
+  /**
+   * @generated from field: string uid = 1;
+   */
+  uid: string;
+
+  /**
+   * @generated from field: string summary = 2;
+   */
+  summary: string;
+
+  /**
+   * @generated from field: appointment.ImportEventStatus status = 3;
+   */
+  status: ImportEventStatus;
+
+  /**
+   * @generated from field: string appointment_id = 4;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: string message = 5;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message appointment.ImportEventResult.
+ * Use `create(ImportEventResultSchema)` to create a new message.
+ */
+export const ImportEventResultSchema: GenMessage<ImportEventResult> = /*@__PURE__*/
+  messageDesc(file_appointment, 16);
+
 /**
  * @generated from message appointment.ContactInformation
  */
@@ -245,38 +658,1469 @@ export type ContactInformation = Message<"appointment.ContactInformation"> & {
  * Use `create(ContactInformationSchema)` to create a new message.
  */
 export const ContactInformationSchema: GenMessage<ContactInformation> = /*@__PURE__*/
-  messageDesc(file_appointment, 8);
+  messageDesc(file_appointment, 17);
 
 /**
- * @generated from service appointment.AppointmentService
+ * BookingPolicyViolations is attached to the FailedPrecondition error of a
+ * booking that breaks the schedule's booking policy, listing every rule it
+ * breaks.
+ *
+ * @generated from message appointment.BookingPolicyViolations
  */
-export const AppointmentService: GenService<{
-  /**
-   * @generated from rpc appointment.AppointmentService.GetAppointment
-   */
-  getAppointment: {
-    methodKind: "unary";
-    input: typeof GetAppointmentRequestSchema;
-    output: typeof AppointmentSchema;
-  },
+export type BookingPolicyViolations = Message<"appointment.BookingPolicyViolations"> & {
   /**
-   * @generated from rpc appointment.AppointmentService.GetUserAppointments
+   * @generated from field: repeated appointment.BookingPolicyViolation violations = 1;
    */
-  getUserAppointments: {
-    methodKind: "unary";
-    input: typeof GetUserAppointmentRequestSchema;
-    output: typeof GetUserAppointmentResponseSchema;
-  },
+  violations: BookingPolicyViolation[];
+};
+
+/**
+ * Describes the message appointment.BookingPolicyViolations.
+ * Use `create(BookingPolicyViolationsSchema)` to create a new message.
+ */
+export const BookingPolicyViolationsSchema: GenMessage<BookingPolicyViolations> = /*@__PURE__*/
+  messageDesc(file_appointment, 18);
+
+/**
+ * @generated from message appointment.BookingPolicyViolation
+ */
+export type BookingPolicyViolation = Message<"appointment.BookingPolicyViolation"> & {
   /**
-   * @generated from rpc appointment.AppointmentService.CreateAppointment
+   * @generated from field: appointment.BookingPolicyRule rule = 1;
    */
-  createAppointment: {
-    methodKind: "unary";
-    input: typeof CreateAppointmentRequestSchema;
-    output: typeof AppointmentSchema;
-  },
+  rule: BookingPolicyRule;
+
   /**
-   * @generated from rpc appointment.AppointmentService.UpdateAppointment
+   * @generated from field: string description = 2;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message appointment.BookingPolicyViolation.
+ * Use `create(BookingPolicyViolationSchema)` to create a new message.
+ */
+export const BookingPolicyViolationSchema: GenMessage<BookingPolicyViolation> = /*@__PURE__*/
+  messageDesc(file_appointment, 19);
+
+/**
+ * AppointmentType is an entry in the catalog of services that can be
+ * booked, such as a 30 minute consultation.
+ *
+ * @generated from message appointment.AppointmentType
+ */
+export type AppointmentType = Message<"appointment.AppointmentType"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 4;
+   */
+  duration?: Duration;
+
+  /**
+   * Time kept free around appointments of this type. Unset buffers fall
+   * back to the schedule's.
+   *
+   * @generated from field: google.protobuf.Duration buffer_before = 5;
+   */
+  bufferBefore?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration buffer_after = 6;
+   */
+  bufferAfter?: Duration;
+
+  /**
+   * Display color such as "#3366ff".
+   *
+   * @generated from field: string color = 7;
+   */
+  color: string;
+
+  /**
+   * Inactive types cannot be booked but stay on existing appointments.
+   *
+   * @generated from field: bool active = 8;
+   */
+  active: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 10;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message appointment.AppointmentType.
+ * Use `create(AppointmentTypeSchema)` to create a new message.
+ */
+export const AppointmentTypeSchema: GenMessage<AppointmentType> = /*@__PURE__*/
+  messageDesc(file_appointment, 20);
+
+/**
+ * @generated from message appointment.ListAppointmentTypesRequest
+ */
+export type ListAppointmentTypesRequest = Message<"appointment.ListAppointmentTypesRequest"> & {
+  /**
+   * @generated from field: bool include_inactive = 1;
+   */
+  includeInactive: boolean;
+};
+
+/**
+ * Describes the message appointment.ListAppointmentTypesRequest.
+ * Use `create(ListAppointmentTypesRequestSchema)` to create a new message.
+ */
+export const ListAppointmentTypesRequestSchema: GenMessage<ListAppointmentTypesRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 21);
+
+/**
+ * @generated from message appointment.ListAppointmentTypesResponse
+ */
+export type ListAppointmentTypesResponse = Message<"appointment.ListAppointmentTypesResponse"> & {
+  /**
+   * @generated from field: repeated appointment.AppointmentType appointment_types = 1;
+   */
+  appointmentTypes: AppointmentType[];
+};
+
+/**
+ * Describes the message appointment.ListAppointmentTypesResponse.
+ * Use `create(ListAppointmentTypesResponseSchema)` to create a new message.
+ */
+export const ListAppointmentTypesResponseSchema: GenMessage<ListAppointmentTypesResponse> = /*@__PURE__*/
+  messageDesc(file_appointment, 22);
+
+/**
+ * @generated from message appointment.GetAppointmentTypeRequest
+ */
+export type GetAppointmentTypeRequest = Message<"appointment.GetAppointmentTypeRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message appointment.GetAppointmentTypeRequest.
+ * Use `create(GetAppointmentTypeRequestSchema)` to create a new message.
+ */
+export const GetAppointmentTypeRequestSchema: GenMessage<GetAppointmentTypeRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 23);
+
+/**
+ * @generated from message appointment.GroupSession
+ */
+export type GroupSession = Message<"appointment.GroupSession"> & {
+  /**
+   * @generated from field: appointment.Appointment appointment = 1;
+   */
+  appointment?: Appointment;
+
+  /**
+   * @generated from field: int32 attendee_count = 2;
+   */
+  attendeeCount: number;
+
+  /**
+   * @generated from field: int32 seats_remaining = 3;
+   */
+  seatsRemaining: number;
+};
+
+/**
+ * Describes the message appointment.GroupSession.
+ * Use `create(GroupSessionSchema)` to create a new message.
+ */
+export const GroupSessionSchema: GenMessage<GroupSession> = /*@__PURE__*/
+  messageDesc(file_appointment, 24);
+
+/**
+ * @generated from message appointment.SessionAttendee
+ */
+export type SessionAttendee = Message<"appointment.SessionAttendee"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp joined_at = 4;
+   */
+  joinedAt?: Timestamp;
+};
+
+/**
+ * Describes the message appointment.SessionAttendee.
+ * Use `create(SessionAttendeeSchema)` to create a new message.
+ */
+export const SessionAttendeeSchema: GenMessage<SessionAttendee> = /*@__PURE__*/
+  messageDesc(file_appointment, 25);
+
+/**
+ * Lists active group sessions starting within [from, to), soonest first.
+ *
+ * @generated from message appointment.ListGroupSessionsRequest
+ */
+export type ListGroupSessionsRequest = Message<"appointment.ListGroupSessionsRequest"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 1;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 2;
+   */
+  to?: Timestamp;
+
+  /**
+   * Leaves out sessions with no seats remaining.
+   *
+   * @generated from field: bool available_only = 3;
+   */
+  availableOnly: boolean;
+
+  /**
+   * @generated from field: int32 page_size = 4;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 5;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message appointment.ListGroupSessionsRequest.
+ * Use `create(ListGroupSessionsRequestSchema)` to create a new message.
+ */
+export const ListGroupSessionsRequestSchema: GenMessage<ListGroupSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 26);
+
+/**
+ * @generated from message appointment.ListGroupSessionsResponse
+ */
+export type ListGroupSessionsResponse = Message<"appointment.ListGroupSessionsResponse"> & {
+  /**
+   * @generated from field: repeated appointment.GroupSession sessions = 1;
+   */
+  sessions: GroupSession[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message appointment.ListGroupSessionsResponse.
+ * Use `create(ListGroupSessionsResponseSchema)` to create a new message.
+ */
+export const ListGroupSessionsResponseSchema: GenMessage<ListGroupSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_appointment, 27);
+
+/**
+ * @generated from message appointment.JoinGroupSessionRequest
+ */
+export type JoinGroupSessionRequest = Message<"appointment.JoinGroupSessionRequest"> & {
+  /**
+   * @generated from field: string appointment_id = 1;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: appointment.ContactInformation contact_information = 2;
+   */
+  contactInformation?: ContactInformation;
+};
+
+/**
+ * Describes the message appointment.JoinGroupSessionRequest.
+ * Use `create(JoinGroupSessionRequestSchema)` to create a new message.
+ */
+export const JoinGroupSessionRequestSchema: GenMessage<JoinGroupSessionRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 28);
+
+/**
+ * @generated from message appointment.JoinGroupSessionResponse
+ */
+export type JoinGroupSessionResponse = Message<"appointment.JoinGroupSessionResponse"> & {
+  /**
+   * @generated from field: appointment.GroupSession session = 1;
+   */
+  session?: GroupSession;
+
+  /**
+   * @generated from field: appointment.SessionAttendee attendee = 2;
+   */
+  attendee?: SessionAttendee;
+};
+
+/**
+ * Describes the message appointment.JoinGroupSessionResponse.
+ * Use `create(JoinGroupSessionResponseSchema)` to create a new message.
+ */
+export const JoinGroupSessionResponseSchema: GenMessage<JoinGroupSessionResponse> = /*@__PURE__*/
+  messageDesc(file_appointment, 29);
+
+/**
+ * @generated from message appointment.LeaveGroupSessionRequest
+ */
+export type LeaveGroupSessionRequest = Message<"appointment.LeaveGroupSessionRequest"> & {
+  /**
+   * @generated from field: string appointment_id = 1;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message appointment.LeaveGroupSessionRequest.
+ * Use `create(LeaveGroupSessionRequestSchema)` to create a new message.
+ */
+export const LeaveGroupSessionRequestSchema: GenMessage<LeaveGroupSessionRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 30);
+
+/**
+ * @generated from message appointment.GetGroupSessionRosterRequest
+ */
+export type GetGroupSessionRosterRequest = Message<"appointment.GetGroupSessionRosterRequest"> & {
+  /**
+   * @generated from field: string appointment_id = 1;
+   */
+  appointmentId: string;
+};
+
+/**
+ * Describes the message appointment.GetGroupSessionRosterRequest.
+ * Use `create(GetGroupSessionRosterRequestSchema)` to create a new message.
+ */
+export const GetGroupSessionRosterRequestSchema: GenMessage<GetGroupSessionRosterRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 31);
+
+/**
+ * @generated from message appointment.GetGroupSessionRosterResponse
+ */
+export type GetGroupSessionRosterResponse = Message<"appointment.GetGroupSessionRosterResponse"> & {
+  /**
+   * @generated from field: appointment.GroupSession session = 1;
+   */
+  session?: GroupSession;
+
+  /**
+   * @generated from field: repeated appointment.SessionAttendee attendees = 2;
+   */
+  attendees: SessionAttendee[];
+};
+
+/**
+ * Describes the message appointment.GetGroupSessionRosterResponse.
+ * Use `create(GetGroupSessionRosterResponseSchema)` to create a new message.
+ */
+export const GetGroupSessionRosterResponseSchema: GenMessage<GetGroupSessionRosterResponse> = /*@__PURE__*/
+  messageDesc(file_appointment, 32);
+
+/**
+ * @generated from message appointment.Invitee
+ */
+export type Invitee = Message<"appointment.Invitee"> & {
+  /**
+   * @generated from field: appointment.ContactInformation contact_information = 1;
+   */
+  contactInformation?: ContactInformation;
+
+  /**
+   * REQUIRED or OPTIONAL; defaults to REQUIRED.
+   *
+   * @generated from field: appointment.ParticipantRole role = 2;
+   */
+  role: ParticipantRole;
+};
+
+/**
+ * Describes the message appointment.Invitee.
+ * Use `create(InviteeSchema)` to create a new message.
+ */
+export const InviteeSchema: GenMessage<Invitee> = /*@__PURE__*/
+  messageDesc(file_appointment, 33);
+
+/**
+ * @generated from message appointment.Participant
+ */
+export type Participant = Message<"appointment.Participant"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: appointment.ParticipantRole role = 4;
+   */
+  role: ParticipantRole;
+
+  /**
+   * @generated from field: appointment.RsvpStatus rsvp_status = 5;
+   */
+  rsvpStatus: RsvpStatus;
+
+  /**
+   * When the participant last answered the invitation.
+   *
+   * @generated from field: google.protobuf.Timestamp responded_at = 6;
+   */
+  respondedAt?: Timestamp;
+};
+
+/**
+ * Describes the message appointment.Participant.
+ * Use `create(ParticipantSchema)` to create a new message.
+ */
+export const ParticipantSchema: GenMessage<Participant> = /*@__PURE__*/
+  messageDesc(file_appointment, 34);
+
+/**
+ * Answers an invitation on behalf of user_id. The organizer cannot answer
+ * their own appointment. Accepting fails with ALREADY_EXISTS if a required
+ * participant has since been booked elsewhere at the time.
+ *
+ * @generated from message appointment.UpdateRsvpStatusRequest
+ */
+export type UpdateRsvpStatusRequest = Message<"appointment.UpdateRsvpStatusRequest"> & {
+  /**
+   * @generated from field: string appointment_id = 1;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: appointment.RsvpStatus rsvp_status = 3;
+   */
+  rsvpStatus: RsvpStatus;
+};
+
+/**
+ * Describes the message appointment.UpdateRsvpStatusRequest.
+ * Use `create(UpdateRsvpStatusRequestSchema)` to create a new message.
+ */
+export const UpdateRsvpStatusRequestSchema: GenMessage<UpdateRsvpStatusRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 35);
+
+/**
+ * WaitlistEntry asks for any slot within [window_start, window_end) that is
+ * freed when an appointment is cancelled or moved. Freed slots are offered
+ * to entries in the order they joined.
+ *
+ * @generated from message appointment.WaitlistEntry
+ */
+export type WaitlistEntry = Message<"appointment.WaitlistEntry"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: appointment.ContactInformation contact_information = 3;
+   */
+  contactInformation?: ContactInformation;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 4;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 5;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: appointment.WaitlistStatus status = 6;
+   */
+  status: WaitlistStatus;
+
+  /**
+   * The offer waiting for an answer, if any.
+   *
+   * @generated from field: appointment.WaitlistOffer offer = 7;
+   */
+  offer?: WaitlistOffer;
+
+  /**
+   * The appointment booked by accepting an offer.
+   *
+   * @generated from field: string appointment_id = 8;
+   */
+  appointmentId: string;
+
+  /**
+   * The zone of the person waiting, for showing offers in local time.
+   *
+   * @generated from field: string time_zone = 9;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 11;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * What to book when an offer is accepted.
+   *
+   * @generated from field: string title = 12;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 13;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message appointment.WaitlistEntry.
+ * Use `create(WaitlistEntrySchema)` to create a new message.
+ */
+export const WaitlistEntrySchema: GenMessage<WaitlistEntry> = /*@__PURE__*/
+  messageDesc(file_appointment, 36);
+
+/**
+ * WaitlistOffer holds a freed slot for one waitlist entry until expires_at.
+ * Nobody else can book the slot meanwhile. Declined or expired, it is offered
+ * to the next entry.
+ *
+ * @generated from message appointment.WaitlistOffer
+ */
+export type WaitlistOffer = Message<"appointment.WaitlistOffer"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string entry_id = 2;
+   */
+  entryId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: appointment.WaitlistOfferStatus status = 5;
+   */
+  status: WaitlistOfferStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp responded_at = 8;
+   */
+  respondedAt?: Timestamp;
+};
+
+/**
+ * Describes the message appointment.WaitlistOffer.
+ * Use `create(WaitlistOfferSchema)` to create a new message.
+ */
+export const WaitlistOfferSchema: GenMessage<WaitlistOffer> = /*@__PURE__*/
+  messageDesc(file_appointment, 37);
+
+/**
+ * @generated from message appointment.JoinWaitlistRequest
+ */
+export type JoinWaitlistRequest = Message<"appointment.JoinWaitlistRequest"> & {
+  /**
+   * @generated from field: appointment.ContactInformation contact_information = 1;
+   */
+  contactInformation?: ContactInformation;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 2;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 3;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: string title = 4;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message appointment.JoinWaitlistRequest.
+ * Use `create(JoinWaitlistRequestSchema)` to create a new message.
+ */
+export const JoinWaitlistRequestSchema: GenMessage<JoinWaitlistRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 38);
+
+/**
+ * @generated from message appointment.GetWaitlistEntryRequest
+ */
+export type GetWaitlistEntryRequest = Message<"appointment.GetWaitlistEntryRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message appointment.GetWaitlistEntryRequest.
+ * Use `create(GetWaitlistEntryRequestSchema)` to create a new message.
+ */
+export const GetWaitlistEntryRequestSchema: GenMessage<GetWaitlistEntryRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 39);
+
+/**
+ * Leaving withdraws any open offer, which then goes to the next entry.
+ *
+ * @generated from message appointment.LeaveWaitlistRequest
+ */
+export type LeaveWaitlistRequest = Message<"appointment.LeaveWaitlistRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message appointment.LeaveWaitlistRequest.
+ * Use `create(LeaveWaitlistRequestSchema)` to create a new message.
+ */
+export const LeaveWaitlistRequestSchema: GenMessage<LeaveWaitlistRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 40);
+
+/**
+ * Books the offered slot under the booking policy, like CreateAppointment.
+ *
+ * @generated from message appointment.AcceptWaitlistOfferRequest
+ */
+export type AcceptWaitlistOfferRequest = Message<"appointment.AcceptWaitlistOfferRequest"> & {
+  /**
+   * @generated from field: string offer_id = 1;
+   */
+  offerId: string;
+};
+
+/**
+ * Describes the message appointment.AcceptWaitlistOfferRequest.
+ * Use `create(AcceptWaitlistOfferRequestSchema)` to create a new message.
+ */
+export const AcceptWaitlistOfferRequestSchema: GenMessage<AcceptWaitlistOfferRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 41);
+
+/**
+ * @generated from message appointment.AcceptWaitlistOfferResponse
+ */
+export type AcceptWaitlistOfferResponse = Message<"appointment.AcceptWaitlistOfferResponse"> & {
+  /**
+   * @generated from field: appointment.WaitlistEntry entry = 1;
+   */
+  entry?: WaitlistEntry;
+
+  /**
+   * @generated from field: appointment.Appointment appointment = 2;
+   */
+  appointment?: Appointment;
+};
+
+/**
+ * Describes the message appointment.AcceptWaitlistOfferResponse.
+ * Use `create(AcceptWaitlistOfferResponseSchema)` to create a new message.
+ */
+export const AcceptWaitlistOfferResponseSchema: GenMessage<AcceptWaitlistOfferResponse> = /*@__PURE__*/
+  messageDesc(file_appointment, 42);
+
+/**
+ * The entry stays on the waitlist for other slots.
+ *
+ * @generated from message appointment.DeclineWaitlistOfferRequest
+ */
+export type DeclineWaitlistOfferRequest = Message<"appointment.DeclineWaitlistOfferRequest"> & {
+  /**
+   * @generated from field: string offer_id = 1;
+   */
+  offerId: string;
+};
+
+/**
+ * Describes the message appointment.DeclineWaitlistOfferRequest.
+ * Use `create(DeclineWaitlistOfferRequestSchema)` to create a new message.
+ */
+export const DeclineWaitlistOfferRequestSchema: GenMessage<DeclineWaitlistOfferRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 43);
+
+/**
+ * SlotHold keeps a slot free for someone while they fill in the booking
+ * form. Until it expires it blocks the slot like an appointment.
+ *
+ * @generated from message appointment.SlotHold
+ */
+export type SlotHold = Message<"appointment.SlotHold"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: appointment.ContactInformation contact_information = 3;
+   */
+  contactInformation?: ContactInformation;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 4;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 5;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message appointment.SlotHold.
+ * Use `create(SlotHoldSchema)` to create a new message.
+ */
+export const SlotHoldSchema: GenMessage<SlotHold> = /*@__PURE__*/
+  messageDesc(file_appointment, 44);
+
+/**
+ * @generated from message appointment.HoldSlotRequest
+ */
+export type HoldSlotRequest = Message<"appointment.HoldSlotRequest"> & {
+  /**
+   * @generated from field: appointment.ContactInformation contact_information = 1;
+   */
+  contactInformation?: ContactInformation;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 3;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * Defaults to the zone saved for the user, else the schedule's.
+   *
+   * @generated from field: string time_zone = 4;
+   */
+  timeZone: string;
+
+  /**
+   * Releases this earlier hold of the same user, when picking another
+   * slot. Other holds are left alone until they expire.
+   *
+   * @generated from field: string replaces_hold_id = 5;
+   */
+  replacesHoldId: string;
+};
+
+/**
+ * Describes the message appointment.HoldSlotRequest.
+ * Use `create(HoldSlotRequestSchema)` to create a new message.
+ */
+export const HoldSlotRequestSchema: GenMessage<HoldSlotRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 45);
+
+/**
+ * Books the held slot under the booking policy, like CreateAppointment. The
+ * appointment keeps the id of the hold. Only the user the slot is held for
+ * can confirm it; for anyone else the hold is not found.
+ *
+ * @generated from message appointment.ConfirmHoldRequest
+ */
+export type ConfirmHoldRequest = Message<"appointment.ConfirmHoldRequest"> & {
+  /**
+   * @generated from field: string hold_id = 1;
+   */
+  holdId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated appointment.Invitee invitees = 4;
+   */
+  invitees: Invitee[];
+
+  /**
+   * @generated from field: string user_id = 5;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message appointment.ConfirmHoldRequest.
+ * Use `create(ConfirmHoldRequestSchema)` to create a new message.
+ */
+export const ConfirmHoldRequestSchema: GenMessage<ConfirmHoldRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 46);
+
+/**
+ * Moves an active appointment to a new time under the booking policy,
+ * keeping its id. If the new time is taken nothing changes.
+ *
+ * @generated from message appointment.RescheduleAppointmentRequest
+ */
+export type RescheduleAppointmentRequest = Message<"appointment.RescheduleAppointmentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 3;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message appointment.RescheduleAppointmentRequest.
+ * Use `create(RescheduleAppointmentRequestSchema)` to create a new message.
+ */
+export const RescheduleAppointmentRequestSchema: GenMessage<RescheduleAppointmentRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 47);
+
+/**
+ * Reschedule records an appointment being moved.
+ *
+ * @generated from message appointment.Reschedule
+ */
+export type Reschedule = Message<"appointment.Reschedule"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string appointment_id = 2;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp previous_start_time = 3;
+   */
+  previousStartTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp previous_end_time = 4;
+   */
+  previousEndTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * Who moved it, as in the audit log: "admin", "anonymous" and so on.
+   *
+   * @generated from field: string actor = 7;
+   */
+  actor: string;
+
+  /**
+   * @generated from field: string reason = 8;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message appointment.Reschedule.
+ * Use `create(RescheduleSchema)` to create a new message.
+ */
+export const RescheduleSchema: GenMessage<Reschedule> = /*@__PURE__*/
+  messageDesc(file_appointment, 48);
+
+/**
+ * @generated from message appointment.ListReschedulesRequest
+ */
+export type ListReschedulesRequest = Message<"appointment.ListReschedulesRequest"> & {
+  /**
+   * @generated from field: string appointment_id = 1;
+   */
+  appointmentId: string;
+};
+
+/**
+ * Describes the message appointment.ListReschedulesRequest.
+ * Use `create(ListReschedulesRequestSchema)` to create a new message.
+ */
+export const ListReschedulesRequestSchema: GenMessage<ListReschedulesRequest> = /*@__PURE__*/
+  messageDesc(file_appointment, 49);
+
+/**
+ * Oldest first.
+ *
+ * @generated from message appointment.ListReschedulesResponse
+ */
+export type ListReschedulesResponse = Message<"appointment.ListReschedulesResponse"> & {
+  /**
+   * @generated from field: repeated appointment.Reschedule reschedules = 1;
+   */
+  reschedules: Reschedule[];
+};
+
+/**
+ * Describes the message appointment.ListReschedulesResponse.
+ * Use `create(ListReschedulesResponseSchema)` to create a new message.
+ */
+export const ListReschedulesResponseSchema: GenMessage<ListReschedulesResponse> = /*@__PURE__*/
+  messageDesc(file_appointment, 50);
+
+/**
+ * @generated from message appointment.Cancellation
+ */
+export type Cancellation = Message<"appointment.Cancellation"> & {
+  /**
+   * @generated from field: appointment.CancellationReason reason = 1;
+   */
+  reason: CancellationReason;
+
+  /**
+   * Free text from whoever cancelled.
+   *
+   * @generated from field: string note = 2;
+   */
+  note: string;
+
+  /**
+   * @generated from field: appointment.CancelledBy cancelled_by = 3;
+   */
+  cancelledBy: CancelledBy;
+
+  /**
+   * Whether a client cancelled within the schedule's late cancellation
+   * window of the start time. A provider cancelling is never late.
+   *
+   * @generated from field: bool late = 4;
+   */
+  late: boolean;
+};
+
+/**
+ * Describes the message appointment.Cancellation.
+ * Use `create(CancellationSchema)` to create a new message.
+ */
+export const CancellationSchema: GenMessage<Cancellation> = /*@__PURE__*/
+  messageDesc(file_appointment, 51);
+
+/**
+ * @generated from enum appointment.AppointmentScope
+ */
+export enum AppointmentScope {
+  /**
+   * @generated from enum value: APPOINTMENT_SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: APPOINTMENT_SCOPE_ALL = 1;
+   */
+  ALL = 1,
+
+  /**
+   * @generated from enum value: APPOINTMENT_SCOPE_UPCOMING = 2;
+   */
+  UPCOMING = 2,
+
+  /**
+   * @generated from enum value: APPOINTMENT_SCOPE_PAST = 3;
+   */
+  PAST = 3,
+}
+
+/**
+ * Describes the enum appointment.AppointmentScope.
+ */
+export const AppointmentScopeSchema: GenEnum<AppointmentScope> = /*@__PURE__*/
+  enumDesc(file_appointment, 0);
+
+/**
+ * @generated from enum appointment.SortDirection
+ */
+export enum SortDirection {
+  /**
+   * @generated from enum value: SORT_DIRECTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SORT_DIRECTION_ASCENDING = 1;
+   */
+  ASCENDING = 1,
+
+  /**
+   * @generated from enum value: SORT_DIRECTION_DESCENDING = 2;
+   */
+  DESCENDING = 2,
+}
+
+/**
+ * Describes the enum appointment.SortDirection.
+ */
+export const SortDirectionSchema: GenEnum<SortDirection> = /*@__PURE__*/
+  enumDesc(file_appointment, 1);
+
+/**
+ * @generated from enum appointment.ImportEventStatus
+ */
+export enum ImportEventStatus {
+  /**
+   * @generated from enum value: IMPORT_EVENT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_EVENT_STATUS_IMPORTED = 1;
+   */
+  IMPORTED = 1,
+
+  /**
+   * @generated from enum value: IMPORT_EVENT_STATUS_DUPLICATE = 2;
+   */
+  DUPLICATE = 2,
+
+  /**
+   * @generated from enum value: IMPORT_EVENT_STATUS_CONFLICT = 3;
+   */
+  CONFLICT = 3,
+
+  /**
+   * @generated from enum value: IMPORT_EVENT_STATUS_INVALID = 4;
+   */
+  INVALID = 4,
+}
+
+/**
+ * Describes the enum appointment.ImportEventStatus.
+ */
+export const ImportEventStatusSchema: GenEnum<ImportEventStatus> = /*@__PURE__*/
+  enumDesc(file_appointment, 2);
+
+/**
+ * @generated from enum appointment.BookingPolicyRule
+ */
+export enum BookingPolicyRule {
+  /**
+   * @generated from enum value: BOOKING_POLICY_RULE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: BOOKING_POLICY_RULE_MIN_NOTICE = 1;
+   */
+  MIN_NOTICE = 1,
+
+  /**
+   * @generated from enum value: BOOKING_POLICY_RULE_MAX_ADVANCE = 2;
+   */
+  MAX_ADVANCE = 2,
+
+  /**
+   * @generated from enum value: BOOKING_POLICY_RULE_MIN_DURATION = 3;
+   */
+  MIN_DURATION = 3,
+
+  /**
+   * @generated from enum value: BOOKING_POLICY_RULE_MAX_DURATION = 4;
+   */
+  MAX_DURATION = 4,
+
+  /**
+   * @generated from enum value: BOOKING_POLICY_RULE_SLOT_ALIGNMENT = 5;
+   */
+  SLOT_ALIGNMENT = 5,
+
+  /**
+   * @generated from enum value: BOOKING_POLICY_RULE_MAX_PER_DAY = 6;
+   */
+  MAX_PER_DAY = 6,
+
+  /**
+   * @generated from enum value: BOOKING_POLICY_RULE_MAX_PER_WEEK = 7;
+   */
+  MAX_PER_WEEK = 7,
+}
+
+/**
+ * Describes the enum appointment.BookingPolicyRule.
+ */
+export const BookingPolicyRuleSchema: GenEnum<BookingPolicyRule> = /*@__PURE__*/
+  enumDesc(file_appointment, 3);
+
+/**
+ * Required participants, and the organizer, count as busy for the length of
+ * the appointment unless they decline. Optional participants never do.
+ *
+ * @generated from enum appointment.ParticipantRole
+ */
+export enum ParticipantRole {
+  /**
+   * @generated from enum value: PARTICIPANT_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PARTICIPANT_ROLE_ORGANIZER = 1;
+   */
+  ORGANIZER = 1,
+
+  /**
+   * @generated from enum value: PARTICIPANT_ROLE_REQUIRED = 2;
+   */
+  REQUIRED = 2,
+
+  /**
+   * @generated from enum value: PARTICIPANT_ROLE_OPTIONAL = 3;
+   */
+  OPTIONAL = 3,
+}
+
+/**
+ * Describes the enum appointment.ParticipantRole.
+ */
+export const ParticipantRoleSchema: GenEnum<ParticipantRole> = /*@__PURE__*/
+  enumDesc(file_appointment, 4);
+
+/**
+ * @generated from enum appointment.RsvpStatus
+ */
+export enum RsvpStatus {
+  /**
+   * @generated from enum value: RSVP_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RSVP_STATUS_NEEDS_ACTION = 1;
+   */
+  NEEDS_ACTION = 1,
+
+  /**
+   * @generated from enum value: RSVP_STATUS_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+
+  /**
+   * @generated from enum value: RSVP_STATUS_DECLINED = 3;
+   */
+  DECLINED = 3,
+
+  /**
+   * @generated from enum value: RSVP_STATUS_TENTATIVE = 4;
+   */
+  TENTATIVE = 4,
+}
+
+/**
+ * Describes the enum appointment.RsvpStatus.
+ */
+export const RsvpStatusSchema: GenEnum<RsvpStatus> = /*@__PURE__*/
+  enumDesc(file_appointment, 5);
+
+/**
+ * @generated from enum appointment.WaitlistStatus
+ */
+export enum WaitlistStatus {
+  /**
+   * @generated from enum value: WAITLIST_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WAITLIST_STATUS_WAITING = 1;
+   */
+  WAITING = 1,
+
+  /**
+   * @generated from enum value: WAITLIST_STATUS_BOOKED = 2;
+   */
+  BOOKED = 2,
+
+  /**
+   * @generated from enum value: WAITLIST_STATUS_CANCELLED = 3;
+   */
+  CANCELLED = 3,
+}
+
+/**
+ * Describes the enum appointment.WaitlistStatus.
+ */
+export const WaitlistStatusSchema: GenEnum<WaitlistStatus> = /*@__PURE__*/
+  enumDesc(file_appointment, 6);
+
+/**
+ * @generated from enum appointment.WaitlistOfferStatus
+ */
+export enum WaitlistOfferStatus {
+  /**
+   * @generated from enum value: WAITLIST_OFFER_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WAITLIST_OFFER_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: WAITLIST_OFFER_STATUS_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+
+  /**
+   * @generated from enum value: WAITLIST_OFFER_STATUS_DECLINED = 3;
+   */
+  DECLINED = 3,
+
+  /**
+   * @generated from enum value: WAITLIST_OFFER_STATUS_EXPIRED = 4;
+   */
+  EXPIRED = 4,
+
+  /**
+   * The entry left the waitlist while the offer was open.
+   *
+   * @generated from enum value: WAITLIST_OFFER_STATUS_WITHDRAWN = 5;
+   */
+  WITHDRAWN = 5,
+}
+
+/**
+ * Describes the enum appointment.WaitlistOfferStatus.
+ */
+export const WaitlistOfferStatusSchema: GenEnum<WaitlistOfferStatus> = /*@__PURE__*/
+  enumDesc(file_appointment, 7);
+
+/**
+ * @generated from enum appointment.CancellationReason
+ */
+export enum CancellationReason {
+  /**
+   * @generated from enum value: CANCELLATION_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CANCELLATION_REASON_SCHEDULE_CONFLICT = 1;
+   */
+  SCHEDULE_CONFLICT = 1,
+
+  /**
+   * @generated from enum value: CANCELLATION_REASON_ILLNESS = 2;
+   */
+  ILLNESS = 2,
+
+  /**
+   * @generated from enum value: CANCELLATION_REASON_NO_LONGER_NEEDED = 3;
+   */
+  NO_LONGER_NEEDED = 3,
+
+  /**
+   * @generated from enum value: CANCELLATION_REASON_PROVIDER_UNAVAILABLE = 4;
+   */
+  PROVIDER_UNAVAILABLE = 4,
+
+  /**
+   * @generated from enum value: CANCELLATION_REASON_OTHER = 5;
+   */
+  OTHER = 5,
+}
+
+/**
+ * Describes the enum appointment.CancellationReason.
+ */
+export const CancellationReasonSchema: GenEnum<CancellationReason> = /*@__PURE__*/
+  enumDesc(file_appointment, 8);
+
+/**
+ * @generated from enum appointment.CancelledBy
+ */
+export enum CancelledBy {
+  /**
+   * @generated from enum value: CANCELLED_BY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The person who booked the appointment.
+   *
+   * @generated from enum value: CANCELLED_BY_CLIENT = 1;
+   */
+  CLIENT = 1,
+
+  /**
+   * Whoever runs the schedule, through the admin API.
+   *
+   * @generated from enum value: CANCELLED_BY_PROVIDER = 2;
+   */
+  PROVIDER = 2,
+}
+
+/**
+ * Describes the enum appointment.CancelledBy.
+ */
+export const CancelledBySchema: GenEnum<CancelledBy> = /*@__PURE__*/
+  enumDesc(file_appointment, 9);
+
+/**
+ * @generated from service appointment.AppointmentService
+ */
+export const AppointmentService: GenService<{
+  /**
+   * @generated from rpc appointment.AppointmentService.GetAppointment
+   */
+  getAppointment: {
+    methodKind: "unary";
+    input: typeof GetAppointmentRequestSchema;
+    output: typeof AppointmentSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.GetUserAppointments
+   */
+  getUserAppointments: {
+    methodKind: "unary";
+    input: typeof GetUserAppointmentRequestSchema;
+    output: typeof GetUserAppointmentResponseSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.CreateAppointment
+   */
+  createAppointment: {
+    methodKind: "unary";
+    input: typeof CreateAppointmentRequestSchema;
+    output: typeof AppointmentSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.UpdateAppointment
    */
   updateAppointment: {
     methodKind: "unary";
@@ -291,6 +2135,166 @@ export const AppointmentService: GenService<{
     input: typeof DeleteAppointmentRequestSchema;
     output: typeof DeleteAppointmentResponseSchema;
   },
+  /**
+   * @generated from rpc appointment.AppointmentService.SearchAppointments
+   */
+  searchAppointments: {
+    methodKind: "unary";
+    input: typeof SearchAppointmentsRequestSchema;
+    output: typeof SearchAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.ImportCalendar
+   */
+  importCalendar: {
+    methodKind: "client_streaming";
+    input: typeof ImportCalendarRequestSchema;
+    output: typeof ImportCalendarResponseSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.ListDeletedAppointments
+   */
+  listDeletedAppointments: {
+    methodKind: "unary";
+    input: typeof ListDeletedAppointmentsRequestSchema;
+    output: typeof ListDeletedAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.RestoreAppointment
+   */
+  restoreAppointment: {
+    methodKind: "unary";
+    input: typeof RestoreAppointmentRequestSchema;
+    output: typeof AppointmentSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.ListAppointmentTypes
+   */
+  listAppointmentTypes: {
+    methodKind: "unary";
+    input: typeof ListAppointmentTypesRequestSchema;
+    output: typeof ListAppointmentTypesResponseSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.GetAppointmentType
+   */
+  getAppointmentType: {
+    methodKind: "unary";
+    input: typeof GetAppointmentTypeRequestSchema;
+    output: typeof AppointmentTypeSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.ListGroupSessions
+   */
+  listGroupSessions: {
+    methodKind: "unary";
+    input: typeof ListGroupSessionsRequestSchema;
+    output: typeof ListGroupSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.JoinGroupSession
+   */
+  joinGroupSession: {
+    methodKind: "unary";
+    input: typeof JoinGroupSessionRequestSchema;
+    output: typeof JoinGroupSessionResponseSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.LeaveGroupSession
+   */
+  leaveGroupSession: {
+    methodKind: "unary";
+    input: typeof LeaveGroupSessionRequestSchema;
+    output: typeof GroupSessionSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.GetGroupSessionRoster
+   */
+  getGroupSessionRoster: {
+    methodKind: "unary";
+    input: typeof GetGroupSessionRosterRequestSchema;
+    output: typeof GetGroupSessionRosterResponseSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.UpdateRsvpStatus
+   */
+  updateRsvpStatus: {
+    methodKind: "unary";
+    input: typeof UpdateRsvpStatusRequestSchema;
+    output: typeof ParticipantSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.JoinWaitlist
+   */
+  joinWaitlist: {
+    methodKind: "unary";
+    input: typeof JoinWaitlistRequestSchema;
+    output: typeof WaitlistEntrySchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.GetWaitlistEntry
+   */
+  getWaitlistEntry: {
+    methodKind: "unary";
+    input: typeof GetWaitlistEntryRequestSchema;
+    output: typeof WaitlistEntrySchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.LeaveWaitlist
+   */
+  leaveWaitlist: {
+    methodKind: "unary";
+    input: typeof LeaveWaitlistRequestSchema;
+    output: typeof WaitlistEntrySchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.AcceptWaitlistOffer
+   */
+  acceptWaitlistOffer: {
+    methodKind: "unary";
+    input: typeof AcceptWaitlistOfferRequestSchema;
+    output: typeof AcceptWaitlistOfferResponseSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.DeclineWaitlistOffer
+   */
+  declineWaitlistOffer: {
+    methodKind: "unary";
+    input: typeof DeclineWaitlistOfferRequestSchema;
+    output: typeof WaitlistEntrySchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.HoldSlot
+   */
+  holdSlot: {
+    methodKind: "unary";
+    input: typeof HoldSlotRequestSchema;
+    output: typeof SlotHoldSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.ConfirmHold
+   */
+  confirmHold: {
+    methodKind: "unary";
+    input: typeof ConfirmHoldRequestSchema;
+    output: typeof AppointmentSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.RescheduleAppointment
+   */
+  rescheduleAppointment: {
+    methodKind: "unary";
+    input: typeof RescheduleAppointmentRequestSchema;
+    output: typeof AppointmentSchema;
+  },
+  /**
+   * @generated from rpc appointment.AppointmentService.ListReschedules
+   */
+  listReschedules: {
+    methodKind: "unary";
+    input: typeof ListReschedulesRequestSchema;
+    output: typeof ListReschedulesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_appointment, 0);
 
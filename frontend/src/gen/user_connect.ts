@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetUserRequest, GetUserResponse } from "./user_pb.js";
+import { CreateFeedTokenRequest, CreateFeedTokenResponse, GetUserRequest, GetUserResponse, ListFeedTokensRequest, ListFeedTokensResponse, RevokeFeedTokenRequest, RevokeFeedTokenResponse, SetUserTimeZoneRequest, User } from "./user_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -21,6 +21,44 @@ export const UserService = {
       O: GetUserResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Feed tokens can only be managed with the admin token as a bearer token.
+     *
+     * @generated from rpc user.UserService.CreateFeedToken
+     */
+    createFeedToken: {
+      name: "CreateFeedToken",
+      I: CreateFeedTokenRequest,
+      O: CreateFeedTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc user.UserService.ListFeedTokens
+     */
+    listFeedTokens: {
+      name: "ListFeedTokens",
+      I: ListFeedTokensRequest,
+      O: ListFeedTokensResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc user.UserService.RevokeFeedToken
+     */
+    revokeFeedToken: {
+      name: "RevokeFeedToken",
+      I: RevokeFeedTokenRequest,
+      O: RevokeFeedTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc user.UserService.SetUserTimeZone
+     */
+    setUserTimeZone: {
+      name: "SetUserTimeZone",
+      I: SetUserTimeZoneRequest,
+      O: User,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -2,15 +2,17 @@
 // @generated from file user.proto (package user, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file user.proto.
  */
 export const file_user: GenFile = /*@__PURE__*/
-  fileDesc("Cgp1c2VyLnByb3RvEgR1c2VyIi8KBFVzZXISCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCSIcCg5HZXRVc2VyUmVxdWVzdBIKCgJpZBgBIAEoCSIrCg9HZXRVc2VyUmVzcG9uc2USGAoEdXNlchgBIAEoCzIKLnVzZXIuVXNlcjJFCgtVc2VyU2VydmljZRI2CgdHZXRVc2VyEhQudXNlci5HZXRVc2VyUmVxdWVzdBoVLnVzZXIuR2V0VXNlclJlc3BvbnNlQjFaL2dpdGh1Yi5jb20vZm9sdWNvZGUvYXBwb2ludG1lbnQtc2NoZWR1bGVyL3Byb3RvYgZwcm90bzM");
+  fileDesc("Cgp1c2VyLnByb3RvEgR1c2VyIkIKBFVzZXISCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRIRCgl0aW1lX3pvbmUYBCABKAkiHAoOR2V0VXNlclJlcXVlc3QSCgoCaWQYASABKAkiKwoPR2V0VXNlclJlc3BvbnNlEhgKBHVzZXIYASABKAsyCi51c2VyLlVzZXIiPAoWU2V0VXNlclRpbWVab25lUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhEKCXRpbWVfem9uZRgCIAEoCSKpAQoJRmVlZFRva2VuEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKcmV2b2tlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHwoFc2NvcGUYBSABKA4yEC51c2VyLlRva2VuU2NvcGUiSgoWQ3JlYXRlRmVlZFRva2VuUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEh8KBXNjb3BlGAIgASgOMhAudXNlci5Ub2tlblNjb3BlIm8KF0NyZWF0ZUZlZWRUb2tlblJlc3BvbnNlEh4KBXRva2VuGAEgASgLMg8udXNlci5GZWVkVG9rZW4SEAoIZmVlZF91cmwYAiABKAkSDgoGc2VjcmV0GAMgASgJEhIKCmNhbGRhdl91cmwYBCABKAkiKAoVTGlzdEZlZWRUb2tlbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiOQoWTGlzdEZlZWRUb2tlbnNSZXNwb25zZRIfCgZ0b2tlbnMYASADKAsyDy51c2VyLkZlZWRUb2tlbiI1ChZSZXZva2VGZWVkVG9rZW5SZXF1ZXN0EgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiKgoXUmV2b2tlRmVlZFRva2VuUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCpXCgpUb2tlblNjb3BlEhsKF1RPS0VOX1NDT1BFX1VOU1BFQ0lGSUVEEAASFAoQVE9LRU5fU0NPUEVfRkVFRBABEhYKElRPS0VOX1NDT1BFX0NBTERBVhACMu8CCgtVc2VyU2VydmljZRI2CgdHZXRVc2VyEhQudXNlci5HZXRVc2VyUmVxdWVzdBoVLnVzZXIuR2V0VXNlclJlc3BvbnNlEk4KD0NyZWF0ZUZlZWRUb2tlbhIcLnVzZXIuQ3JlYXRlRmVlZFRva2VuUmVxdWVzdBodLnVzZXIuQ3JlYXRlRmVlZFRva2VuUmVzcG9uc2USSwoOTGlzdEZlZWRUb2tlbnMSGy51c2VyLkxpc3RGZWVkVG9rZW5zUmVxdWVzdBocLnVzZXIuTGlzdEZlZWRUb2tlbnNSZXNwb25zZRJOCg9SZXZva2VGZWVkVG9rZW4SHC51c2VyLlJldm9rZUZlZWRUb2tlblJlcXVlc3QaHS51c2VyLlJldm9rZUZlZWRUb2tlblJlc3BvbnNlEjsKD1NldFVzZXJUaW1lWm9uZRIcLnVzZXIuU2V0VXNlclRpbWVab25lUmVxdWVzdBoKLnVzZXIuVXNlckIxWi9naXRodWIuY29tL2ZvbHVjb2RlL2FwcG9pbnRtZW50LXNjaGVkdWxlci9wcm90b2IGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message user.User
@@ -30,6 +32,13 @@ export type User = Message<"user.User"> & {
    * @generated from field: string email = 3;
    */
   email: string;
+
+  /**
+   * IANA time zone, such as "Africa/Lagos".
+   *
+   * @generated from field: string time_zone = 4;
+   */
+  timeZone: string;
 };
 
 /**
@@ -73,6 +82,225 @@ export type GetUserResponse = Message<"user.GetUserResponse"> & {
 export const GetUserResponseSchema: GenMessage<GetUserResponse> = /*@__PURE__*/
   messageDesc(file_user, 2);
 
+/**
+ * @generated from message user.SetUserTimeZoneRequest
+ */
+export type SetUserTimeZoneRequest = Message<"user.SetUserTimeZoneRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string time_zone = 2;
+   */
+  timeZone: string;
+};
+
+/**
+ * Describes the message user.SetUserTimeZoneRequest.
+ * Use `create(SetUserTimeZoneRequestSchema)` to create a new message.
+ */
+export const SetUserTimeZoneRequestSchema: GenMessage<SetUserTimeZoneRequest> = /*@__PURE__*/
+  messageDesc(file_user, 3);
+
+/**
+ * @generated from message user.FeedToken
+ */
+export type FeedToken = Message<"user.FeedToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp revoked_at = 4;
+   */
+  revokedAt?: Timestamp;
+
+  /**
+   * @generated from field: user.TokenScope scope = 5;
+   */
+  scope: TokenScope;
+};
+
+/**
+ * Describes the message user.FeedToken.
+ * Use `create(FeedTokenSchema)` to create a new message.
+ */
+export const FeedTokenSchema: GenMessage<FeedToken> = /*@__PURE__*/
+  messageDesc(file_user, 4);
+
+/**
+ * @generated from message user.CreateFeedTokenRequest
+ */
+export type CreateFeedTokenRequest = Message<"user.CreateFeedTokenRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: user.TokenScope scope = 2;
+   */
+  scope: TokenScope;
+};
+
+/**
+ * Describes the message user.CreateFeedTokenRequest.
+ * Use `create(CreateFeedTokenRequestSchema)` to create a new message.
+ */
+export const CreateFeedTokenRequestSchema: GenMessage<CreateFeedTokenRequest> = /*@__PURE__*/
+  messageDesc(file_user, 5);
+
+/**
+ * The feed URL embeds the secret token and is only returned once. CalDAV
+ * tokens return the secret on its own along with the CalDAV server URL.
+ *
+ * @generated from message user.CreateFeedTokenResponse
+ */
+export type CreateFeedTokenResponse = Message<"user.CreateFeedTokenResponse"> & {
+  /**
+   * @generated from field: user.FeedToken token = 1;
+   */
+  token?: FeedToken;
+
+  /**
+   * @generated from field: string feed_url = 2;
+   */
+  feedUrl: string;
+
+  /**
+   * @generated from field: string secret = 3;
+   */
+  secret: string;
+
+  /**
+   * @generated from field: string caldav_url = 4;
+   */
+  caldavUrl: string;
+};
+
+/**
+ * Describes the message user.CreateFeedTokenResponse.
+ * Use `create(CreateFeedTokenResponseSchema)` to create a new message.
+ */
+export const CreateFeedTokenResponseSchema: GenMessage<CreateFeedTokenResponse> = /*@__PURE__*/
+  messageDesc(file_user, 6);
+
+/**
+ * @generated from message user.ListFeedTokensRequest
+ */
+export type ListFeedTokensRequest = Message<"user.ListFeedTokensRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message user.ListFeedTokensRequest.
+ * Use `create(ListFeedTokensRequestSchema)` to create a new message.
+ */
+export const ListFeedTokensRequestSchema: GenMessage<ListFeedTokensRequest> = /*@__PURE__*/
+  messageDesc(file_user, 7);
+
+/**
+ * @generated from message user.ListFeedTokensResponse
+ */
+export type ListFeedTokensResponse = Message<"user.ListFeedTokensResponse"> & {
+  /**
+   * @generated from field: repeated user.FeedToken tokens = 1;
+   */
+  tokens: FeedToken[];
+};
+
+/**
+ * Describes the message user.ListFeedTokensResponse.
+ * Use `create(ListFeedTokensResponseSchema)` to create a new message.
+ */
+export const ListFeedTokensResponseSchema: GenMessage<ListFeedTokensResponse> = /*@__PURE__*/
+  messageDesc(file_user, 8);
+
+/**
+ * @generated from message user.RevokeFeedTokenRequest
+ */
+export type RevokeFeedTokenRequest = Message<"user.RevokeFeedTokenRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message user.RevokeFeedTokenRequest.
+ * Use `create(RevokeFeedTokenRequestSchema)` to create a new message.
+ */
+export const RevokeFeedTokenRequestSchema: GenMessage<RevokeFeedTokenRequest> = /*@__PURE__*/
+  messageDesc(file_user, 9);
+
+/**
+ * @generated from message user.RevokeFeedTokenResponse
+ */
+export type RevokeFeedTokenResponse = Message<"user.RevokeFeedTokenResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message user.RevokeFeedTokenResponse.
+ * Use `create(RevokeFeedTokenResponseSchema)` to create a new message.
+ */
+export const RevokeFeedTokenResponseSchema: GenMessage<RevokeFeedTokenResponse> = /*@__PURE__*/
+  messageDesc(file_user, 10);
+
+/**
+ * A feed token only grants read access to the subscription feed. A CalDAV
+ * token is used as the password, with the user's email as the username, when
+ * a calendar client syncs and edits appointments over CalDAV.
+ *
+ * @generated from enum user.TokenScope
+ */
+export enum TokenScope {
+  /**
+   * @generated from enum value: TOKEN_SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TOKEN_SCOPE_FEED = 1;
+   */
+  FEED = 1,
+
+  /**
+   * @generated from enum value: TOKEN_SCOPE_CALDAV = 2;
+   */
+  CALDAV = 2,
+}
+
+/**
+ * Describes the enum user.TokenScope.
+ */
+export const TokenScopeSchema: GenEnum<TokenScope> = /*@__PURE__*/
+  enumDesc(file_user, 0);
+
 /**
  * @generated from service user.UserService
  */
@@ -85,6 +313,40 @@ export const UserService: GenService<{
     input: typeof GetUserRequestSchema;
     output: typeof GetUserResponseSchema;
   },
+  /**
+   * Feed tokens can only be managed with the admin token as a bearer token.
+   *
+   * @generated from rpc user.UserService.CreateFeedToken
+   */
+  createFeedToken: {
+    methodKind: "unary";
+    input: typeof CreateFeedTokenRequestSchema;
+    output: typeof CreateFeedTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc user.UserService.ListFeedTokens
+   */
+  listFeedTokens: {
+    methodKind: "unary";
+    input: typeof ListFeedTokensRequestSchema;
+    output: typeof ListFeedTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc user.UserService.RevokeFeedToken
+   */
+  revokeFeedToken: {
+    methodKind: "unary";
+    input: typeof RevokeFeedTokenRequestSchema;
+    output: typeof RevokeFeedTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc user.UserService.SetUserTimeZone
+   */
+  setUserTimeZone: {
+    methodKind: "unary";
+    input: typeof SetUserTimeZoneRequestSchema;
+    output: typeof UserSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_user, 0);
 
@@ -0,0 +1,440 @@
+// Package caldav serves a single calendar per user over CalDAV (RFC 4791) so
+// desktop and mobile calendar clients can sync appointments. It implements
+// the subset of WebDAV those clients rely on: PROPFIND discovery, the
+// calendar-query and calendar-multiget reports, and GET/PUT/DELETE of
+// calendar objects guarded by ETags.
+package caldav
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/ical"
+)
+
+var (
+	ErrNotFound           = errors.New("calendar object not found")
+	ErrPreconditionFailed = errors.New("calendar object has changed")
+	ErrConflict           = errors.New("calendar object conflicts with another appointment")
+	ErrForbidden          = errors.New("calendar object cannot be changed")
+	ErrUnauthorized       = errors.New("invalid credentials")
+)
+
+// Object is one calendar object resource: a single VEVENT addressed by Name
+// within the user's calendar collection.
+type Object struct {
+	Name  string
+	ETag  string
+	Event ical.Event
+}
+
+// Store maps calendar objects onto persistent appointments.
+type Store interface {
+	// Authenticate resolves HTTP Basic credentials to a user id.
+	Authenticate(ctx context.Context, username, password string) (string, error)
+	// ListObjects returns the user's active objects overlapping [start, end).
+	// Zero times leave that side of the range open.
+	ListObjects(ctx context.Context, userID string, start, end time.Time) ([]*Object, error)
+	GetObject(ctx context.Context, userID, name string) (*Object, error)
+	// PutObject creates or replaces the named object. ifMatch, when not
+	// empty, is the ETag the client expects the object to have.
+	PutObject(ctx context.Context, userID, name string, ev ical.Event, ifMatch string) (obj *Object, created bool, err error)
+	DeleteObject(ctx context.Context, userID, name, ifMatch string) error
+	// CTag changes whenever any object in the user's calendar changes.
+	CTag(ctx context.Context, userID string) (string, error)
+}
+
+const (
+	nsDAV    = "DAV:"
+	nsCalDAV = "urn:ietf:params:xml:ns:caldav"
+	nsCS     = "http://calendarserver.org/ns/"
+
+	calendarName = "appointments"
+	maxBodyBytes = 1 << 20
+)
+
+type Handler struct {
+	Store  Store
+	Prefix string
+	ProdID string
+}
+
+type resourceKind int
+
+const (
+	rootResource resourceKind = iota
+	principalResource
+	homeResource
+	calendarResource
+	objectResource
+)
+
+type resource struct {
+	kind   resourceKind
+	userID string
+	name   string
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		unauthorized(w)
+		return
+	}
+
+	userID, err := h.Store.Authenticate(r.Context(), username, password)
+	if err != nil {
+		if !errors.Is(err, ErrUnauthorized) {
+			log.Printf("Error authenticating CalDAV request: %v", err)
+		}
+		unauthorized(w)
+		return
+	}
+
+	res, ok := h.parsePath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if res.kind != rootResource && res.userID != userID {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodOptions:
+		w.Header().Set("DAV", "1, 3, calendar-access")
+		w.Header().Set("Allow", "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT")
+		w.WriteHeader(http.StatusOK)
+	case "PROPFIND":
+		h.propfind(w, r, res, userID)
+	case "REPORT":
+		h.report(w, r, res)
+	case http.MethodGet, http.MethodHead:
+		h.get(w, r, res)
+	case http.MethodPut:
+		h.put(w, r, res)
+	case http.MethodDelete:
+		h.delete(w, r, res)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func unauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Basic realm="appointments", charset="UTF-8"`)
+	http.Error(w, "unauthorized", http.StatusUnauthorized)
+}
+
+// parsePath recognises
+//
+//	{prefix}/
+//	{prefix}/principals/{user}/
+//	{prefix}/calendars/{user}/
+//	{prefix}/calendars/{user}/appointments/
+//	{prefix}/calendars/{user}/appointments/{object}.ics
+func (h *Handler) parsePath(p string) (resource, bool) {
+	rest, ok := strings.CutPrefix(p, h.Prefix)
+	if !ok {
+		return resource{}, false
+	}
+
+	parts := strings.FieldsFunc(rest, func(r rune) bool { return r == '/' })
+	switch {
+	case len(parts) == 0:
+		return resource{kind: rootResource}, true
+	case len(parts) == 2 && parts[0] == "principals":
+		return resource{kind: principalResource, userID: parts[1]}, true
+	case len(parts) == 2 && parts[0] == "calendars":
+		return resource{kind: homeResource, userID: parts[1]}, true
+	case len(parts) == 3 && parts[0] == "calendars" && parts[2] == calendarName:
+		return resource{kind: calendarResource, userID: parts[1]}, true
+	case len(parts) == 4 && parts[0] == "calendars" && parts[2] == calendarName && strings.HasSuffix(parts[3], ".ics"):
+		return resource{kind: objectResource, userID: parts[1], name: parts[3]}, true
+	}
+
+	return resource{}, false
+}
+
+func (h *Handler) principalHref(userID string) string {
+	return h.Prefix + "/principals/" + userID + "/"
+}
+
+func (h *Handler) homeHref(userID string) string {
+	return h.Prefix + "/calendars/" + userID + "/"
+}
+
+func (h *Handler) calendarHref(userID string) string {
+	return h.homeHref(userID) + calendarName + "/"
+}
+
+func (h *Handler) objectHref(userID, name string) string {
+	return h.calendarHref(userID) + name
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request, res resource) {
+	if res.kind != objectResource {
+		http.Error(w, "not a calendar object", http.StatusMethodNotAllowed)
+		return
+	}
+
+	obj, err := h.Store.GetObject(r.Context(), res.userID, res.name)
+	if err != nil {
+		h.storeError(w, err)
+		return
+	}
+
+	data, err := h.encode(obj)
+	if err != nil {
+		h.storeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("ETag", obj.ETag)
+	if r.Method == http.MethodHead {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Write(data)
+}
+
+func (h *Handler) put(w http.ResponseWriter, r *http.Request, res resource) {
+	if res.kind != objectResource {
+		http.Error(w, "not a calendar object", http.StatusMethodNotAllowed)
+		return
+	}
+
+	events, err := ical.Decode(io.LimitReader(r.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+		return
+	}
+	if len(events) != 1 {
+		http.Error(w, "a calendar object must contain exactly one VEVENT", http.StatusForbidden)
+		return
+	}
+
+	ev := events[0]
+	switch {
+	case ev.Err != nil:
+		http.Error(w, ev.Err.Error(), http.StatusBadRequest)
+		return
+	case ev.Recurring:
+		http.Error(w, "recurring events are not supported", http.StatusForbidden)
+		return
+	}
+
+	ifMatch := r.Header.Get("If-Match")
+	if r.Header.Get("If-None-Match") == "*" {
+		if _, err := h.Store.GetObject(r.Context(), res.userID, res.name); err == nil {
+			http.Error(w, "calendar object already exists", http.StatusPreconditionFailed)
+			return
+		} else if !errors.Is(err, ErrNotFound) {
+			h.storeError(w, err)
+			return
+		}
+	}
+
+	obj, created, err := h.Store.PutObject(r.Context(), res.userID, res.name, ev.Event, ifMatch)
+	if err != nil {
+		h.storeError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", obj.ETag)
+	if created {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request, res resource) {
+	if res.kind != objectResource {
+		http.Error(w, "only calendar objects can be deleted", http.StatusForbidden)
+		return
+	}
+
+	if err := h.Store.DeleteObject(r.Context(), res.userID, res.name, r.Header.Get("If-Match")); err != nil {
+		h.storeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) storeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, ErrPreconditionFailed):
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+	case errors.Is(err, ErrConflict):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, ErrForbidden):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		log.Printf("Error handling CalDAV request: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) encode(obj *Object) ([]byte, error) {
+	var buf bytes.Buffer
+	err := ical.Encode(&buf, ical.Calendar{ProdID: h.ProdID, Events: []ical.Event{obj.Event}}, time.Now())
+	return buf.Bytes(), err
+}
+
+// propfind answers with the requested properties of the target resource and,
+// at Depth: 1, of its children.
+func (h *Handler) propfind(w http.ResponseWriter, r *http.Request, res resource, userID string) {
+	names, err := requestedProps(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	ms := &multistatus{}
+
+	switch res.kind {
+	case rootResource:
+		ms.add(h.Prefix+"/", names, h.rootProps(userID))
+	case principalResource:
+		ms.add(h.principalHref(userID), names, h.principalProps(userID))
+	case homeResource:
+		ms.add(h.homeHref(userID), names, h.homeProps(userID))
+		if depth(r) > 0 {
+			props, err := h.calendarProps(ctx, userID)
+			if err != nil {
+				h.storeError(w, err)
+				return
+			}
+			ms.add(h.calendarHref(userID), names, props)
+		}
+	case calendarResource:
+		props, err := h.calendarProps(ctx, userID)
+		if err != nil {
+			h.storeError(w, err)
+			return
+		}
+		ms.add(h.calendarHref(userID), names, props)
+
+		if depth(r) > 0 {
+			objects, err := h.Store.ListObjects(ctx, userID, time.Time{}, time.Time{})
+			if err != nil {
+				h.storeError(w, err)
+				return
+			}
+			for _, obj := range objects {
+				ms.add(h.objectHref(userID, obj.Name), names, h.objectProps(obj, nil))
+			}
+		}
+	case objectResource:
+		obj, err := h.Store.GetObject(ctx, userID, res.name)
+		if err != nil {
+			h.storeError(w, err)
+			return
+		}
+		ms.add(h.objectHref(userID, obj.Name), names, h.objectProps(obj, nil))
+	}
+
+	ms.write(w)
+}
+
+func depth(r *http.Request) int {
+	if r.Header.Get("Depth") == "0" {
+		return 0
+	}
+	return 1
+}
+
+// report handles calendar-query and calendar-multiget on the calendar
+// collection.
+func (h *Handler) report(w http.ResponseWriter, r *http.Request, res resource) {
+	if res.kind != calendarResource {
+		http.Error(w, "reports are only supported on the calendar collection", http.StatusForbidden)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var rep reportRequest
+	if err := xml.Unmarshal(body, &rep); err != nil {
+		http.Error(w, "malformed report body", http.StatusBadRequest)
+		return
+	}
+
+	names := rep.Prop.names()
+	ctx := r.Context()
+	ms := &multistatus{}
+
+	switch {
+	case rep.XMLName.Space == nsCalDAV && rep.XMLName.Local == "calendar-query":
+		start, end, err := rep.timeRange()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		objects, err := h.Store.ListObjects(ctx, res.userID, start, end)
+		if err != nil {
+			h.storeError(w, err)
+			return
+		}
+		for _, obj := range objects {
+			data, err := h.encode(obj)
+			if err != nil {
+				h.storeError(w, err)
+				return
+			}
+			ms.add(h.objectHref(res.userID, obj.Name), names, h.objectProps(obj, data))
+		}
+	case rep.XMLName.Space == nsCalDAV && rep.XMLName.Local == "calendar-multiget":
+		for _, href := range rep.Hrefs {
+			href = strings.TrimSpace(href)
+			name, err := url.PathUnescape(path.Base(href))
+			if err != nil {
+				ms.missing(href)
+				continue
+			}
+
+			obj, err := h.Store.GetObject(ctx, res.userID, name)
+			if errors.Is(err, ErrNotFound) {
+				ms.missing(href)
+				continue
+			}
+			if err != nil {
+				h.storeError(w, err)
+				return
+			}
+
+			data, err := h.encode(obj)
+			if err != nil {
+				h.storeError(w, err)
+				return
+			}
+			ms.add(h.objectHref(res.userID, obj.Name), names, h.objectProps(obj, data))
+		}
+	default:
+		http.Error(w, fmt.Sprintf("unsupported report %s", rep.XMLName.Local), http.StatusForbidden)
+		return
+	}
+
+	ms.write(w)
+}
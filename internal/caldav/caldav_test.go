@@ -0,0 +1,300 @@
+package caldav
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/ical"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore keeps objects for a single user and rejects overlapping events
+// the way the appointments table's exclusion constraint does. Objects named in
+// frozen cannot be deleted, as past the cancellation cutoff.
+type memoryStore struct {
+	objects map[string]*Object
+	frozen  map[string]bool
+	version int
+}
+
+func (s *memoryStore) Authenticate(ctx context.Context, username, password string) (string, error) {
+	if username == "user@example.com" && password == "secret" {
+		return "user-1", nil
+	}
+	return "", ErrUnauthorized
+}
+
+func (s *memoryStore) ListObjects(ctx context.Context, userID string, start, end time.Time) ([]*Object, error) {
+	var result []*Object
+	for _, obj := range s.objects {
+		if (start.IsZero() || obj.Event.End.After(start)) && (end.IsZero() || obj.Event.Start.Before(end)) {
+			result = append(result, obj)
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryStore) GetObject(ctx context.Context, userID, name string) (*Object, error) {
+	obj, ok := s.objects[name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return obj, nil
+}
+
+func (s *memoryStore) PutObject(ctx context.Context, userID, name string, ev ical.Event, ifMatch string) (*Object, bool, error) {
+	existing, exists := s.objects[name]
+	if ifMatch != "" && (!exists || existing.ETag != ifMatch) {
+		return nil, false, ErrPreconditionFailed
+	}
+
+	for other, obj := range s.objects {
+		if other != name && ev.Start.Before(obj.Event.End) && obj.Event.Start.Before(ev.End) {
+			return nil, false, ErrConflict
+		}
+	}
+
+	s.version++
+	obj := &Object{Name: name, ETag: fmt.Sprintf(`"%d"`, s.version), Event: ev}
+	s.objects[name] = obj
+	return obj, !exists, nil
+}
+
+func (s *memoryStore) DeleteObject(ctx context.Context, userID, name, ifMatch string) error {
+	existing, exists := s.objects[name]
+	if !exists {
+		return ErrNotFound
+	}
+	if ifMatch != "" && existing.ETag != ifMatch {
+		return ErrPreconditionFailed
+	}
+	if s.frozen[name] {
+		return ErrForbidden
+	}
+
+	s.version++
+	delete(s.objects, name)
+	return nil
+}
+
+func (s *memoryStore) CTag(ctx context.Context, userID string) (string, error) {
+	return fmt.Sprintf(`"%d"`, s.version), nil
+}
+
+const calendarPath = "/caldav/calendars/user-1/appointments/"
+
+var start = time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+
+func newTestHandler() (*Handler, *memoryStore) {
+	store := &memoryStore{objects: map[string]*Object{
+		"existing.ics": {
+			Name: "existing.ics",
+			ETag: `"0"`,
+			Event: ical.Event{
+				UID:     "existing@example.com",
+				Summary: "Checkup",
+				Start:   start,
+				End:     start.Add(time.Hour),
+			},
+		},
+	}}
+	return &Handler{Store: store, Prefix: "/caldav", ProdID: "-//test//EN"}, store
+}
+
+func do(h http.Handler, method, path, body string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.SetBasicAuth("user@example.com", "secret")
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func eventBody(uid string, start time.Time, extra string) string {
+	return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VEVENT\r\n" +
+		"UID:" + uid + "\r\n" +
+		"SUMMARY:Follow-up\r\n" +
+		"DTSTART:" + ical.FormatTime(start) + "\r\n" +
+		"DTEND:" + ical.FormatTime(start.Add(30*time.Minute)) + "\r\n" +
+		extra +
+		"END:VEVENT\r\nEND:VCALENDAR\r\n"
+}
+
+func TestAuthentication(t *testing.T) {
+	h, _ := newTestHandler()
+
+	t.Run("challenges requests without credentials", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("PROPFIND", "/caldav/", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "Basic")
+	})
+
+	t.Run("rejects wrong credentials", func(t *testing.T) {
+		req := httptest.NewRequest("PROPFIND", "/caldav/", nil)
+		req.SetBasicAuth("user@example.com", "wrong")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("forbids other users' calendars", func(t *testing.T) {
+		rec := do(h, "PROPFIND", "/caldav/calendars/user-2/appointments/", "", nil)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestPropfind(t *testing.T) {
+	h, _ := newTestHandler()
+
+	t.Run("advertises CalDAV support", func(t *testing.T) {
+		rec := do(h, http.MethodOptions, "/caldav/", "", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("DAV"), "calendar-access")
+	})
+
+	t.Run("discovers the principal and calendar home", func(t *testing.T) {
+		body := `<?xml version="1.0"?>
+<d:propfind xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop><d:current-user-principal/><c:calendar-home-set/></d:prop>
+</d:propfind>`
+
+		rec := do(h, "PROPFIND", "/caldav/principals/user-1/", body, map[string]string{"Depth": "0"})
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		assert.Contains(t, rec.Body.String(), "<d:current-user-principal><d:href>/caldav/principals/user-1/</d:href></d:current-user-principal>")
+		assert.Contains(t, rec.Body.String(), "<c:calendar-home-set><d:href>/caldav/calendars/user-1/</d:href></c:calendar-home-set>")
+	})
+
+	t.Run("lists calendar objects at depth 1 and reports unknown props as missing", func(t *testing.T) {
+		body := `<?xml version="1.0"?>
+<d:propfind xmlns:d="DAV:" xmlns:x="urn:example">
+  <d:prop><d:getetag/><x:color/></d:prop>
+</d:propfind>`
+
+		rec := do(h, "PROPFIND", calendarPath, body, map[string]string{"Depth": "1"})
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		assert.Contains(t, rec.Body.String(), "<d:href>"+calendarPath+"existing.ics</d:href>")
+		assert.Contains(t, rec.Body.String(), "<d:getetag>&#34;0&#34;</d:getetag>")
+		assert.Contains(t, rec.Body.String(), `<x:color xmlns:x="urn:example"/></d:prop><d:status>HTTP/1.1 404 Not Found</d:status>`)
+	})
+}
+
+func TestReport(t *testing.T) {
+	h, _ := newTestHandler()
+
+	t.Run("calendar-query filters by time range", func(t *testing.T) {
+		query := func(from, to time.Time) string {
+			return `<?xml version="1.0"?>
+<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop><d:getetag/><c:calendar-data/></d:prop>
+  <c:filter>
+    <c:comp-filter name="VCALENDAR">
+      <c:comp-filter name="VEVENT">
+        <c:time-range start="` + ical.FormatTime(from) + `" end="` + ical.FormatTime(to) + `"/>
+      </c:comp-filter>
+    </c:comp-filter>
+  </c:filter>
+</c:calendar-query>`
+		}
+
+		rec := do(h, "REPORT", calendarPath, query(start.Add(-time.Hour), start.Add(time.Hour)), nil)
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		assert.Contains(t, rec.Body.String(), "existing.ics")
+		assert.Contains(t, rec.Body.String(), "SUMMARY:Checkup")
+
+		rec = do(h, "REPORT", calendarPath, query(start.Add(2*time.Hour), start.Add(3*time.Hour)), nil)
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "existing.ics")
+	})
+
+	t.Run("calendar-multiget returns requested objects and 404s the rest", func(t *testing.T) {
+		body := `<?xml version="1.0"?>
+<c:calendar-multiget xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop><d:getetag/><c:calendar-data/></d:prop>
+  <d:href>` + calendarPath + `existing.ics</d:href>
+  <d:href>` + calendarPath + `missing.ics</d:href>
+</c:calendar-multiget>`
+
+		rec := do(h, "REPORT", calendarPath, body, nil)
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		assert.Contains(t, rec.Body.String(), "UID:existing@example.com")
+		assert.Contains(t, rec.Body.String(), "<d:href>"+calendarPath+"missing.ics</d:href><d:status>HTTP/1.1 404 Not Found</d:status>")
+	})
+}
+
+func TestObjects(t *testing.T) {
+	h, store := newTestHandler()
+
+	t.Run("GET returns the event with its ETag", func(t *testing.T) {
+		rec := do(h, http.MethodGet, calendarPath+"existing.ics", "", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"0"`, rec.Header().Get("ETag"))
+		assert.Contains(t, rec.Body.String(), "SUMMARY:Checkup")
+
+		rec = do(h, http.MethodGet, calendarPath+"missing.ics", "", nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("PUT creates a new object", func(t *testing.T) {
+		rec := do(h, http.MethodPut, calendarPath+"new.ics", eventBody("new@example.com", start.Add(2*time.Hour), ""),
+			map[string]string{"If-None-Match": "*"})
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("ETag"))
+		require.Contains(t, store.objects, "new.ics")
+		assert.Equal(t, "Follow-up", store.objects["new.ics"].Event.Summary)
+	})
+
+	t.Run("PUT with If-None-Match fails when the object exists", func(t *testing.T) {
+		rec := do(h, http.MethodPut, calendarPath+"new.ics", eventBody("new@example.com", start.Add(2*time.Hour), ""),
+			map[string]string{"If-None-Match": "*"})
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	})
+
+	t.Run("PUT with a stale If-Match fails", func(t *testing.T) {
+		rec := do(h, http.MethodPut, calendarPath+"new.ics", eventBody("new@example.com", start.Add(3*time.Hour), ""),
+			map[string]string{"If-Match": `"stale"`})
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	})
+
+	t.Run("PUT that overlaps another appointment conflicts", func(t *testing.T) {
+		rec := do(h, http.MethodPut, calendarPath+"overlap.ics", eventBody("overlap@example.com", start, ""), nil)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.NotContains(t, store.objects, "overlap.ics")
+	})
+
+	t.Run("PUT rejects recurring events", func(t *testing.T) {
+		rec := do(h, http.MethodPut, calendarPath+"weekly.ics", eventBody("weekly@example.com", start.Add(5*time.Hour), "RRULE:FREQ=WEEKLY\r\n"), nil)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("DELETE the store refuses is forbidden", func(t *testing.T) {
+		store.frozen = map[string]bool{"existing.ics": true}
+		defer func() { store.frozen = nil }()
+
+		rec := do(h, http.MethodDelete, calendarPath+"existing.ics", "", nil)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, store.objects, "existing.ics")
+	})
+
+	t.Run("DELETE honours If-Match and removes the object", func(t *testing.T) {
+		rec := do(h, http.MethodDelete, calendarPath+"existing.ics", "", map[string]string{"If-Match": `"stale"`})
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+		rec = do(h, http.MethodDelete, calendarPath+"existing.ics", "", map[string]string{"If-Match": `"0"`})
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		rec = do(h, http.MethodGet, calendarPath+"existing.ics", "", nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
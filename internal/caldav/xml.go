@@ -0,0 +1,262 @@
+package caldav
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// props maps a property name to its value, already serialised as XML using
+// the d:, c: and cs: prefixes declared on the multistatus element.
+type props map[xml.Name]string
+
+var prefixes = map[string]string{
+	nsDAV:    "d",
+	nsCalDAV: "c",
+	nsCS:     "cs",
+}
+
+func davName(local string) xml.Name    { return xml.Name{Space: nsDAV, Local: local} }
+func calDAVName(local string) xml.Name { return xml.Name{Space: nsCalDAV, Local: local} }
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+func hrefXML(p string) string {
+	return "<d:href>" + escape((&url.URL{Path: p}).EscapedPath()) + "</d:href>"
+}
+
+func (h *Handler) rootProps(userID string) props {
+	return props{
+		davName("resourcetype"):           "<d:collection/>",
+		davName("current-user-principal"): hrefXML(h.principalHref(userID)),
+	}
+}
+
+func (h *Handler) principalProps(userID string) props {
+	return props{
+		davName("resourcetype"):           "<d:collection/><d:principal/>",
+		davName("displayname"):            escape(userID),
+		davName("current-user-principal"): hrefXML(h.principalHref(userID)),
+		davName("principal-URL"):          hrefXML(h.principalHref(userID)),
+		calDAVName("calendar-home-set"):   hrefXML(h.homeHref(userID)),
+	}
+}
+
+func (h *Handler) homeProps(userID string) props {
+	return props{
+		davName("resourcetype"):           "<d:collection/>",
+		davName("current-user-principal"): hrefXML(h.principalHref(userID)),
+	}
+}
+
+func (h *Handler) calendarProps(ctx context.Context, userID string) (props, error) {
+	ctag, err := h.Store.CTag(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return props{
+		davName("resourcetype"):                        "<d:collection/><c:calendar/>",
+		davName("displayname"):                         "Appointments",
+		davName("current-user-principal"):              hrefXML(h.principalHref(userID)),
+		davName("current-user-privilege-set"):          "<d:privilege><d:read/></d:privilege><d:privilege><d:write/></d:privilege>",
+		davName("getetag"):                             escape(ctag),
+		calDAVName("supported-calendar-component-set"): `<c:comp name="VEVENT"/>`,
+		{Space: nsCS, Local: "getctag"}:                escape(ctag),
+	}, nil
+}
+
+// objectProps describes a calendar object. calendar-data is only available
+// when data is supplied, which the reports do.
+func (h *Handler) objectProps(obj *Object, data []byte) props {
+	p := props{
+		davName("resourcetype"):   "",
+		davName("getetag"):        escape(obj.ETag),
+		davName("getcontenttype"): "text/calendar; charset=utf-8; component=VEVENT",
+	}
+	if data != nil {
+		p[calDAVName("calendar-data")] = escape(string(data))
+	}
+	return p
+}
+
+type anyElement struct {
+	XMLName xml.Name
+}
+
+type propList struct {
+	Names []anyElement `xml:",any"`
+}
+
+// names returns the requested property names, or nil for all of them.
+func (p *propList) names() []xml.Name {
+	if p == nil || len(p.Names) == 0 {
+		return nil
+	}
+
+	names := make([]xml.Name, len(p.Names))
+	for i, n := range p.Names {
+		names[i] = n.XMLName
+	}
+	return names
+}
+
+type propfindRequest struct {
+	XMLName xml.Name    `xml:"DAV: propfind"`
+	Prop    *propList   `xml:"DAV: prop"`
+	AllProp *anyElement `xml:"DAV: allprop"`
+}
+
+// requestedProps parses a PROPFIND body. An empty body or allprop asks for
+// every property, which is reported as nil.
+func requestedProps(body io.Reader) ([]xml.Name, error) {
+	data, err := io.ReadAll(io.LimitReader(body, maxBodyBytes))
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var req propfindRequest
+	if err := xml.Unmarshal(data, &req); err != nil {
+		return nil, errors.New("malformed propfind body")
+	}
+
+	return req.Prop.names(), nil
+}
+
+type timeRange struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+type compFilter struct {
+	Name        string       `xml:"name,attr"`
+	CompFilters []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	TimeRange   *timeRange   `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+}
+
+type reportRequest struct {
+	XMLName xml.Name
+	Prop    *propList   `xml:"DAV: prop"`
+	Hrefs   []string    `xml:"DAV: href"`
+	Filter  *compFilter `xml:"urn:ietf:params:xml:ns:caldav filter>comp-filter"`
+}
+
+// timeRange returns the VEVENT time-range of a calendar-query, if any. Only
+// VCALENDAR/VEVENT filters are supported; every object is a VEVENT, so a
+// filter without a time-range matches all of them.
+func (r *reportRequest) timeRange() (time.Time, time.Time, error) {
+	var start, end time.Time
+
+	if r.Filter == nil {
+		return start, end, nil
+	}
+
+	for _, comp := range r.Filter.CompFilters {
+		if !strings.EqualFold(comp.Name, "VEVENT") || comp.TimeRange == nil {
+			continue
+		}
+
+		var err error
+		if comp.TimeRange.Start != "" {
+			if start, err = time.Parse("20060102T150405Z", comp.TimeRange.Start); err != nil {
+				return start, end, fmt.Errorf("invalid time-range start %q", comp.TimeRange.Start)
+			}
+		}
+		if comp.TimeRange.End != "" {
+			if end, err = time.Parse("20060102T150405Z", comp.TimeRange.End); err != nil {
+				return start, end, fmt.Errorf("invalid time-range end %q", comp.TimeRange.End)
+			}
+		}
+	}
+
+	return start, end, nil
+}
+
+// multistatus accumulates the responses of a 207 Multi-Status body.
+type multistatus struct {
+	buf bytes.Buffer
+}
+
+// add reports the props of href named in names, or all of them when names is
+// nil. Properties the resource doesn't have are listed as 404 Not Found.
+func (ms *multistatus) add(href string, names []xml.Name, p props) {
+	var found, missing strings.Builder
+
+	if names == nil {
+		for _, name := range slices.SortedFunc(maps.Keys(p), compareNames) {
+			writeProp(&found, name, p[name])
+		}
+	}
+	for _, name := range names {
+		if value, ok := p[name]; ok {
+			writeProp(&found, name, value)
+		} else {
+			writeProp(&missing, name, "")
+		}
+	}
+
+	ms.buf.WriteString("<d:response>" + hrefXML(href))
+	if found.Len() > 0 || missing.Len() == 0 {
+		ms.buf.WriteString("<d:propstat><d:prop>" + found.String() + "</d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat>")
+	}
+	if missing.Len() > 0 {
+		ms.buf.WriteString("<d:propstat><d:prop>" + missing.String() + "</d:prop><d:status>HTTP/1.1 404 Not Found</d:status></d:propstat>")
+	}
+	ms.buf.WriteString("</d:response>")
+}
+
+// missing reports that href does not exist.
+func (ms *multistatus) missing(href string) {
+	ms.buf.WriteString("<d:response><d:href>" + escape(href) + "</d:href><d:status>HTTP/1.1 404 Not Found</d:status></d:response>")
+}
+
+func (ms *multistatus) write(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+
+	io.WriteString(w, xml.Header)
+	io.WriteString(w, `<d:multistatus xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav" xmlns:cs="http://calendarserver.org/ns/">`)
+	w.Write(ms.buf.Bytes())
+	io.WriteString(w, "</d:multistatus>")
+}
+
+func compareNames(a, b xml.Name) int {
+	return cmp.Or(strings.Compare(a.Space, b.Space), strings.Compare(a.Local, b.Local))
+}
+
+func writeProp(b *strings.Builder, name xml.Name, value string) {
+	prefix, ok := prefixes[name.Space]
+	if !ok && name.Space == "" {
+		fmt.Fprintf(b, "<%s/>", name.Local)
+		return
+	}
+	if !ok {
+		// Properties from namespaces we don't know only ever appear as
+		// missing, so declare the namespace on the element itself.
+		fmt.Fprintf(b, `<x:%s xmlns:x="%s"/>`, name.Local, escape(name.Space))
+		return
+	}
+
+	if value == "" {
+		fmt.Fprintf(b, "<%s:%s/>", prefix, name.Local)
+		return
+	}
+	fmt.Fprintf(b, "<%s:%s>%s</%s:%s>", prefix, name.Local, value, prefix, name.Local)
+}
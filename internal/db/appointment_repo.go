@@ -4,20 +4,184 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/folucode/appointment-scheduler/internal/policy"
+	"github.com/folucode/appointment-scheduler/internal/timezone"
 	pb "github.com/folucode/appointment-scheduler/proto"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// CreateAppointment writes appt as is. Bookings should go through
+// BookAppointment, which enforces the booking policy.
 func (db *Database) CreateAppointment(ctx context.Context, appt *pb.Appointment) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertAppointment(ctx, tx, appt, "", "", db.ReminderOffsets); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// BookAppointment creates appt if it meets the schedule's booking policy at
+// now, returning a *BookingPolicyError listing what it breaks otherwise. The
+// check and the insert share a transaction that holds the user's row, so
+// concurrent bookings cannot together exceed a quota. It then holds the rows
+// of every required participant too, and fails with a
+// *ParticipantConflictError if one of them is already busy at the time. An
+// appointment with a type takes its end time and buffers from the type.
+func (db *Database) BookAppointment(ctx context.Context, appt *pb.Appointment, now time.Time) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := bookAppointment(ctx, tx, appt, "", "", now, db.ReminderOffsets); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// bookAppointment does the work of BookAppointment within tx, recording
+// icalUID and resourceName as insertAppointment does and scheduling the
+// appointment's reminders at reminderOffsets. Participants given only by
+// name and email are resolved to users first.
+func bookAppointment(ctx context.Context, tx pgx.Tx, appt *pb.Appointment, icalUID, resourceName string, now time.Time, reminderOffsets []time.Duration) error {
+	if appt.AppointmentTypeId != "" {
+		if err := applyAppointmentType(ctx, tx, appt); err != nil {
+			return err
+		}
+	}
+
+	invited, err := resolveParticipants(ctx, tx, appt.Participants)
+	if err != nil {
+		return err
+	}
+
+	busy := busyParticipants(appt)
+	if err := lockUsers(ctx, tx, busy); err != nil {
+		return err
+	}
+
+	if err := checkBookingPolicy(ctx, tx, appt, now); err != nil {
+		return err
+	}
+
+	if err := participantConflict(ctx, tx, busy, appt); err != nil {
+		return err
+	}
+
+	if err := insertAppointment(ctx, tx, appt, icalUID, resourceName, reminderOffsets); err != nil {
+		return err
+	}
+
+	for _, user := range invited {
+		if err := recordUserChange(ctx, tx, EventUserCreated, nil, user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkBookingPolicy evaluates the booking policy for appt, locking its user
+// and sharing the schedule so neither changes before the transaction ends.
+// appt itself does not count towards the quotas, so it can be moved.
+func checkBookingPolicy(ctx context.Context, tx pgx.Tx, appt *pb.Appointment, now time.Time) error {
+	var userTimeZone string
+	err := tx.QueryRow(ctx, `SELECT time_zone FROM users WHERE id = $1 FOR UPDATE`, appt.UserId).Scan(&userTimeZone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	schedule, err := getSchedule(ctx, tx, "FOR SHARE")
+	if err != nil {
+		return err
+	}
+
+	zone := appt.TimeZone
+	if zone == "" {
+		zone = userTimeZone
+	}
+	loc, err := timezone.Load(zone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	b := policy.Booking{
+		Start:    appt.StartTime.AsTime(),
+		End:      appt.EndTime.AsTime(),
+		Location: loc,
+	}
+
+	p := policy.FromProto(schedule.Policy)
+	if p.MaxPerDay > 0 || p.MaxPerWeek > 0 {
+		dayStart, dayEnd := policy.Day(b.Start, loc)
+		weekStart, weekEnd := policy.Week(b.Start, loc)
+
+		query := `
+		SELECT
+			COUNT(*) FILTER (WHERE start_time >= $2 AND start_time < $3),
+			COUNT(*) FILTER (WHERE start_time >= $4 AND start_time < $5)
+		FROM appointments
+		WHERE user_id = $1 AND deleted_at IS NULL AND hold_expires_at IS NULL AND id <> $6
+			AND start_time >= LEAST($2::timestamptz, $4::timestamptz)
+			AND start_time < GREATEST($3::timestamptz, $5::timestamptz)`
+
+		err := tx.QueryRow(ctx, query, appt.UserId, dayStart, dayEnd, weekStart, weekEnd, appt.Id).
+			Scan(&b.BookedThatDay, &b.BookedThatWeek)
+		if err != nil {
+			return err
+		}
+	}
+
+	if violations := p.Check(b, now); len(violations) > 0 {
+		return &BookingPolicyError{Violations: violations}
+	}
+
+	return nil
+}
+
+// insertAppointment writes appt and its appointment.created event, recording
+// icalUID and resourceName when the appointment came from an imported
+// calendar or a CalDAV client, and fills in appt's timestamps. Without a time
+// zone the appointment takes its user's, and without buffers the schedule's.
+// The user becomes the organizer, joined by anyone invited in
+// appt.Participants, and its reminders are scheduled at reminderOffsets.
+// Expired holds in its way are released first. q should be a transaction so
+// the writes commit together.
+func insertAppointment(ctx context.Context, q querier, appt *pb.Appointment, icalUID, resourceName string, reminderOffsets []time.Duration) error {
+	if err := releaseExpiredHolds(ctx, q, appt); err != nil {
+		return err
+	}
+
 	query := `
-        INSERT INTO appointments (id, user_id, contact_name, contact_email, start_time, end_time, title, description, date)
-        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+        INSERT INTO appointments (id, user_id, contact_name, contact_email, start_time, end_time, title, description, date, ical_uid, resource_name, time_zone,
+            buffer_before_seconds, buffer_after_seconds, appointment_type_id, capacity)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''), NULLIF($11, ''),
+            COALESCE(NULLIF($12, ''), (SELECT time_zone FROM users WHERE id = $2), 'UTC'),
+            COALESCE($13, (SELECT buffer_before_seconds FROM schedule), 0),
+            COALESCE($14, (SELECT buffer_after_seconds FROM schedule), 0),
+            NULLIF($15, '')::uuid, NULLIF($16, 0))
+        RETURNING created_at, updated_at, time_zone, buffer_before_seconds, buffer_after_seconds`
 
-	_, err := db.Pool.Exec(ctx, query,
+	var createdAt, updatedAt time.Time
+	var bufferBefore, bufferAfter int64
+	err := q.QueryRow(ctx, query,
 		appt.Id,
 		appt.UserId,
 		appt.ContactInformation.Name,
@@ -27,113 +191,488 @@ func (db *Database) CreateAppointment(ctx context.Context, appt *pb.Appointment)
 		appt.Title,
 		appt.Description,
 		appt.Date.AsTime(),
-	)
+		icalUID,
+		resourceName,
+		appt.TimeZone,
+		optionalSeconds(appt.BufferBefore),
+		optionalSeconds(appt.BufferAfter),
+		appt.AppointmentTypeId,
+		appt.Capacity,
+	).Scan(&createdAt, &updatedAt, &appt.TimeZone, &bufferBefore, &bufferAfter)
 
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			if pgErr.Code == "23P01" {
-				return errors.New("conflict: this time slot overlaps with an existing appointment")
+				return ErrAppointmentConflict
 			}
 		}
 		return err
 	}
 
-	return nil
+	appt.CreatedAt = timestamppb.New(createdAt)
+	appt.UpdatedAt = timestamppb.New(updatedAt)
+	appt.BufferBefore = durationpb.New(time.Duration(bufferBefore) * time.Second)
+	appt.BufferAfter = durationpb.New(time.Duration(bufferAfter) * time.Second)
+
+	if err := checkSlotOffers(ctx, q, appt); err != nil {
+		return err
+	}
+
+	if err := insertParticipants(ctx, q, appt); err != nil {
+		return err
+	}
+
+	if err := scheduleReminders(ctx, q, appt.Id, appt.StartTime.AsTime(), reminderOffsets); err != nil {
+		return err
+	}
+
+	return recordAppointmentChange(ctx, q, EventAppointmentCreated, nil, appt)
 }
 
-func (db *Database) GetAppointment(ctx context.Context, id string) (*pb.Appointment, error) {
+// optionalSeconds converts d to whole seconds, keeping nil as NULL.
+func optionalSeconds(d *durationpb.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	s := seconds(d.AsDuration())
+	return &s
+}
+
+// lockActiveAppointment reads and locks the active appointment matching
+// condition, so its state before a change can be recorded.
+func lockActiveAppointment(ctx context.Context, q querier, condition string, args ...any) (*CalendarEntry, error) {
 	query := `
-	SELECT id, user_id, title, description, date, contact_name, contact_email, start_time, end_time
-	FROM appointments 
-	WHERE id = $1 AND deleted_at IS NULL`
+	SELECT ` + calendarEntryColumns + `
+	FROM appointments
+	WHERE ` + condition + ` AND deleted_at IS NULL AND hold_expires_at IS NULL
+	FOR UPDATE`
 
-	var appt pb.Appointment
-	var contact pb.ContactInformation
-	var date, start, end time.Time
+	return scanCalendarEntry(q.QueryRow(ctx, query, args...))
+}
 
-	err := db.Pool.QueryRow(ctx, query, id).Scan(
-		&appt.Id,
-		&appt.UserId,
-		&appt.Title,
-		&appt.Description,
-		&date,
-		&contact.Name,
-		&contact.Email,
-		&start,
-		&end,
-	)
+func (db *Database) GetAppointment(ctx context.Context, id string) (*pb.Appointment, error) {
+	query := `
+	SELECT ` + calendarEntryColumns + `
+	FROM appointments 
+	WHERE id = $1 AND deleted_at IS NULL AND hold_expires_at IS NULL`
 
+	entry, err := scanCalendarEntry(db.Pool.QueryRow(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New("appointment not found")
+			return nil, ErrAppointmentNotFound
 		}
 		return nil, err
 	}
 
-	appt.ContactInformation = &contact
-	appt.StartTime = timestamppb.New(start)
-	appt.EndTime = timestamppb.New(end)
-	appt.Date = timestamppb.New(date)
+	if err := loadParticipants(ctx, db.Pool, []*pb.Appointment{entry.Appointment}); err != nil {
+		return nil, err
+	}
+
+	return entry.Appointment, nil
+}
+
+// AppointmentFilter narrows and pages GetAppointments. Zero values mean no
+// bound, every scope, ascending order and the default page size.
+type AppointmentFilter struct {
+	From       time.Time
+	To         time.Time
+	Scope      pb.AppointmentScope
+	Descending bool
+	PageSize   int
+	PageToken  string
+}
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// GetAppointments pages through the active appointments userId organizes or
+// has been invited to. Page tokens only continue the same user, range, scope
+// and order, and fail with ErrInvalidPageToken otherwise.
+func (db *Database) GetAppointments(ctx context.Context, userId string, filter AppointmentFilter) ([]*pb.Appointment, string, error) {
+	scope := pageTokenScope(userId, filter.From, filter.To, filter.Scope, filter.Descending)
+	conditions := []string{
+		"deleted_at IS NULL",
+		"hold_expires_at IS NULL",
+	}
+	args := []any{userId}
+
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		conditions = append(conditions, fmt.Sprintf("start_time >= $%d", len(args)))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		conditions = append(conditions, fmt.Sprintf("start_time < $%d", len(args)))
+	}
+
+	switch filter.Scope {
+	case pb.AppointmentScope_APPOINTMENT_SCOPE_UPCOMING:
+		conditions = append(conditions, "end_time > NOW()")
+	case pb.AppointmentScope_APPOINTMENT_SCOPE_PAST:
+		conditions = append(conditions, "end_time <= NOW()")
+	}
+
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if filter.PageToken != "" {
+		cursorStart, cursorId, err := decodePageToken(filter.PageToken, scope)
+		if err != nil {
+			return nil, "", err
+		}
+		args = append(args, cursorStart, cursorId)
+		conditions = append(conditions, fmt.Sprintf("(start_time, id) %s ($%d, $%d)", comparison, len(args)-1, len(args)))
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// The appointments a user organizes and those they are invited to are
+	// paged separately, so the first can seek along the (user_id,
+	// start_time, id) index rather than filter every appointment. The two
+	// never overlap, as the organizer is never also an invitee.
+	where := strings.Join(conditions, " AND ")
+	order := fmt.Sprintf("ORDER BY start_time %s, id %s LIMIT %d", direction, direction, pageSize+1)
+	query := fmt.Sprintf(`
+        SELECT %s
+        FROM appointments WHERE id IN (
+            (SELECT id FROM appointments WHERE user_id = $1 AND %s %s)
+            UNION ALL
+            (SELECT id FROM appointments
+                JOIN appointment_participants p ON p.appointment_id = id
+                WHERE p.user_id = $1 AND p.role <> 'organizer' AND %s %s)
+        )
+        %s`, calendarEntryColumns, where, order, where, order, order)
+
+	rows, err := db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	result, err := collectAppointments(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(result) > pageSize {
+		result = result[:pageSize]
+		last := result[len(result)-1]
+		nextPageToken = encodePageToken(last.StartTime.AsTime(), last.Id, scope)
+	}
+
+	if err := loadParticipants(ctx, db.Pool, result); err != nil {
+		return nil, "", err
+	}
 
-	return &appt, nil
+	return result, nextPageToken, nil
 }
 
-func (db *Database) GetAppointments(ctx context.Context, userId string) ([]*pb.Appointment, error) {
+// DeleteAppointment cancels the active appointment id at now, soft deleting
+// it and recording the cancellation c alongside. A client cannot cancel
+// within the schedule's cancellation cutoff, which fails with
+// ErrCancellationCutoff, and is flagged late within its late cancellation
+// window. It returns ErrAppointmentNotFound if there is no active appointment
+// with that id.
+func (db *Database) DeleteAppointment(ctx context.Context, id string, c *pb.Cancellation, now time.Time) (*pb.Appointment, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := lockActiveAppointment(ctx, tx, "id = $1", id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAppointmentNotFound
+		}
+		return nil, err
+	}
+
+	if c == nil {
+		c = &pb.Cancellation{}
+	}
+	if err := checkCancellation(ctx, tx, before.Appointment, c, now); err != nil {
+		return nil, err
+	}
+	args, err := cancellationArgs(c)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
-        SELECT id, user_id, contact_name, contact_email, start_time, end_time, date, title, description 
-        FROM appointments WHERE user_id = $1 AND deleted_at IS NULL`
+	UPDATE appointments SET deleted_at = NOW(), updated_at = NOW(), ` + cancellationColumns + `
+	WHERE id = $1
+	RETURNING ` + calendarEntryColumns
 
-	rows, err := db.Pool.Query(ctx, query, userId)
+	after, err := scanCalendarEntry(tx.QueryRow(ctx, query, append([]any{id}, args...)...))
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	var result []*pb.Appointment
-	for rows.Next() {
-		var a pb.Appointment
-		var c pb.ContactInformation
-		var start, end, date time.Time
+	if err := loadParticipants(ctx, tx, []*pb.Appointment{before.Appointment}); err != nil {
+		return nil, err
+	}
+	after.Appointment.Participants = before.Appointment.Participants
+
+	if c.Late {
+		if err := recordLateCancellation(ctx, tx, after.Appointment); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cancelReminders(ctx, tx, id); err != nil {
+		return nil, err
+	}
+
+	if err := offerFreedSlot(ctx, tx, before.Appointment.StartTime.AsTime(), before.Appointment.EndTime.AsTime()); err != nil {
+		return nil, err
+	}
+
+	if err := recordAppointmentChange(ctx, tx, EventAppointmentCancelled, before.Appointment, after.Appointment); err != nil {
+		return nil, err
+	}
+
+	return after.Appointment, tx.Commit(ctx)
+}
+
+// ListDeletedAppointments lists a user's deleted appointments, most recently
+// deleted first.
+func (db *Database) ListDeletedAppointments(ctx context.Context, userId string, pageSize int, pageToken string) ([]*pb.Appointment, string, error) {
+	conditions := []string{"user_id = $1", "deleted_at IS NOT NULL"}
+	args := []any{userId}
 
-		err := rows.Scan(
-			&a.Id,
-			&a.UserId,
-			&c.Name,
-			&c.Email,
-			&start,
-			&end,
-			&date,
-			&a.Title,
-			&a.Description,
-		)
+	if pageToken != "" {
+		cursorDeleted, cursorId, err := decodePageToken(pageToken, pageTokenScope(userId))
 		if err != nil {
-			return nil, fmt.Errorf("error scanning row: %w", err)
+			return nil, "", err
 		}
+		args = append(args, cursorDeleted, cursorId)
+		conditions = append(conditions, fmt.Sprintf("(deleted_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
 
-		a.ContactInformation = &c
+	query := fmt.Sprintf(`
+        SELECT %s
+        FROM appointments WHERE %s
+        ORDER BY deleted_at DESC, id DESC
+        LIMIT %d`, calendarEntryColumns, strings.Join(conditions, " AND "), pageSize+1)
 
-		a.StartTime = timestamppb.New(start)
-		a.EndTime = timestamppb.New(end)
-		a.Date = timestamppb.New(date)
+	rows, err := db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
 
-		result = append(result, &a)
+	result, err := collectAppointments(rows)
+	if err != nil {
+		return nil, "", err
 	}
 
-	if err = rows.Err(); err != nil {
+	var nextPageToken string
+	if len(result) > pageSize {
+		result = result[:pageSize]
+		last := result[len(result)-1]
+		nextPageToken = encodePageToken(last.DeletedAt.AsTime(), last.Id, pageTokenScope(userId))
+	}
+
+	return result, nextPageToken, nil
+}
+
+// RestoreAppointment undoes the deletion of an appointment, clearing its
+// cancellation, though a late one still counts in CountLateCancellations. It
+// returns ErrAppointmentNotFound if there is no deleted appointment with that
+// id, ErrUserErased if it belongs to an erased user, an
+// *AppointmentConflictError if its slot has been booked since, and
+// ErrSlotOffered if it has been offered to the waitlist.
+func (db *Database) RestoreAppointment(ctx context.Context, id string) (*pb.Appointment, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback(ctx)
+
+	lock := `
+	SELECT ` + calendarEntryColumns + `
+	FROM appointments
+	WHERE id = $1 AND deleted_at IS NOT NULL
+	FOR UPDATE`
+
+	before, err := scanCalendarEntry(tx.QueryRow(ctx, lock, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAppointmentNotFound
+		}
+		return nil, err
+	}
+
+	var erased bool
+	if err := tx.QueryRow(ctx, `SELECT erased_at IS NOT NULL FROM users WHERE id = $1`, before.Appointment.UserId).Scan(&erased); err != nil {
+		return nil, err
+	}
+	if erased {
+		return nil, ErrUserErased
+	}
+
+	if err := releaseExpiredHolds(ctx, tx, before.Appointment); err != nil {
+		return nil, err
+	}
+
+	query := `
+	UPDATE appointments SET deleted_at = NULL, updated_at = NOW(),
+		cancellation_reason = NULL, cancellation_note = '', cancelled_by = NULL, cancelled_late = FALSE
+	WHERE id = $1
+	RETURNING ` + calendarEntryColumns
+
+	after, err := scanCalendarEntry(tx.QueryRow(ctx, query, id))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23P01" {
+			tx.Rollback(ctx)
+			return nil, db.appointmentConflict(ctx, before.Appointment)
+		}
+		return nil, err
+	}
+
+	if err := checkSlotOffers(ctx, tx, after.Appointment); err != nil {
+		return nil, err
+	}
+
+	if err := scheduleReminders(ctx, tx, id, after.Appointment.StartTime.AsTime(), db.ReminderOffsets); err != nil {
+		return nil, err
+	}
+
+	if err := recordAppointmentChange(ctx, tx, EventAppointmentRestored, before.Appointment, after.Appointment); err != nil {
+		return nil, err
+	}
+
+	return after.Appointment, tx.Commit(ctx)
+}
+
+// appointmentConflict finds the active appointment overlapping appt, buffers
+// included, for reporting why appt could not be saved.
+func (db *Database) appointmentConflict(ctx context.Context, appt *pb.Appointment) error {
+	query := `
+	SELECT ` + calendarEntryColumns + `
+	FROM appointments
+	WHERE deleted_at IS NULL AND hold_expires_at IS NULL AND id <> $1
+		AND appointment_busy_range(start_time, end_time, buffer_before_seconds, buffer_after_seconds)
+			&& appointment_busy_range($2, $3, $4, $5)
+	ORDER BY start_time
+	LIMIT 1`
+
+	blocking, err := scanCalendarEntry(db.Pool.QueryRow(ctx, query, appt.Id, appt.StartTime.AsTime(), appt.EndTime.AsTime(),
+		seconds(appt.BufferBefore.AsDuration()), seconds(appt.BufferAfter.AsDuration())))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The slot is only held, or the blocking appointment has
+			// gone since.
+			return ErrAppointmentConflict
+		}
+		return err
+	}
+
+	return &AppointmentConflictError{Blocking: blocking.Appointment}
+}
+
+func collectAppointments(rows pgx.Rows) ([]*pb.Appointment, error) {
+	entries, err := collectCalendarEntries(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.Appointment, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.Appointment)
+	}
 
 	return result, nil
 }
 
-func (db *Database) DeleteAppointment(ctx context.Context, id string) (bool, error) {
-	query := `UPDATE appointments SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+// ListAppointmentsOptions configures the cross-user admin listing. Filter and
+// OrderBy use the AIP-160 and AIP-132 syntax described in filter.go.
+type ListAppointmentsOptions struct {
+	Filter    string
+	OrderBy   string
+	PageSize  int
+	PageToken string
+}
+
+// ListAppointments lists appointments across all users, including deleted
+// ones unless the filter excludes them, along with the total number of rows
+// matching the filter. Page tokens only continue the same filter and order.
+func (db *Database) ListAppointments(ctx context.Context, opts ListAppointmentsOptions) ([]*pb.Appointment, string, int, error) {
+	where, args, err := parseFilter(opts.Filter, appointmentFilterFields, nil)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	orderBy, err := parseOrderBy(opts.OrderBy, appointmentFilterFields)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	scope := pageTokenScope(opts.Filter, opts.OrderBy)
+	offset := 0
+	if opts.PageToken != "" {
+		offset, err = decodeOffsetToken(opts.PageToken, scope)
+		if err != nil {
+			return nil, "", 0, err
+		}
+	}
+
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
 
-	commandTag, err := db.Pool.Exec(ctx, query, id)
+	var total int
+	// Holds are not appointments yet, whatever the filter says.
+	where = "hold_expires_at IS NULL AND (" + where + ")"
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM appointments WHERE %s`, where)
+	if err := db.Pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, "", 0, err
+	}
+
+	query := fmt.Sprintf(`
+        SELECT %s
+        FROM appointments WHERE %s
+        ORDER BY %s
+        LIMIT %d OFFSET %d`, calendarEntryColumns, where, orderBy, pageSize, offset)
+
+	rows, err := db.Pool.Query(ctx, query, args...)
 	if err != nil {
-		return false, err
+		return nil, "", 0, err
+	}
+
+	result, err := collectAppointments(rows)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	var nextPageToken string
+	if offset+len(result) < total {
+		nextPageToken = encodeOffsetToken(offset+len(result), scope)
 	}
 
-	return commandTag.RowsAffected() > 0, nil
+	return result, nextPageToken, total, nil
 }
@@ -0,0 +1,254 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetAppointmentsPagination(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user := newTestUser(t, db, "Paginator")
+
+	base := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	for i := 0; i < 3; i++ {
+		err := db.CreateAppointment(ctx, &pb.Appointment{
+			Id:          uuid.NewString(),
+			UserId:      user.Id,
+			Title:       "Test title",
+			Description: "Test description",
+			Date:        timestamppb.New(base),
+			ContactInformation: &pb.ContactInformation{
+				Name:  "Test",
+				Email: "test@user.com",
+			},
+			StartTime: timestamppb.New(base.Add(time.Duration(i) * time.Hour)),
+			EndTime:   timestamppb.New(base.Add(time.Duration(i)*time.Hour + 30*time.Minute)),
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("pages through appointments in start time order", func(t *testing.T) {
+		first, token, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{PageSize: 2})
+		assert.NoError(t, err)
+		assert.Len(t, first, 2)
+		assert.NotEmpty(t, token)
+		assert.True(t, first[0].StartTime.AsTime().Before(first[1].StartTime.AsTime()))
+
+		second, token, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{PageSize: 2, PageToken: token})
+		assert.NoError(t, err)
+		assert.Len(t, second, 1)
+		assert.Empty(t, token)
+		assert.True(t, second[0].StartTime.AsTime().After(first[1].StartTime.AsTime()))
+	})
+
+	t.Run("filters by time range and sorts descending", func(t *testing.T) {
+		appts, _, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{
+			From:       base.Add(time.Hour),
+			Descending: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, appts, 2)
+		assert.True(t, appts[0].StartTime.AsTime().After(appts[1].StartTime.AsTime()))
+	})
+
+	t.Run("past scope excludes upcoming appointments", func(t *testing.T) {
+		appts, _, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{Scope: pb.AppointmentScope_APPOINTMENT_SCOPE_PAST})
+		assert.NoError(t, err)
+		assert.Empty(t, appts)
+	})
+
+	t.Run("rejects a malformed page token", func(t *testing.T) {
+		_, _, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{PageToken: "not-a-token"})
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+
+	t.Run("rejects a page token from another listing", func(t *testing.T) {
+		_, token, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{PageSize: 1})
+		require.NoError(t, err)
+		require.NotEmpty(t, token)
+
+		_, _, err = db.GetAppointments(ctx, user.Id, AppointmentFilter{PageSize: 1, PageToken: token, Descending: true})
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+		_, _, err = db.GetAppointments(ctx, uuid.NewString(), AppointmentFilter{PageSize: 1, PageToken: token})
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+
+	t.Run("pages through organized and invited appointments together", func(t *testing.T) {
+		host := newTestUser(t, db, "Host")
+
+		invited := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             host.Id,
+			Title:              "Invited",
+			Date:               timestamppb.New(base),
+			ContactInformation: &pb.ContactInformation{Name: host.Name, Email: host.Email},
+			StartTime:          timestamppb.New(base.Add(30 * time.Minute)),
+			EndTime:            timestamppb.New(base.Add(45 * time.Minute)),
+			Participants: []*pb.Participant{
+				{UserId: user.Id, Role: pb.ParticipantRole_PARTICIPANT_ROLE_OPTIONAL},
+			},
+		}
+		require.NoError(t, db.CreateAppointment(ctx, invited))
+
+		var seen []*pb.Appointment
+		token := ""
+		for {
+			page, next, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{PageSize: 2, PageToken: token})
+			require.NoError(t, err)
+			seen = append(seen, page...)
+			if next == "" {
+				break
+			}
+			token = next
+		}
+
+		require.Len(t, seen, 4)
+		assert.Equal(t, invited.Id, seen[1].Id)
+		for i := 1; i < len(seen); i++ {
+			assert.True(t, seen[i-1].StartTime.AsTime().Before(seen[i].StartTime.AsTime()))
+		}
+	})
+}
+
+func TestListAppointments(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{
+		Id:    uuid.NewString(),
+		Name:  "Test user",
+		Email: "test@user.com",
+	})
+	require.NoError(t, err)
+
+	base := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	var ids []string
+	for i, email := range []string{"ada@example.com", "grace@example.com"} {
+		appt := &pb.Appointment{
+			Id:          uuid.NewString(),
+			UserId:      user.Id,
+			Title:       "Test title",
+			Description: "Test description",
+			Date:        timestamppb.New(base),
+			ContactInformation: &pb.ContactInformation{
+				Name:  "Test",
+				Email: email,
+			},
+			StartTime: timestamppb.New(base.Add(time.Duration(i) * time.Hour)),
+			EndTime:   timestamppb.New(base.Add(time.Duration(i)*time.Hour + 30*time.Minute)),
+		}
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		ids = append(ids, appt.Id)
+	}
+
+	_, err = db.DeleteAppointment(ctx, ids[1], nil, time.Now())
+	require.NoError(t, err)
+
+	t.Run("filters across users including deleted rows", func(t *testing.T) {
+		appts, _, total, err := db.ListAppointments(ctx, ListAppointmentsOptions{
+			Filter: `contact_email = "grace@example.com" AND deleted = true`,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		if assert.Len(t, appts, 1) {
+			assert.Equal(t, ids[1], appts[0].Id)
+			assert.NotNil(t, appts[0].DeletedAt)
+		}
+	})
+
+	t.Run("pages with a total count", func(t *testing.T) {
+		appts, token, total, err := db.ListAppointments(ctx, ListAppointmentsOptions{OrderBy: "start_time desc", PageSize: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.NotEmpty(t, token)
+		if assert.Len(t, appts, 1) {
+			assert.Equal(t, ids[1], appts[0].Id)
+		}
+
+		_, _, _, err = db.ListAppointments(ctx, ListAppointmentsOptions{OrderBy: "start_time", PageSize: 1, PageToken: token})
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+}
+
+func TestRestoreAppointment(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Test user", Email: "test@user.com"})
+	require.NoError(t, err)
+
+	newAppointment := func(start time.Time) *pb.Appointment {
+		appt := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Title:              "Test title",
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: "Test", Email: "test@example.com"},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+		}
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		return appt
+	}
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	first := newAppointment(start)
+	second := newAppointment(start.Add(2 * time.Hour))
+	assert.NotNil(t, first.CreatedAt)
+
+	_, err = db.DeleteAppointment(ctx, first.Id, nil, time.Now())
+	require.NoError(t, err)
+	_, err = db.DeleteAppointment(ctx, second.Id, nil, time.Now())
+	require.NoError(t, err)
+
+	t.Run("lists deleted appointments most recently deleted first", func(t *testing.T) {
+		page, token, err := db.ListDeletedAppointments(ctx, user.Id, 1, "")
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, second.Id, page[0].Id)
+		assert.NotNil(t, page[0].DeletedAt)
+		assert.NotNil(t, page[0].UpdatedAt)
+
+		page, token, err = db.ListDeletedAppointments(ctx, user.Id, 1, token)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, first.Id, page[0].Id)
+		assert.Empty(t, token)
+	})
+
+	t.Run("restores a deleted appointment", func(t *testing.T) {
+		restored, err := db.RestoreAppointment(ctx, second.Id)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+
+		appt, err := db.GetAppointment(ctx, second.Id)
+		require.NoError(t, err)
+		assert.Equal(t, restored.UpdatedAt.AsTime(), appt.UpdatedAt.AsTime())
+
+		_, err = db.RestoreAppointment(ctx, second.Id)
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+	})
+
+	t.Run("reports the appointment blocking a restore", func(t *testing.T) {
+		blocking := newAppointment(start.Add(30 * time.Minute))
+
+		_, err := db.RestoreAppointment(ctx, first.Id)
+		var conflict *AppointmentConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.ErrorIs(t, err, ErrAppointmentConflict)
+		assert.Equal(t, blocking.Id, conflict.Blocking.Id)
+
+		_, err = db.GetAppointment(ctx, first.Id)
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+	})
+}
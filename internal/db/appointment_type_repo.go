@@ -0,0 +1,255 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrAppointmentTypeNotFound = errors.New("appointment type not found")
+	ErrAppointmentTypeInactive = errors.New("appointment type is not active")
+	ErrAppointmentTypeExists   = errors.New("an appointment type with this name already exists")
+	ErrAppointmentTypeInUse    = errors.New("appointment type has been booked; deactivate it instead")
+)
+
+const appointmentTypeColumns = `id, name, description, duration_seconds, buffer_before_seconds, buffer_after_seconds,
+	color, active, created_at, updated_at`
+
+// appointmentTypeFields maps the update mask paths of an AppointmentType to
+// its columns. Clearing a buffer makes the type use the schedule's again.
+var appointmentTypeFields = map[string]func(t *pb.AppointmentType) (string, any){
+	"name":        func(t *pb.AppointmentType) (string, any) { return "name", t.Name },
+	"description": func(t *pb.AppointmentType) (string, any) { return "description", t.Description },
+	"duration":    func(t *pb.AppointmentType) (string, any) { return "duration_seconds", seconds(t.Duration.AsDuration()) },
+	"buffer_before": func(t *pb.AppointmentType) (string, any) {
+		return "buffer_before_seconds", optionalSeconds(t.BufferBefore)
+	},
+	"buffer_after": func(t *pb.AppointmentType) (string, any) {
+		return "buffer_after_seconds", optionalSeconds(t.BufferAfter)
+	},
+	"color":  func(t *pb.AppointmentType) (string, any) { return "color", t.Color },
+	"active": func(t *pb.AppointmentType) (string, any) { return "active", t.Active },
+}
+
+func scanAppointmentType(row pgx.Row) (*pb.AppointmentType, error) {
+	var t pb.AppointmentType
+	var duration int64
+	var bufferBefore, bufferAfter *int64
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(&t.Id, &t.Name, &t.Description, &duration, &bufferBefore, &bufferAfter,
+		&t.Color, &t.Active, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Duration = durationpb.New(time.Duration(duration) * time.Second)
+	if bufferBefore != nil {
+		t.BufferBefore = durationpb.New(time.Duration(*bufferBefore) * time.Second)
+	}
+	if bufferAfter != nil {
+		t.BufferAfter = durationpb.New(time.Duration(*bufferAfter) * time.Second)
+	}
+	t.CreatedAt = timestamppb.New(createdAt)
+	t.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &t, nil
+}
+
+// appointmentTypeError maps constraint violations on appointment_types to
+// their errors.
+func appointmentTypeError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAppointmentTypeNotFound
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			return ErrAppointmentTypeExists
+		case "23503":
+			return ErrAppointmentTypeInUse
+		}
+	}
+	return err
+}
+
+// CreateAppointmentType adds t, which should already have been validated, to
+// the catalog.
+func (db *Database) CreateAppointmentType(ctx context.Context, t *pb.AppointmentType) (*pb.AppointmentType, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+	INSERT INTO appointment_types (id, name, description, duration_seconds, buffer_before_seconds, buffer_after_seconds, color, active)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	RETURNING ` + appointmentTypeColumns
+
+	created, err := scanAppointmentType(tx.QueryRow(ctx, query,
+		t.Id,
+		t.Name,
+		t.Description,
+		seconds(t.Duration.AsDuration()),
+		optionalSeconds(t.BufferBefore),
+		optionalSeconds(t.BufferAfter),
+		t.Color,
+		t.Active,
+	))
+	if err != nil {
+		return nil, appointmentTypeError(err)
+	}
+
+	if err := appendAuditEvent(ctx, tx, "appointment_type", created.Id, "appointment_type.created", nil, created); err != nil {
+		return nil, err
+	}
+
+	return created, tx.Commit(ctx)
+}
+
+func (db *Database) GetAppointmentType(ctx context.Context, id string) (*pb.AppointmentType, error) {
+	query := `SELECT ` + appointmentTypeColumns + ` FROM appointment_types WHERE id = $1`
+
+	t, err := scanAppointmentType(db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, appointmentTypeError(err)
+	}
+
+	return t, nil
+}
+
+func (db *Database) ListAppointmentTypes(ctx context.Context, includeInactive bool) ([]*pb.AppointmentType, error) {
+	query := `
+	SELECT ` + appointmentTypeColumns + `
+	FROM appointment_types
+	WHERE active OR $1
+	ORDER BY lower(name)`
+
+	rows, err := db.Pool.Query(ctx, query, includeInactive)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*pb.AppointmentType
+	for rows.Next() {
+		t, err := scanAppointmentType(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		result = append(result, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateAppointmentType sets the fields of the type named by paths to their
+// values in t, which should already have been validated. Appointments
+// already booked keep the times and buffers they were booked with.
+func (db *Database) UpdateAppointmentType(ctx context.Context, t *pb.AppointmentType, paths []string) (*pb.AppointmentType, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w: no fields to update", ErrInvalidUpdateMask)
+	}
+
+	args := []any{t.Id}
+	var sets []string
+	for _, path := range paths {
+		field, ok := appointmentTypeFields[path]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidUpdateMask, path)
+		}
+		column, value := field(t)
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	lock := `SELECT ` + appointmentTypeColumns + ` FROM appointment_types WHERE id = $1 FOR UPDATE`
+	before, err := scanAppointmentType(tx.QueryRow(ctx, lock, t.Id))
+	if err != nil {
+		return nil, appointmentTypeError(err)
+	}
+
+	query := fmt.Sprintf(`UPDATE appointment_types SET %s, updated_at = NOW() WHERE id = $1 RETURNING %s`,
+		strings.Join(sets, ", "), appointmentTypeColumns)
+	after, err := scanAppointmentType(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, appointmentTypeError(err)
+	}
+
+	if err := appendAuditEvent(ctx, tx, "appointment_type", after.Id, "appointment_type.updated", before, after); err != nil {
+		return nil, err
+	}
+
+	return after, tx.Commit(ctx)
+}
+
+// DeleteAppointmentType removes a type that has never been booked, returning
+// ErrAppointmentTypeInUse otherwise.
+func (db *Database) DeleteAppointmentType(ctx context.Context, id string) (bool, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `DELETE FROM appointment_types WHERE id = $1 RETURNING ` + appointmentTypeColumns
+
+	before, err := scanAppointmentType(tx.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, appointmentTypeError(err)
+	}
+
+	if err := appendAuditEvent(ctx, tx, "appointment_type", id, "appointment_type.deleted", before, nil); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(ctx)
+}
+
+// applyAppointmentType fills in what appt's type decides: its end time, its
+// buffers and, without one of its own, its title. The type is shared-locked
+// so it cannot be deactivated or changed until the booking commits.
+func applyAppointmentType(ctx context.Context, q querier, appt *pb.Appointment) error {
+	query := `SELECT ` + appointmentTypeColumns + ` FROM appointment_types WHERE id = $1 FOR SHARE`
+
+	t, err := scanAppointmentType(q.QueryRow(ctx, query, appt.AppointmentTypeId))
+	if err != nil {
+		return appointmentTypeError(err)
+	}
+	if !t.Active {
+		return ErrAppointmentTypeInactive
+	}
+
+	appt.EndTime = timestamppb.New(appt.StartTime.AsTime().Add(t.Duration.AsDuration()))
+	appt.BufferBefore = t.BufferBefore
+	appt.BufferAfter = t.BufferAfter
+	if appt.Title == "" {
+		appt.Title = t.Name
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAppointmentTypes(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user := newTestUser(t, db, "Test")
+
+	consultation, err := db.CreateAppointmentType(ctx, &pb.AppointmentType{
+		Id:           uuid.NewString(),
+		Name:         "Consultation",
+		Duration:     durationpb.New(30 * time.Minute),
+		BufferBefore: durationpb.New(5 * time.Minute),
+		Color:        "#3366ff",
+		Active:       true,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, consultation.BufferAfter)
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	book := func(typeID string, start time.Time) (*pb.Appointment, error) {
+		appt := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: "Test", Email: "test@example.com"},
+			StartTime:          timestamppb.New(start),
+			AppointmentTypeId:  typeID,
+		}
+		return appt, db.BookAppointment(ctx, appt, time.Now())
+	}
+
+	t.Run("names are unique regardless of case", func(t *testing.T) {
+		_, err := db.CreateAppointmentType(ctx, &pb.AppointmentType{
+			Id:       uuid.NewString(),
+			Name:     "consultation",
+			Duration: durationpb.New(time.Hour),
+		})
+		assert.ErrorIs(t, err, ErrAppointmentTypeExists)
+	})
+
+	t.Run("bookings take the type's duration, buffers and name", func(t *testing.T) {
+		appt, err := book(consultation.Id, start)
+		require.NoError(t, err)
+
+		stored, err := db.GetAppointment(ctx, appt.Id)
+		require.NoError(t, err)
+		assert.Equal(t, start.Add(30*time.Minute).UTC(), stored.EndTime.AsTime())
+		assert.Equal(t, "Consultation", stored.Title)
+		assert.Equal(t, consultation.Id, stored.AppointmentTypeId)
+		assert.Equal(t, 5*time.Minute, stored.BufferBefore.AsDuration())
+		assert.Zero(t, stored.BufferAfter.AsDuration())
+	})
+
+	t.Run("unknown and inactive types cannot be booked", func(t *testing.T) {
+		_, err := book(uuid.NewString(), start.Add(2*time.Hour))
+		assert.ErrorIs(t, err, ErrAppointmentTypeNotFound)
+
+		updated, err := db.UpdateAppointmentType(ctx, &pb.AppointmentType{Id: consultation.Id}, []string{"active", "buffer_before"})
+		require.NoError(t, err)
+		assert.False(t, updated.Active)
+		assert.Nil(t, updated.BufferBefore)
+		assert.Equal(t, "Consultation", updated.Name)
+
+		_, err = book(consultation.Id, start.Add(2*time.Hour))
+		assert.ErrorIs(t, err, ErrAppointmentTypeInactive)
+
+		active, err := db.ListAppointmentTypes(ctx, false)
+		require.NoError(t, err)
+		assert.Empty(t, active)
+
+		all, err := db.ListAppointmentTypes(ctx, true)
+		require.NoError(t, err)
+		assert.Len(t, all, 1)
+	})
+
+	t.Run("only types never booked can be deleted", func(t *testing.T) {
+		_, err := db.DeleteAppointmentType(ctx, consultation.Id)
+		assert.ErrorIs(t, err, ErrAppointmentTypeInUse)
+
+		unused, err := db.CreateAppointmentType(ctx, &pb.AppointmentType{
+			Id:       uuid.NewString(),
+			Name:     "Workshop",
+			Duration: durationpb.New(2 * time.Hour),
+			Active:   true,
+		})
+		require.NoError(t, err)
+
+		deleted, err := db.DeleteAppointmentType(ctx, unused.Id)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+
+		_, err = db.GetAppointmentType(ctx, unused.Id)
+		assert.ErrorIs(t, err, ErrAppointmentTypeNotFound)
+	})
+}
@@ -0,0 +1,323 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Every change to an appointment or user appends an audit event in the same
+// transaction. The chaining itself happens in the audit_events_chain trigger:
+// it serializes appends, numbers events without gaps and sets each event's
+// hash over its own fields and the previous event's hash. Snapshots are
+// hashed separately into before_digest and after_digest, so the chain covers
+// their content through the digests, and erasing a user can redact the
+// snapshots without breaking the chain.
+
+// AuditContext describes who is making the changes in a request.
+type AuditContext struct {
+	// Actor is "admin", "user:{id}", "anonymous" or "system".
+	Actor     string
+	IP        string
+	Procedure string
+}
+
+type auditContextKey struct{}
+
+// WithAudit attaches the audit context for the changes made with ctx.
+func WithAudit(ctx context.Context, audit AuditContext) context.Context {
+	return context.WithValue(ctx, auditContextKey{}, audit)
+}
+
+// AuditFromContext returns the audit context attached to ctx. Changes made
+// outside a request are attributed to "system".
+func AuditFromContext(ctx context.Context) AuditContext {
+	audit, _ := ctx.Value(auditContextKey{}).(AuditContext)
+	if audit.Actor == "" {
+		audit.Actor = "system"
+	}
+	return audit
+}
+
+func snapshotJSON(msg proto.Message) ([]byte, error) {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return nil, nil
+	}
+	return protojson.Marshal(msg)
+}
+
+// appendAuditEvent records action on an entity with its state before and
+// after, either of which may be nil. q must be the transaction that makes the
+// change.
+func appendAuditEvent(ctx context.Context, q querier, entityType, entityId, action string, before, after proto.Message) error {
+	beforeJSON, err := snapshotJSON(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := snapshotJSON(after)
+	if err != nil {
+		return err
+	}
+
+	audit := AuditFromContext(ctx)
+
+	query := `
+	INSERT INTO audit_events (id, actor, actor_ip, procedure, entity_type, entity_id, action, before, after)
+	VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, $9)`
+
+	_, err = q.Exec(ctx, query,
+		uuid.NewString(),
+		audit.Actor,
+		audit.IP,
+		audit.Procedure,
+		entityType,
+		entityId,
+		action,
+		beforeJSON,
+		afterJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+
+	return nil
+}
+
+// recordAppointmentChange writes the audit event and the outbox event for a
+// change to an appointment. before is nil when the appointment is new.
+func recordAppointmentChange(ctx context.Context, q querier, eventType string, before, after *pb.Appointment) error {
+	if err := appendAuditEvent(ctx, q, "appointment", after.Id, eventType, before, after); err != nil {
+		return err
+	}
+	return appendAppointmentEvent(ctx, q, eventType, after)
+}
+
+// recordUserChange is recordAppointmentChange for users.
+func recordUserChange(ctx context.Context, q querier, eventType string, before, after *pb.User) error {
+	if err := appendAuditEvent(ctx, q, "user", after.Id, eventType, before, after); err != nil {
+		return err
+	}
+	return appendOutboxEvent(ctx, q, "user", after.Id, eventType, after)
+}
+
+// AuditFilter narrows ListAuditEvents. Zero values match everything.
+type AuditFilter struct {
+	EntityType string
+	EntityId   string
+	Actor      string
+	From       time.Time
+	To         time.Time
+	PageSize   int
+	PageToken  string
+}
+
+const auditEventColumns = `id, seq, occurred_at, actor, COALESCE(actor_ip, ''), procedure, entity_type, entity_id,
+		action, COALESCE(before::text, ''), COALESCE(after::text, ''), COALESCE(prev_hash, ''), hash, redacted_at`
+
+func scanAuditEvent(row pgx.Row) (*pb.AuditEvent, error) {
+	var e pb.AuditEvent
+	var occurredAt time.Time
+	var redactedAt *time.Time
+
+	err := row.Scan(
+		&e.Id,
+		&e.Sequence,
+		&occurredAt,
+		&e.Actor,
+		&e.ActorIp,
+		&e.Procedure,
+		&e.EntityType,
+		&e.EntityId,
+		&e.Action,
+		&e.Before,
+		&e.After,
+		&e.PrevHash,
+		&e.Hash,
+		&redactedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e.OccurredAt = timestamppb.New(occurredAt)
+	if redactedAt != nil {
+		e.RedactedAt = timestamppb.New(*redactedAt)
+	}
+	return &e, nil
+}
+
+// ListAuditEvents lists audit events newest first.
+func (db *Database) ListAuditEvents(ctx context.Context, filter AuditFilter) ([]*pb.AuditEvent, string, error) {
+	var conditions []string
+	var args []any
+
+	if filter.EntityType != "" {
+		args = append(args, filter.EntityType)
+		conditions = append(conditions, fmt.Sprintf("entity_type = $%d", len(args)))
+	}
+	if filter.EntityId != "" {
+		args = append(args, filter.EntityId)
+		conditions = append(conditions, fmt.Sprintf("entity_id::text = $%d", len(args)))
+	}
+	if filter.Actor != "" {
+		args = append(args, filter.Actor)
+		conditions = append(conditions, fmt.Sprintf("actor = $%d", len(args)))
+	}
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		conditions = append(conditions, fmt.Sprintf("occurred_at >= $%d", len(args)))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		conditions = append(conditions, fmt.Sprintf("occurred_at < $%d", len(args)))
+	}
+	if filter.PageToken != "" {
+		seq, err := decodeSequenceToken(filter.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		args = append(args, seq)
+		conditions = append(conditions, fmt.Sprintf("seq < $%d", len(args)))
+	}
+
+	where := "TRUE"
+	if len(conditions) > 0 {
+		where = strings.Join(conditions, " AND ")
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	query := fmt.Sprintf(`
+	SELECT %s
+	FROM audit_events
+	WHERE %s
+	ORDER BY seq DESC
+	LIMIT %d`, auditEventColumns, where, pageSize+1)
+
+	rows, err := db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var result []*pb.AuditEvent
+	for rows.Next() {
+		e, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("error scanning row: %w", err)
+		}
+		result = append(result, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(result) > pageSize {
+		result = result[:pageSize]
+		nextPageToken = encodeSequenceToken(result[len(result)-1].Sequence)
+	}
+
+	return result, nextPageToken, nil
+}
+
+// AuditVerification is the outcome of checking the audit log's hash chain.
+type AuditVerification struct {
+	Valid   bool
+	Checked int64
+	// FirstInvalid is the sequence of the first event that doesn't fit the
+	// chain, when Valid is false.
+	FirstInvalid int64
+	HeadHash     string
+}
+
+// VerifyAuditLog walks the whole audit log in order, checking that sequence
+// numbers have no gaps, that each event links to the hash of the one before
+// and that every hash and snapshot digest still matches what it covers.
+// Redacted snapshots no longer match their digests, so only their hashes are
+// checked.
+func (db *Database) VerifyAuditLog(ctx context.Context) (*AuditVerification, error) {
+	query := `
+	SELECT seq, COALESCE(prev_hash, ''), hash,
+		hash = audit_event_hash(a) AND (redacted_at IS NOT NULL OR (
+			before_digest IS NOT DISTINCT FROM audit_snapshot_digest(before)
+			AND after_digest IS NOT DISTINCT FROM audit_snapshot_digest(after)))
+	FROM audit_events a
+	ORDER BY seq`
+
+	rows, err := db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &AuditVerification{Valid: true}
+	for rows.Next() {
+		var seq int64
+		var prevHash, hash string
+		var matches bool
+
+		if err := rows.Scan(&seq, &prevHash, &hash, &matches); err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+
+		if seq != result.Checked+1 || prevHash != result.HeadHash || !matches {
+			result.Valid = false
+			result.FirstInvalid = result.Checked + 1
+			return result, nil
+		}
+
+		result.Checked = seq
+		result.HeadHash = hash
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAppointmentAsOf returns the appointment as the audit log recorded it at
+// asOf. Appointments deleted by then are returned with their deleted_at set.
+// It returns ErrAppointmentNotFound if the appointment did not exist yet, or
+// predates the audit log.
+func (db *Database) GetAppointmentAsOf(ctx context.Context, id string, asOf time.Time) (*pb.Appointment, error) {
+	query := `
+	SELECT after::text FROM audit_events
+	WHERE entity_type = 'appointment' AND entity_id::text = $1 AND occurred_at <= $2 AND after IS NOT NULL
+	ORDER BY seq DESC
+	LIMIT 1`
+
+	var snapshot string
+	err := db.Pool.QueryRow(ctx, query, id, asOf).Scan(&snapshot)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAppointmentNotFound
+		}
+		return nil, err
+	}
+
+	var appt pb.Appointment
+	if err := protojson.Unmarshal([]byte(snapshot), &appt); err != nil {
+		return nil, fmt.Errorf("failed to decode audit snapshot: %w", err)
+	}
+
+	return &appt, nil
+}
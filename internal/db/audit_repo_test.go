@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAuditLog(t *testing.T) {
+	db := createTestDB(t)
+	ctx := WithAudit(context.Background(), AuditContext{Actor: "admin", IP: "10.0.0.1", Procedure: "/test.Service/Method"})
+
+	user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Test user", Email: "test@user.com"})
+	require.NoError(t, err)
+
+	start := time.Now().Add(24 * time.Hour)
+	appt := &pb.Appointment{
+		Id:                 uuid.NewString(),
+		UserId:             user.Id,
+		Title:              "Test title",
+		Date:               timestamppb.New(start),
+		ContactInformation: &pb.ContactInformation{Name: "Test", Email: "test@example.com"},
+		StartTime:          timestamppb.New(start),
+		EndTime:            timestamppb.New(start.Add(time.Hour)),
+	}
+
+	beforeCreate := time.Now()
+	require.NoError(t, db.CreateAppointment(ctx, appt))
+	afterCreate := time.Now()
+	_, err = db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
+	require.NoError(t, err)
+
+	t.Run("records changes with the actor and snapshots", func(t *testing.T) {
+		events, _, err := db.ListAuditEvents(ctx, AuditFilter{EntityId: appt.Id})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+
+		deleted, created := events[0], events[1]
+		assert.Equal(t, EventAppointmentCancelled, deleted.Action)
+		assert.Equal(t, EventAppointmentCreated, created.Action)
+		assert.Equal(t, "admin", created.Actor)
+		assert.Equal(t, "10.0.0.1", created.ActorIp)
+		assert.Equal(t, "/test.Service/Method", created.Procedure)
+		assert.Empty(t, created.Before)
+		assert.Contains(t, created.After, "Test title")
+		assert.NotContains(t, deleted.Before, "deletedAt")
+		assert.Contains(t, deleted.After, "deletedAt")
+		assert.Equal(t, created.Hash, deleted.PrevHash)
+	})
+
+	t.Run("changes outside a request are attributed to system", func(t *testing.T) {
+		other, err := db.CreateUser(context.Background(), &pb.User{Id: uuid.NewString(), Name: "Other", Email: "other@user.com"})
+		require.NoError(t, err)
+
+		events, _, err := db.ListAuditEvents(ctx, AuditFilter{EntityId: other.Id})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "system", events[0].Actor)
+		assert.Empty(t, events[0].ActorIp)
+	})
+
+	t.Run("pages newest first", func(t *testing.T) {
+		first, token, err := db.ListAuditEvents(ctx, AuditFilter{PageSize: 2})
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+		require.NotEmpty(t, token)
+		assert.Greater(t, first[0].Sequence, first[1].Sequence)
+
+		rest, token, err := db.ListAuditEvents(ctx, AuditFilter{PageSize: 2, PageToken: token})
+		require.NoError(t, err)
+		assert.Empty(t, token)
+		require.Len(t, rest, 2)
+		assert.Equal(t, first[1].Sequence-1, rest[0].Sequence)
+
+		_, _, err = db.ListAuditEvents(ctx, AuditFilter{PageToken: "garbage"})
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+
+	t.Run("returns the appointment as it was", func(t *testing.T) {
+		_, err := db.GetAppointmentAsOf(ctx, appt.Id, beforeCreate)
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+
+		asOf, err := db.GetAppointmentAsOf(ctx, appt.Id, afterCreate)
+		require.NoError(t, err)
+		assert.Equal(t, "Test title", asOf.Title)
+		assert.Nil(t, asOf.DeletedAt)
+
+		asOf, err = db.GetAppointmentAsOf(ctx, appt.Id, time.Now())
+		require.NoError(t, err)
+		assert.NotNil(t, asOf.DeletedAt)
+	})
+
+	t.Run("verifies the chain", func(t *testing.T) {
+		result, err := db.VerifyAuditLog(ctx)
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.EqualValues(t, 4, result.Checked)
+
+		latest, _, err := db.ListAuditEvents(ctx, AuditFilter{PageSize: 1})
+		require.NoError(t, err)
+		assert.Equal(t, latest[0].Hash, result.HeadHash)
+	})
+
+	t.Run("rejects changes to recorded events", func(t *testing.T) {
+		_, err := db.Pool.Exec(ctx, `UPDATE audit_events SET actor = 'someone else'`)
+		assert.Error(t, err)
+
+		_, err = db.Pool.Exec(ctx, `DELETE FROM audit_events`)
+		assert.Error(t, err)
+	})
+}
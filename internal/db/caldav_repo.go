@@ -0,0 +1,230 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// These queries back the CalDAV endpoint. Calendar objects are addressed by
+// name within a user's calendar, and writes can be made conditional on the
+// updated_at the client last saw so concurrent edits are detected.
+
+// calendarObjectMatch selects a user's appointment by its CalDAV object name,
+// with the user id in $1 and the name in $2.
+const calendarObjectMatch = `user_id = $1 AND (resource_name = $2 OR (resource_name IS NULL AND id::text || '.ics' = $2))`
+
+// ListCalendarEntries returns a user's active appointments overlapping
+// [from, to). A zero from or to leaves that side of the range open.
+func (db *Database) ListCalendarEntries(ctx context.Context, userId string, from, to time.Time) ([]*CalendarEntry, error) {
+	query := `
+	SELECT ` + calendarEntryColumns + `
+	FROM appointments
+	WHERE user_id = $1 AND deleted_at IS NULL AND hold_expires_at IS NULL
+		AND ($2::timestamptz IS NULL OR end_time > $2)
+		AND ($3::timestamptz IS NULL OR start_time < $3)
+	ORDER BY start_time, id`
+
+	rows, err := db.Pool.Query(ctx, query, userId, nullableTime(from), nullableTime(to))
+	if err != nil {
+		return nil, err
+	}
+
+	return collectCalendarEntries(rows)
+}
+
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// GetCalendarEntry returns the active appointment stored under name.
+func (db *Database) GetCalendarEntry(ctx context.Context, userId, name string) (*CalendarEntry, error) {
+	query := `
+	SELECT ` + calendarEntryColumns + `
+	FROM appointments
+	WHERE ` + calendarObjectMatch + ` AND deleted_at IS NULL AND hold_expires_at IS NULL`
+
+	entry, err := scanCalendarEntry(db.Pool.QueryRow(ctx, query, userId, name))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAppointmentNotFound
+		}
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// CreateCalendarAppointment books an appointment uploaded by a calendar
+// client under the given object name and iCalendar UID, held to the booking
+// policy at now as BookAppointment is.
+func (db *Database) CreateCalendarAppointment(ctx context.Context, appt *pb.Appointment, name, icalUID string, now time.Time) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = bookAppointment(ctx, tx, appt, icalUID, name, now, db.ReminderOffsets)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return ErrCalendarObjectExists
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UpdateCalendarAppointment replaces the schedule and text of the active
+// appointment stored under name. A new time is a reschedule at now, with
+// the checks and history of RescheduleAppointment. When unmodifiedSince is
+// set the update only applies if the appointment's updated_at still equals
+// it.
+func (db *Database) UpdateCalendarAppointment(ctx context.Context, appt *pb.Appointment, name string, unmodifiedSince *time.Time, now time.Time) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := lockActiveAppointment(ctx, tx, calendarObjectMatch, appt.UserId, name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAppointmentNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if unmodifiedSince != nil && !before.UpdatedAt.Equal(*unmodifiedSince) {
+		return ErrAppointmentModified
+	}
+	if err := loadParticipants(ctx, tx, []*pb.Appointment{before.Appointment}); err != nil {
+		return err
+	}
+
+	event := EventAppointmentUpdated
+	moved := !before.Appointment.StartTime.AsTime().Equal(appt.StartTime.AsTime()) ||
+		!before.Appointment.EndTime.AsTime().Equal(appt.EndTime.AsTime())
+	if moved {
+		if _, err := db.moveAppointment(ctx, tx, before.Appointment, appt.StartTime.AsTime(), appt.EndTime.AsTime(), "", now); err != nil {
+			return err
+		}
+		event = EventAppointmentRescheduled
+	}
+
+	query := `
+	UPDATE appointments
+	SET title = $2, description = $3, date = $4, updated_at = NOW()
+	WHERE id = $1
+	RETURNING ` + calendarEntryColumns
+
+	entry, err := scanCalendarEntry(tx.QueryRow(ctx, query,
+		before.Appointment.Id,
+		appt.Title,
+		appt.Description,
+		appt.Date.AsTime(),
+	))
+	if err != nil {
+		return err
+	}
+	entry.Appointment.Participants = before.Appointment.Participants
+
+	if err := recordAppointmentChange(ctx, tx, event, before.Appointment, entry.Appointment); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// DeleteCalendarAppointment soft deletes the active appointment stored under
+// name, under the same unmodifiedSince condition as UpdateCalendarAppointment.
+// This is a client cancelling at now, as with DeleteAppointment.
+func (db *Database) DeleteCalendarAppointment(ctx context.Context, userId, name string, unmodifiedSince *time.Time, now time.Time) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := lockActiveAppointment(ctx, tx, calendarObjectMatch, userId, name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAppointmentNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	c := &pb.Cancellation{CancelledBy: pb.CancelledBy_CANCELLED_BY_CLIENT}
+	if err := checkCancellation(ctx, tx, before.Appointment, c, now); err != nil {
+		return err
+	}
+
+	query := `
+	UPDATE appointments SET deleted_at = NOW(), updated_at = NOW(), cancelled_by = 'client', cancelled_late = $4
+	WHERE ` + calendarObjectMatch + ` AND deleted_at IS NULL AND hold_expires_at IS NULL
+		AND ($3::timestamptz IS NULL OR updated_at = $3)
+	RETURNING ` + calendarEntryColumns
+
+	entry, err := scanCalendarEntry(tx.QueryRow(ctx, query, userId, name, unmodifiedSince, c.Late))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.missingCalendarObject(ctx, userId, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	if c.Late {
+		if err := recordLateCancellation(ctx, tx, entry.Appointment); err != nil {
+			return err
+		}
+	}
+
+	if err := cancelReminders(ctx, tx, entry.Appointment.Id); err != nil {
+		return err
+	}
+
+	if err := offerFreedSlot(ctx, tx, before.Appointment.StartTime.AsTime(), before.Appointment.EndTime.AsTime()); err != nil {
+		return err
+	}
+
+	if err := recordAppointmentChange(ctx, tx, EventAppointmentCancelled, before.Appointment, entry.Appointment); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// missingCalendarObject explains why a conditional write touched no rows.
+func (db *Database) missingCalendarObject(ctx context.Context, userId, name string) error {
+	query := `SELECT EXISTS (SELECT 1 FROM appointments WHERE ` + calendarObjectMatch + ` AND deleted_at IS NULL AND hold_expires_at IS NULL)`
+
+	var exists bool
+	if err := db.Pool.QueryRow(ctx, query, userId, name).Scan(&exists); err != nil {
+		return err
+	}
+
+	if exists {
+		return ErrAppointmentModified
+	}
+	return ErrAppointmentNotFound
+}
+
+// CalendarLastModified returns the latest updated_at across all of a user's
+// appointments, deleted ones included, so it moves on every change.
+func (db *Database) CalendarLastModified(ctx context.Context, userId string) (time.Time, error) {
+	query := `SELECT COALESCE(MAX(updated_at), 'epoch'::timestamptz) FROM appointments WHERE user_id = $1 AND hold_expires_at IS NULL`
+
+	var lastModified time.Time
+	err := db.Pool.QueryRow(ctx, query, userId).Scan(&lastModified)
+
+	return lastModified, err
+}
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCalendarAppointments(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{
+		Id:    uuid.NewString(),
+		Name:  "Test user",
+		Email: "test@user.com",
+	})
+	require.NoError(t, err)
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	newAppointment := func(offset time.Duration) *pb.Appointment {
+		return &pb.Appointment{
+			Id:          uuid.NewString(),
+			UserId:      user.Id,
+			Title:       "From CalDAV",
+			Description: "",
+			Date:        timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{
+				Name:  user.Name,
+				Email: user.Email,
+			},
+			StartTime: timestamppb.New(start.Add(offset)),
+			EndTime:   timestamppb.New(start.Add(offset + time.Hour)),
+		}
+	}
+
+	appt := newAppointment(0)
+	require.NoError(t, db.CreateCalendarAppointment(ctx, appt, "event.ics", "uid-1", time.Now()))
+
+	t.Run("finds objects by resource name or id", func(t *testing.T) {
+		entry, err := db.GetCalendarEntry(ctx, user.Id, "event.ics")
+		assert.NoError(t, err)
+		assert.Equal(t, appt.Id, entry.Appointment.Id)
+		assert.Equal(t, "uid-1", entry.ICalUID)
+
+		plain := newAppointment(2 * time.Hour)
+		require.NoError(t, db.CreateAppointment(ctx, plain))
+
+		entry, err = db.GetCalendarEntry(ctx, user.Id, plain.Id+".ics")
+		assert.NoError(t, err)
+		assert.Equal(t, plain.Id, entry.Appointment.Id)
+	})
+
+	t.Run("rejects a second object with the same name", func(t *testing.T) {
+		err := db.CreateCalendarAppointment(ctx, newAppointment(4*time.Hour), "event.ics", "uid-2", time.Now())
+		assert.ErrorIs(t, err, ErrCalendarObjectExists)
+	})
+
+	t.Run("lists objects overlapping a range", func(t *testing.T) {
+		entries, err := db.ListCalendarEntries(ctx, user.Id, start.Add(90*time.Minute), time.Time{})
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("conditional updates detect concurrent changes", func(t *testing.T) {
+		entry, err := db.GetCalendarEntry(ctx, user.Id, "event.ics")
+		require.NoError(t, err)
+
+		stale := entry.UpdatedAt.Add(-time.Second)
+		appt.Title = "Renamed"
+		err = db.UpdateCalendarAppointment(ctx, appt, "event.ics", &stale, time.Now())
+		assert.ErrorIs(t, err, ErrAppointmentModified)
+
+		err = db.UpdateCalendarAppointment(ctx, appt, "event.ics", &entry.UpdatedAt, time.Now())
+		assert.NoError(t, err)
+
+		updated, err := db.GetCalendarEntry(ctx, user.Id, "event.ics")
+		assert.NoError(t, err)
+		assert.Equal(t, "Renamed", updated.Appointment.Title)
+	})
+
+	t.Run("updates go through the overlap constraint", func(t *testing.T) {
+		moved := newAppointment(2 * time.Hour)
+		err := db.UpdateCalendarAppointment(ctx, moved, "event.ics", nil, time.Now())
+		assert.ErrorIs(t, err, ErrAppointmentConflict)
+	})
+
+	t.Run("writes are held to the booking policy and moves are recorded", func(t *testing.T) {
+		_, err := db.UpdateSchedule(ctx, &pb.Schedule{Policy: &pb.BookingPolicy{
+			MaxAdvance: durationpb.New(7 * 24 * time.Hour),
+		}}, []string{"policy"})
+		require.NoError(t, err)
+		defer func() {
+			_, err := db.UpdateSchedule(ctx, &pb.Schedule{}, []string{"policy"})
+			require.NoError(t, err)
+		}()
+
+		err = db.CreateCalendarAppointment(ctx, newAppointment(30*24*time.Hour), "later.ics", "uid-3", time.Now())
+		assert.ErrorIs(t, err, ErrBookingPolicy)
+
+		tooLate := newAppointment(30 * 24 * time.Hour)
+		tooLate.Title = "Renamed"
+		err = db.UpdateCalendarAppointment(ctx, tooLate, "event.ics", nil, time.Now())
+		assert.ErrorIs(t, err, ErrBookingPolicy)
+
+		moved := newAppointment(30 * time.Minute)
+		moved.Title = "Renamed"
+		require.NoError(t, db.UpdateCalendarAppointment(ctx, moved, "event.ics", nil, time.Now()))
+
+		reschedules, err := db.ListReschedules(ctx, appt.Id)
+		require.NoError(t, err)
+		require.Len(t, reschedules, 1)
+		assert.True(t, reschedules[0].PreviousStartTime.AsTime().Equal(start))
+		assert.True(t, reschedules[0].StartTime.AsTime().Equal(start.Add(30*time.Minute)))
+	})
+
+	t.Run("delete is a soft delete", func(t *testing.T) {
+		err := db.DeleteCalendarAppointment(ctx, user.Id, "event.ics", nil, time.Now())
+		assert.NoError(t, err)
+
+		_, err = db.GetCalendarEntry(ctx, user.Id, "event.ics")
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+
+		entries, err := db.GetCalendarEntries(ctx, user.Id)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
+}
@@ -0,0 +1,131 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+var ErrCancellationCutoff = errors.New("appointment starts too soon to be cancelled")
+
+var cancellationReasons = map[pb.CancellationReason]string{
+	pb.CancellationReason_CANCELLATION_REASON_SCHEDULE_CONFLICT:    "schedule_conflict",
+	pb.CancellationReason_CANCELLATION_REASON_ILLNESS:              "illness",
+	pb.CancellationReason_CANCELLATION_REASON_NO_LONGER_NEEDED:     "no_longer_needed",
+	pb.CancellationReason_CANCELLATION_REASON_PROVIDER_UNAVAILABLE: "provider_unavailable",
+	pb.CancellationReason_CANCELLATION_REASON_OTHER:                "other",
+}
+
+var cancellers = map[pb.CancelledBy]string{
+	pb.CancelledBy_CANCELLED_BY_CLIENT:   "client",
+	pb.CancelledBy_CANCELLED_BY_PROVIDER: "provider",
+}
+
+func cancellationReasonFromDB(reason string) pb.CancellationReason {
+	for k, v := range cancellationReasons {
+		if v == reason {
+			return k
+		}
+	}
+	return pb.CancellationReason_CANCELLATION_REASON_UNSPECIFIED
+}
+
+func cancelledByFromDB(by string) pb.CancelledBy {
+	for k, v := range cancellers {
+		if v == by {
+			return k
+		}
+	}
+	return pb.CancelledBy_CANCELLED_BY_UNSPECIFIED
+}
+
+// cancellationColumns sets the cancellation of an appointment being soft
+// deleted from the reason, note, canceller and late flag in $2 to $5.
+const cancellationColumns = `cancellation_reason = NULLIF($2, ''), cancellation_note = $3,
+	cancelled_by = NULLIF($4, ''), cancelled_late = $5`
+
+// cancellationArgs returns the values for $2 to $5 of cancellationColumns.
+func cancellationArgs(c *pb.Cancellation) ([]any, error) {
+	reason, ok := cancellationReasons[c.GetReason()]
+	if !ok && c.GetReason() != pb.CancellationReason_CANCELLATION_REASON_UNSPECIFIED {
+		return nil, fmt.Errorf("unknown cancellation reason %v", c.GetReason())
+	}
+	by, ok := cancellers[c.GetCancelledBy()]
+	if !ok && c.GetCancelledBy() != pb.CancelledBy_CANCELLED_BY_UNSPECIFIED {
+		return nil, fmt.Errorf("unknown canceller %v", c.GetCancelledBy())
+	}
+
+	return []any{reason, c.GetNote(), by, c.GetLate()}, nil
+}
+
+// checkCancellation decides whether a cancellation of appt at now, by
+// whoever c names, goes ahead under the schedule, and sets c.Late. Only a
+// client is held to the cancellation cutoff, with ErrCancellationCutoff, or
+// ever cancels late.
+func checkCancellation(ctx context.Context, q querier, appt *pb.Appointment, c *pb.Cancellation, now time.Time) error {
+	c.Late = false
+	if c.CancelledBy != pb.CancelledBy_CANCELLED_BY_CLIENT {
+		return nil
+	}
+
+	schedule, err := getSchedule(ctx, q, "")
+	if err != nil {
+		return err
+	}
+
+	notice := appt.StartTime.AsTime().Sub(now)
+	if cutoff := schedule.CancellationCutoff.AsDuration(); cutoff > 0 && notice < cutoff {
+		return ErrCancellationCutoff
+	}
+	if window := schedule.LateCancellationWindow.AsDuration(); window > 0 && notice < window {
+		c.Late = true
+	}
+
+	return nil
+}
+
+// recordLateCancellation notes that appt was cancelled late. These rows
+// outlive the appointment's own cancelled_late flag, which a restore clears,
+// so restoring and cancelling again cannot wipe a user's history. They also
+// outlive the appointment, losing only its id once it is purged.
+func recordLateCancellation(ctx context.Context, q querier, appt *pb.Appointment) error {
+	query := `
+	INSERT INTO late_cancellations (id, appointment_id, user_id)
+	VALUES ($1, $2, $3)`
+
+	_, err := q.Exec(ctx, query, uuid.NewString(), appt.Id, appt.UserId)
+	return err
+}
+
+// CountLateCancellations counts the late cancellations of userId since the
+// given time, or ever if it is zero, including those of appointments that
+// have been restored since. It returns ErrUserNotFound for an unknown user.
+func (db *Database) CountLateCancellations(ctx context.Context, userId string, since time.Time) (int, error) {
+	var exists bool
+	if err := db.Pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)`, userId).Scan(&exists); err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, ErrUserNotFound
+	}
+
+	var sinceArg *time.Time
+	if !since.IsZero() {
+		sinceArg = &since
+	}
+
+	query := `
+	SELECT COUNT(*) FROM late_cancellations
+	WHERE user_id = $1 AND ($2::timestamptz IS NULL OR cancelled_at >= $2)`
+
+	var count int
+	if err := db.Pool.QueryRow(ctx, query, userId, sinceArg).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCancellationPolicy(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Ann", Email: "ann@example.com"})
+	require.NoError(t, err)
+
+	_, err = db.UpdateSchedule(ctx, &pb.Schedule{
+		CancellationCutoff:     durationpb.New(24 * time.Hour),
+		LateCancellationWindow: durationpb.New(48 * time.Hour),
+	}, []string{"cancellation_cutoff", "late_cancellation_window"})
+	require.NoError(t, err)
+
+	newAppointment := func(start time.Time) *pb.Appointment {
+		appt := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Title:              "Consultation",
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: user.Name, Email: user.Email},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+		}
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		return appt
+	}
+	client := func(reason pb.CancellationReason, note string) *pb.Cancellation {
+		return &pb.Cancellation{Reason: reason, Note: note, CancelledBy: pb.CancelledBy_CANCELLED_BY_CLIENT}
+	}
+
+	now := time.Now()
+	start := now.Truncate(time.Hour)
+
+	t.Run("clients cannot cancel within the cutoff but providers can", func(t *testing.T) {
+		appt := newAppointment(start.Add(12 * time.Hour))
+
+		_, err := db.DeleteAppointment(ctx, appt.Id, client(pb.CancellationReason_CANCELLATION_REASON_ILLNESS, ""), now)
+		assert.ErrorIs(t, err, ErrCancellationCutoff)
+
+		cancelled, err := db.DeleteAppointment(ctx, appt.Id, &pb.Cancellation{
+			Reason:      pb.CancellationReason_CANCELLATION_REASON_PROVIDER_UNAVAILABLE,
+			Note:        "clinic closed",
+			CancelledBy: pb.CancelledBy_CANCELLED_BY_PROVIDER,
+		}, now)
+		require.NoError(t, err)
+		require.NotNil(t, cancelled.Cancellation)
+		assert.Equal(t, pb.CancellationReason_CANCELLATION_REASON_PROVIDER_UNAVAILABLE, cancelled.Cancellation.Reason)
+		assert.Equal(t, "clinic closed", cancelled.Cancellation.Note)
+		assert.Equal(t, pb.CancelledBy_CANCELLED_BY_PROVIDER, cancelled.Cancellation.CancelledBy)
+		assert.False(t, cancelled.Cancellation.Late)
+	})
+
+	t.Run("clients cancelling within the late window are flagged", func(t *testing.T) {
+		late := newAppointment(start.Add(36 * time.Hour))
+		early := newAppointment(start.Add(72 * time.Hour))
+
+		cancelled, err := db.DeleteAppointment(ctx, late.Id, client(pb.CancellationReason_CANCELLATION_REASON_SCHEDULE_CONFLICT, "work"), now)
+		require.NoError(t, err)
+		assert.True(t, cancelled.Cancellation.Late)
+
+		cancelled, err = db.DeleteAppointment(ctx, early.Id, client(pb.CancellationReason_CANCELLATION_REASON_NO_LONGER_NEEDED, ""), now)
+		require.NoError(t, err)
+		assert.False(t, cancelled.Cancellation.Late)
+
+		_, _, total, err := db.ListAppointments(ctx, ListAppointmentsOptions{
+			Filter: fmt.Sprintf(`user_id = "%s" AND cancelled_late = true`, user.Id),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+
+		count, err := db.CountLateCancellations(ctx, user.Id, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		t.Run("and still counted once the appointment is restored", func(t *testing.T) {
+			restored, err := db.RestoreAppointment(ctx, late.Id)
+			require.NoError(t, err)
+			assert.Nil(t, restored.Cancellation)
+
+			_, _, total, err := db.ListAppointments(ctx, ListAppointmentsOptions{
+				Filter: fmt.Sprintf(`user_id = "%s" AND cancelled_late = true`, user.Id),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 0, total)
+
+			count, err := db.CountLateCancellations(ctx, user.Id, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, 1, count)
+
+			_, err = db.DeleteAppointment(ctx, late.Id, client(pb.CancellationReason_CANCELLATION_REASON_ILLNESS, ""), now)
+			require.NoError(t, err)
+
+			count, err = db.CountLateCancellations(ctx, user.Id, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, 2, count)
+
+			count, err = db.CountLateCancellations(ctx, user.Id, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, 0, count)
+		})
+
+		t.Run("and still counted once the appointment is purged", func(t *testing.T) {
+			purged, err := db.PurgeDeletedAppointments(ctx, time.Now().Add(time.Minute), false, 10)
+			require.NoError(t, err)
+			assert.NotZero(t, purged)
+
+			var exists bool
+			require.NoError(t, db.Pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM appointments WHERE id = $1)`, late.Id).Scan(&exists))
+			require.False(t, exists)
+
+			count, err := db.CountLateCancellations(ctx, user.Id, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, 2, count)
+		})
+	})
+
+	t.Run("counting late cancellations of an unknown user fails", func(t *testing.T) {
+		_, err := db.CountLateCancellations(ctx, uuid.NewString(), time.Time{})
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
@@ -4,17 +4,78 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	pb "github.com/folucode/appointment-scheduler/proto"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var ErrUserNotFound = errors.New("user not found")
 
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+var ErrAppointmentNotFound = errors.New("appointment not found")
+
+var ErrAppointmentModified = errors.New("appointment was modified since it was read")
+
+var ErrCalendarObjectExists = errors.New("an appointment with this calendar object name or UID already exists")
+
+var ErrAppointmentConflict = errors.New("conflict: this time slot overlaps with an existing appointment")
+
+// AppointmentConflictError is an ErrAppointmentConflict that knows which
+// appointment holds the slot.
+type AppointmentConflictError struct {
+	Blocking *pb.Appointment
+}
+
+func (e *AppointmentConflictError) Error() string {
+	return fmt.Sprintf("%v (appointment %s)", ErrAppointmentConflict, e.Blocking.Id)
+}
+
+func (e *AppointmentConflictError) Unwrap() error {
+	return ErrAppointmentConflict
+}
+
+var ErrBookingPolicy = errors.New("the appointment breaks the booking policy")
+
+// BookingPolicyError is an ErrBookingPolicy listing every rule broken.
+type BookingPolicyError struct {
+	Violations []*pb.BookingPolicyViolation
+}
+
+func (e *BookingPolicyError) Error() string {
+	descriptions := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		descriptions[i] = v.Description
+	}
+	return fmt.Sprintf("%v: %s", ErrBookingPolicy, strings.Join(descriptions, "; "))
+}
+
+func (e *BookingPolicyError) Unwrap() error {
+	return ErrBookingPolicy
+}
+
 type Database struct {
 	Pool *pgxpool.Pool
+
+	// ReminderOffsets are how long before an appointment starts its
+	// reminders go out. Every write that books or moves an appointment
+	// schedules them in the same transaction.
+	ReminderOffsets []time.Duration
+}
+
+// querier is satisfied by both the pool and a transaction, so a query can run
+// on its own or as part of a larger unit of work.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
 
 func NewDatabase(ctx context.Context, connString string) (*Database, error) {
@@ -2,71 +2,16 @@ package db
 
 import (
 	"context"
-	"path/filepath"
 	"testing"
 	"time"
 
 	pb "github.com/folucode/appointment-scheduler/proto"
-	"github.com/golang-migrate/migrate/v4"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/testcontainers/testcontainers-go"
-	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	"github.com/testcontainers/testcontainers-go/wait"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func createTestDB(t *testing.T) *Database {
-	ctx := context.Background()
-
-	container, err := postgres.Run(
-		ctx,
-		"postgres:16-alpine",
-		postgres.WithDatabase("testdb"),
-		postgres.WithUsername("user"),
-		postgres.WithPassword("password"),
-		testcontainers.WithWaitStrategy(
-			wait.ForLog("database system is ready to accept connections").
-				WithOccurrence(2).
-				WithStartupTimeout(30*time.Second),
-		),
-	)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	t.Cleanup(func() {
-		_ = container.Terminate(context.Background())
-	})
-
-	connStr, err := container.ConnectionString(ctx, "sslmode=disable")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	absPath, err := filepath.Abs("../migrations")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	m, err := migrate.New("file://"+absPath, connStr)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
-		t.Fatal(err)
-	}
-
-	db, err := NewDatabase(ctx, connStr)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	return db
-}
-
 func TestFindUserByEmail(t *testing.T) {
 	db := createTestDB(t)
 	ctx := context.Background()
@@ -202,10 +147,10 @@ func TestDeleteAppointment(t *testing.T) {
 		err := db.CreateAppointment(ctx, appt)
 		assert.NoError(t, err)
 
-		deleteCount, delErr := db.DeleteAppointment(ctx, appt.Id)
+		deleted, delErr := db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
 		assert.NoError(t, delErr)
 
-		assert.Equal(t, deleteCount, true)
+		assert.NotNil(t, deleted.DeletedAt)
 
 	})
 }
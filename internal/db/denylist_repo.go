@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var denylistKinds = map[pb.DenylistKind]string{
+	pb.DenylistKind_DENYLIST_KIND_EMAIL: "email",
+	pb.DenylistKind_DENYLIST_KIND_IP:    "ip",
+}
+
+func (db *Database) AddDenylistEntry(ctx context.Context, entry *pb.DenylistEntry) (*pb.DenylistEntry, error) {
+	kind, ok := denylistKinds[entry.Kind]
+	if !ok {
+		return nil, errors.New("denylist kind must be email or ip")
+	}
+
+	query := `
+	INSERT INTO denylist_entries (id, kind, value, reason)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (kind, value) DO UPDATE SET reason = EXCLUDED.reason
+	RETURNING id, value, reason, created_at`
+
+	var created pb.DenylistEntry
+	var createdAt time.Time
+
+	err := db.Pool.QueryRow(ctx, query,
+		entry.Id,
+		kind,
+		normalizeDenylistValue(entry.Value),
+		entry.Reason,
+	).Scan(
+		&created.Id,
+		&created.Value,
+		&created.Reason,
+		&createdAt,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to add denylist entry: %w", err)
+	}
+
+	created.Kind = entry.Kind
+	created.CreatedAt = timestamppb.New(createdAt)
+
+	return &created, nil
+}
+
+func (db *Database) RemoveDenylistEntry(ctx context.Context, id string) (bool, error) {
+	query := `DELETE FROM denylist_entries WHERE id = $1`
+
+	commandTag, err := db.Pool.Exec(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+
+	return commandTag.RowsAffected() > 0, nil
+}
+
+func (db *Database) ListDenylistEntries(ctx context.Context) ([]*pb.DenylistEntry, error) {
+	query := `SELECT id, kind, value, reason, created_at FROM denylist_entries ORDER BY created_at DESC`
+
+	rows, err := db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*pb.DenylistEntry
+	for rows.Next() {
+		var e pb.DenylistEntry
+		var kind string
+		var createdAt time.Time
+
+		if err := rows.Scan(&e.Id, &kind, &e.Value, &e.Reason, &createdAt); err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+
+		for k, v := range denylistKinds {
+			if v == kind {
+				e.Kind = k
+			}
+		}
+		e.CreatedAt = timestamppb.New(createdAt)
+
+		result = append(result, &e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// IsDenylisted reports whether either the IP address or the email has been
+// denylisted. Empty values are never matched.
+func (db *Database) IsDenylisted(ctx context.Context, ip, email string) (bool, error) {
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM denylist_entries
+		WHERE (kind = 'ip' AND value = $1 AND $1 <> '')
+		   OR (kind = 'email' AND value = $2 AND $2 <> '')
+	)`
+
+	var denied bool
+	err := db.Pool.QueryRow(ctx, query, normalizeDenylistValue(ip), normalizeDenylistValue(email)).Scan(&denied)
+	if err != nil {
+		return false, err
+	}
+
+	return denied, nil
+}
+
+func normalizeDenylistValue(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenylist(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	entry, err := db.AddDenylistEntry(ctx, &pb.DenylistEntry{
+		Id:     uuid.NewString(),
+		Kind:   pb.DenylistKind_DENYLIST_KIND_EMAIL,
+		Value:  "Spammer@Example.com",
+		Reason: "bulk bookings",
+	})
+	require.NoError(t, err)
+
+	t.Run("matches denylisted emails case-insensitively", func(t *testing.T) {
+		denied, err := db.IsDenylisted(ctx, "203.0.113.7", "spammer@example.com")
+		assert.NoError(t, err)
+		assert.True(t, denied)
+	})
+
+	t.Run("does not match empty values", func(t *testing.T) {
+		denied, err := db.IsDenylisted(ctx, "", "")
+		assert.NoError(t, err)
+		assert.False(t, denied)
+	})
+
+	t.Run("removed entries no longer match", func(t *testing.T) {
+		removed, err := db.RemoveDenylistEntry(ctx, entry.Id)
+		assert.NoError(t, err)
+		assert.True(t, removed)
+
+		denied, err := db.IsDenylisted(ctx, "", "spammer@example.com")
+		assert.NoError(t, err)
+		assert.False(t, denied)
+	})
+}
@@ -0,0 +1,250 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Feed tokens are stored as hashes; the plaintext token only exists in the
+// subscription URL or CalDAV password handed to the user.
+
+var tokenScopes = map[pb.TokenScope]string{
+	pb.TokenScope_TOKEN_SCOPE_FEED:   "feed",
+	pb.TokenScope_TOKEN_SCOPE_CALDAV: "caldav",
+}
+
+func tokenScopeFromDB(scope string) pb.TokenScope {
+	for k, v := range tokenScopes {
+		if v == scope {
+			return k
+		}
+	}
+	return pb.TokenScope_TOKEN_SCOPE_UNSPECIFIED
+}
+
+func (db *Database) CreateFeedToken(ctx context.Context, id, userId, tokenHash string, scope pb.TokenScope) (*pb.FeedToken, error) {
+	query := `
+	INSERT INTO feed_tokens (id, user_id, token_hash, scope)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id, user_id, created_at`
+
+	dbScope, ok := tokenScopes[scope]
+	if !ok {
+		return nil, fmt.Errorf("unknown token scope %v", scope)
+	}
+
+	var token pb.FeedToken
+	var createdAt time.Time
+
+	err := db.Pool.QueryRow(ctx, query, id, userId, tokenHash, dbScope).Scan(
+		&token.Id,
+		&token.UserId,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create feed token: %w", err)
+	}
+
+	token.CreatedAt = timestamppb.New(createdAt)
+	token.Scope = scope
+
+	return &token, nil
+}
+
+func (db *Database) RevokeFeedToken(ctx context.Context, id, userId string) (bool, error) {
+	query := `UPDATE feed_tokens SET revoked_at = NOW() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`
+
+	commandTag, err := db.Pool.Exec(ctx, query, id, userId)
+	if err != nil {
+		return false, err
+	}
+
+	return commandTag.RowsAffected() > 0, nil
+}
+
+func (db *Database) ListFeedTokens(ctx context.Context, userId string) ([]*pb.FeedToken, error) {
+	query := `
+	SELECT id, user_id, created_at, revoked_at, scope
+	FROM feed_tokens WHERE user_id = $1
+	ORDER BY created_at DESC`
+
+	rows, err := db.Pool.Query(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*pb.FeedToken
+	for rows.Next() {
+		var t pb.FeedToken
+		var createdAt time.Time
+		var revokedAt *time.Time
+		var scope string
+
+		if err := rows.Scan(&t.Id, &t.UserId, &createdAt, &revokedAt, &scope); err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+
+		t.CreatedAt = timestamppb.New(createdAt)
+		t.Scope = tokenScopeFromDB(scope)
+		if revokedAt != nil {
+			t.RevokedAt = timestamppb.New(*revokedAt)
+		}
+
+		result = append(result, &t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// FindUserByFeedToken returns the owner of an unrevoked token with the given
+// scope.
+func (db *Database) FindUserByFeedToken(ctx context.Context, tokenHash string, scope pb.TokenScope) (*pb.User, error) {
+	query := `
+	SELECT u.id, u.name, COALESCE(u.email, ''), u.time_zone
+	FROM feed_tokens t JOIN users u ON u.id = t.user_id
+	WHERE t.token_hash = $1 AND t.scope = $2 AND t.revoked_at IS NULL`
+
+	var user pb.User
+
+	err := db.Pool.QueryRow(ctx, query, tokenHash, tokenScopes[scope]).Scan(
+		&user.Id,
+		&user.Name,
+		&user.Email,
+		&user.TimeZone,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// CalendarEntry is an appointment with the bookkeeping timestamps calendar
+// clients need to order updates. ResourceName is the object's name in the
+// CalDAV collection and ICalUID the UID it was created with, if it came from
+// a calendar client rather than this service.
+type CalendarEntry struct {
+	Appointment  *pb.Appointment
+	ResourceName string
+	ICalUID      string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
+}
+
+// calendarEntryColumns selects what scanCalendarEntry expects. Appointments
+// without an explicit resource name are addressed as "{id}.ics".
+const calendarEntryColumns = `id, user_id, contact_name, contact_email, start_time, end_time, date, title, description,
+		COALESCE(resource_name, id::text || '.ics'), COALESCE(ical_uid, ''), created_at, updated_at, deleted_at, time_zone,
+		buffer_before_seconds, buffer_after_seconds, COALESCE(appointment_type_id::text, ''),
+		COALESCE(capacity, 0), COALESCE(cancellation_reason, ''), cancellation_note, COALESCE(cancelled_by, ''),
+		cancelled_late`
+
+func scanCalendarEntry(row pgx.Row) (*CalendarEntry, error) {
+	var a pb.Appointment
+	var c pb.ContactInformation
+	var start, end, date time.Time
+	var bufferBefore, bufferAfter int64
+	var cancellationReason, cancelledBy string
+	var cancellation pb.Cancellation
+	var entry CalendarEntry
+
+	err := row.Scan(
+		&a.Id,
+		&a.UserId,
+		&c.Name,
+		&c.Email,
+		&start,
+		&end,
+		&date,
+		&a.Title,
+		&a.Description,
+		&entry.ResourceName,
+		&entry.ICalUID,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+		&entry.DeletedAt,
+		&a.TimeZone,
+		&bufferBefore,
+		&bufferAfter,
+		&a.AppointmentTypeId,
+		&a.Capacity,
+		&cancellationReason,
+		&cancellation.Note,
+		&cancelledBy,
+		&cancellation.Late,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	a.ContactInformation = &c
+	a.BufferBefore = durationpb.New(time.Duration(bufferBefore) * time.Second)
+	a.BufferAfter = durationpb.New(time.Duration(bufferAfter) * time.Second)
+
+	a.StartTime = timestamppb.New(start)
+	a.EndTime = timestamppb.New(end)
+	a.Date = timestamppb.New(date)
+	a.CreatedAt = timestamppb.New(entry.CreatedAt)
+	a.UpdatedAt = timestamppb.New(entry.UpdatedAt)
+	if entry.DeletedAt != nil {
+		a.DeletedAt = timestamppb.New(*entry.DeletedAt)
+		cancellation.Reason = cancellationReasonFromDB(cancellationReason)
+		cancellation.CancelledBy = cancelledByFromDB(cancelledBy)
+		a.Cancellation = &cancellation
+	}
+
+	entry.Appointment = &a
+	return &entry, nil
+}
+
+func collectCalendarEntries(rows pgx.Rows) ([]*CalendarEntry, error) {
+	defer rows.Close()
+
+	var result []*CalendarEntry
+	for rows.Next() {
+		entry, err := scanCalendarEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		result = append(result, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetCalendarEntries returns all of a user's appointments, including deleted
+// ones so subscribers can see them as cancelled.
+func (db *Database) GetCalendarEntries(ctx context.Context, userId string) ([]*CalendarEntry, error) {
+	query := `
+	SELECT ` + calendarEntryColumns + `
+	FROM appointments WHERE user_id = $1 AND hold_expires_at IS NULL
+	ORDER BY start_time, id`
+
+	rows, err := db.Pool.Query(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectCalendarEntries(rows)
+}
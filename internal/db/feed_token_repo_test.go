@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFeedTokens(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{
+		Id:    uuid.NewString(),
+		Name:  "Test user",
+		Email: "test@user.com",
+	})
+	require.NoError(t, err)
+
+	token, err := db.CreateFeedToken(ctx, uuid.NewString(), user.Id, "hash", pb.TokenScope_TOKEN_SCOPE_FEED)
+	require.NoError(t, err)
+
+	t.Run("finds the owner of an active token", func(t *testing.T) {
+		owner, err := db.FindUserByFeedToken(ctx, "hash", pb.TokenScope_TOKEN_SCOPE_FEED)
+		assert.NoError(t, err)
+		assert.Equal(t, user.Id, owner.Id)
+	})
+
+	t.Run("tokens only resolve in their own scope", func(t *testing.T) {
+		owner, err := db.FindUserByFeedToken(ctx, "hash", pb.TokenScope_TOKEN_SCOPE_CALDAV)
+		assert.Nil(t, owner)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("revoked tokens no longer resolve", func(t *testing.T) {
+		revoked, err := db.RevokeFeedToken(ctx, token.Id, user.Id)
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+
+		owner, err := db.FindUserByFeedToken(ctx, "hash", pb.TokenScope_TOKEN_SCOPE_FEED)
+		assert.Nil(t, owner)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("calendar entries include deleted appointments", func(t *testing.T) {
+		start := time.Now().Add(24 * time.Hour)
+		appt := &pb.Appointment{
+			Id:          uuid.NewString(),
+			UserId:      user.Id,
+			Title:       "Test title",
+			Description: "Test description",
+			Date:        timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{
+				Name:  "Test",
+				Email: "test@user.com",
+			},
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(start.Add(time.Hour)),
+		}
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		_, err := db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
+		require.NoError(t, err)
+
+		entries, err := db.GetCalendarEntries(ctx, user.Id)
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 1) {
+			assert.NotNil(t, entries[0].DeletedAt)
+		}
+	})
+}
@@ -0,0 +1,391 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// This file implements the subset of AIP-160 filtering used by the admin
+// listing API. Expressions are parsed into a WHERE clause over a fixed set of
+// columns; every value is passed as a query argument, never spliced into SQL.
+//
+//	start_time > "2026-11-01" AND contact_email = "x@y.com" AND deleted = true
+//	(title:"dentist" OR description:"dentist") AND NOT deleted = true
+
+var ErrInvalidFilter = errors.New("invalid filter")
+
+type fieldKind int
+
+const (
+	textField fieldKind = iota
+	timeField
+	idField
+	deletedField
+	boolField
+)
+
+type filterField struct {
+	column string
+	kind   fieldKind
+}
+
+var appointmentFilterFields = map[string]filterField{
+	"id":                  {"id", idField},
+	"user_id":             {"user_id", idField},
+	"title":               {"title", textField},
+	"description":         {"description", textField},
+	"contact_name":        {"contact_name", textField},
+	"contact_email":       {"contact_email", textField},
+	"start_time":          {"start_time", timeField},
+	"end_time":            {"end_time", timeField},
+	"date":                {"date", timeField},
+	"created_at":          {"created_at", timeField},
+	"updated_at":          {"updated_at", timeField},
+	"deleted_at":          {"deleted_at", timeField},
+	"deleted":             {"deleted_at", deletedField},
+	"cancelled_by":        {"cancelled_by", textField},
+	"cancelled_late":      {"cancelled_late", boolField},
+	"cancellation_reason": {"cancellation_reason", textField},
+}
+
+const (
+	maxFilterLength = 2000
+	maxFilterDepth  = 32
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokString
+	tokOp
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expr)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{tokLParen, "(", i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{tokRParen, ")", i})
+			i++
+		case r == '"':
+			start := i
+			var b strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				b.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("%w: unterminated string at position %d", ErrInvalidFilter, start)
+			}
+			i++
+			tokens = append(tokens, token{tokString, b.String(), start})
+		case strings.ContainsRune("=!<>:", r):
+			start := i
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' && r != '=' && r != ':' {
+				op += "="
+				i++
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("%w: unexpected '!' at position %d", ErrInvalidFilter, start)
+			}
+			i++
+			tokens = append(tokens, token{tokOp, op, start})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("()\"=!<>:", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{tokWord, string(runes[start:i]), start})
+		}
+	}
+
+	return append(tokens, token{tokEOF, "", len(runes)}), nil
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+	depth  int
+	fields map[string]filterField
+	args   []any
+}
+
+// parseFilter turns expr into a SQL boolean expression. Placeholders are
+// numbered after the len(args) arguments the caller already has.
+func parseFilter(expr string, fields map[string]filterField, args []any) (string, []any, error) {
+	if strings.TrimSpace(expr) == "" {
+		return "TRUE", args, nil
+	}
+	if len(expr) > maxFilterLength {
+		return "", nil, fmt.Errorf("%w: filter is longer than %d characters", ErrInvalidFilter, maxFilterLength)
+	}
+
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return "", nil, err
+	}
+
+	p := &filterParser{tokens: tokens, fields: fields, args: args}
+	sql, err := p.parseOr()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokEOF {
+		return "", nil, fmt.Errorf("%w: unexpected %q at position %d", ErrInvalidFilter, tok.value, tok.pos)
+	}
+
+	return sql, p.args, nil
+}
+
+func (p *filterParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *filterParser) parseOr() (string, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return "", err
+	}
+
+	for p.peek().kind == tokWord && p.peek().value == "OR" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return "", err
+		}
+		left = fmt.Sprintf("(%s OR %s)", left, right)
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (string, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		tok := p.peek()
+		if tok.kind == tokWord && tok.value == "AND" {
+			p.next()
+		} else if tok.kind == tokEOF || tok.kind == tokRParen || (tok.kind == tokWord && tok.value == "OR") {
+			return left, nil
+		}
+
+		// Juxtaposed terms are implicitly ANDed, as in AIP-160.
+		right, err := p.parseUnary()
+		if err != nil {
+			return "", err
+		}
+		left = fmt.Sprintf("(%s AND %s)", left, right)
+	}
+}
+
+func (p *filterParser) parseUnary() (string, error) {
+	tok := p.peek()
+	if tok.kind == tokWord && tok.value == "NOT" {
+		p.next()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(NOT %s)", inner), nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *filterParser) parsePrimary() (string, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokLParen:
+		p.depth++
+		if p.depth > maxFilterDepth {
+			return "", fmt.Errorf("%w: filter is nested too deeply", ErrInvalidFilter)
+		}
+		inner, err := p.parseOr()
+		if err != nil {
+			return "", err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return "", fmt.Errorf("%w: expected ')' at position %d", ErrInvalidFilter, closing.pos)
+		}
+		p.depth--
+		return inner, nil
+	case tokWord:
+		return p.parseComparison(tok)
+	case tokEOF:
+		return "", fmt.Errorf("%w: unexpected end of filter", ErrInvalidFilter)
+	default:
+		return "", fmt.Errorf("%w: unexpected %q at position %d", ErrInvalidFilter, tok.value, tok.pos)
+	}
+}
+
+func (p *filterParser) parseComparison(name token) (string, error) {
+	field, ok := p.fields[name.value]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown field %q", ErrInvalidFilter, name.value)
+	}
+
+	op := p.next()
+	if op.kind != tokOp {
+		return "", fmt.Errorf("%w: expected comparison after %q", ErrInvalidFilter, name.value)
+	}
+
+	value := p.next()
+	if value.kind != tokString && value.kind != tokWord {
+		return "", fmt.Errorf("%w: expected value after %q %s", ErrInvalidFilter, name.value, op.value)
+	}
+
+	switch field.kind {
+	case deletedField:
+		return boolComparison(name.value, op.value, value.value, "deleted_at IS NOT NULL", "deleted_at IS NULL")
+	case boolField:
+		return boolComparison(name.value, op.value, value.value, field.column, "NOT "+field.column)
+	case idField:
+		if op.value != "=" && op.value != "!=" {
+			return "", fmt.Errorf("%w: %q only supports = and !=", ErrInvalidFilter, name.value)
+		}
+		if uuid.Validate(value.value) != nil {
+			return "", fmt.Errorf("%w: %q is not a valid id", ErrInvalidFilter, value.value)
+		}
+		return p.bind(field.column, op.value, value.value), nil
+	case timeField:
+		if op.value == ":" {
+			return "", fmt.Errorf("%w: %q does not support ':'", ErrInvalidFilter, name.value)
+		}
+		t, err := parseFilterTime(value.value)
+		if err != nil {
+			return "", err
+		}
+		return p.bind(field.column, op.value, t), nil
+	default:
+		if op.value == ":" {
+			p.args = append(p.args, "%"+escapeLike(value.value)+"%")
+			return fmt.Sprintf("%s ILIKE $%d", field.column, len(p.args)), nil
+		}
+		return p.bind(field.column, op.value, value.value), nil
+	}
+}
+
+func (p *filterParser) bind(column, op string, value any) string {
+	p.args = append(p.args, value)
+	if op == "!=" {
+		op = "<>"
+	}
+	return fmt.Sprintf("%s %s $%d", column, op, len(p.args))
+}
+
+// boolComparison compares name with true or false, returning whenTrue or
+// whenFalse for the outcome.
+func boolComparison(name, op, value, whenTrue, whenFalse string) (string, error) {
+	var want bool
+	switch value {
+	case "true":
+		want = true
+	case "false":
+		want = false
+	default:
+		return "", fmt.Errorf("%w: %q must be compared with true or false", ErrInvalidFilter, name)
+	}
+
+	switch op {
+	case "=":
+	case "!=":
+		want = !want
+	default:
+		return "", fmt.Errorf("%w: %q only supports = and !=", ErrInvalidFilter, name)
+	}
+
+	if want {
+		return whenTrue, nil
+	}
+	return whenFalse, nil
+}
+
+func parseFilterTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q is not an RFC 3339 timestamp or YYYY-MM-DD date", ErrInvalidFilter, value)
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// parseOrderBy turns an AIP-132 order_by such as "start_time desc, title"
+// into an ORDER BY list. id is always appended so paging is stable.
+func parseOrderBy(orderBy string, fields map[string]filterField) (string, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return "start_time ASC, id ASC", nil
+	}
+
+	var terms []string
+	for _, part := range strings.Split(orderBy, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 || len(words) > 2 {
+			return "", fmt.Errorf("%w: invalid order_by term %q", ErrInvalidFilter, strings.TrimSpace(part))
+		}
+
+		field, ok := fields[words[0]]
+		if !ok || field.kind == deletedField || field.kind == boolField {
+			return "", fmt.Errorf("%w: cannot order by %q", ErrInvalidFilter, words[0])
+		}
+
+		direction := "ASC"
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				direction = "DESC"
+			default:
+				return "", fmt.Errorf("%w: invalid sort direction %q", ErrInvalidFilter, words[1])
+			}
+		}
+
+		terms = append(terms, field.column+" "+direction)
+	}
+
+	return strings.Join(append(terms, "id ASC"), ", "), nil
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	t.Run("binds every value as an argument", func(t *testing.T) {
+		sql, args, err := parseFilter(`start_time > "2026-11-01" AND contact_email = "x@y.com" AND deleted = true`, appointmentFilterFields, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "((start_time > $1 AND contact_email = $2) AND deleted_at IS NOT NULL)", sql)
+		assert.Equal(t, []any{time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), "x@y.com"}, args)
+	})
+
+	t.Run("supports OR, NOT, grouping and implicit AND", func(t *testing.T) {
+		sql, args, err := parseFilter(`(title:"dentist" OR description:"50%") NOT deleted = true`, appointmentFilterFields, []any{"existing"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "((title ILIKE $2 OR description ILIKE $3) AND (NOT deleted_at IS NOT NULL))", sql)
+		assert.Equal(t, []any{"existing", "%dentist%", `%50\%%`}, args)
+	})
+
+	t.Run("compares flags with true or false", func(t *testing.T) {
+		sql, args, err := parseFilter(`user_id = "6f1c2b5e-8d1a-4c3e-9b7a-2f4d6e8a0c1b" AND cancelled_late = true`, appointmentFilterFields, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "(user_id = $1 AND cancelled_late)", sql)
+		assert.Equal(t, []any{"6f1c2b5e-8d1a-4c3e-9b7a-2f4d6e8a0c1b"}, args)
+
+		sql, _, err = parseFilter(`cancelled_late != true`, appointmentFilterFields, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "NOT cancelled_late", sql)
+	})
+
+	t.Run("an empty filter matches everything", func(t *testing.T) {
+		sql, _, err := parseFilter("  ", appointmentFilterFields, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "TRUE", sql)
+	})
+
+	t.Run("rejects unsafe or malformed input", func(t *testing.T) {
+		for _, expr := range []string{
+			`title = "x"; DROP TABLE appointments`,
+			`password = "x"`,
+			`title = `,
+			`(title = "x"`,
+			`title = "unterminated`,
+			`start_time > "yesterday"`,
+			`user_id = "not-a-uuid"`,
+			`deleted > true`,
+			`cancelled_late = "yes"`,
+			`title = "x" AND`,
+		} {
+			_, _, err := parseFilter(expr, appointmentFilterFields, nil)
+			assert.ErrorIs(t, err, ErrInvalidFilter, expr)
+		}
+	})
+}
+
+func TestParseOrderBy(t *testing.T) {
+	t.Run("defaults to start time", func(t *testing.T) {
+		orderBy, err := parseOrderBy("", appointmentFilterFields)
+		require.NoError(t, err)
+		assert.Equal(t, "start_time ASC, id ASC", orderBy)
+	})
+
+	t.Run("parses multiple fields with directions", func(t *testing.T) {
+		orderBy, err := parseOrderBy("start_time desc, contact_email", appointmentFilterFields)
+		require.NoError(t, err)
+		assert.Equal(t, "start_time DESC, contact_email ASC, id ASC", orderBy)
+	})
+
+	t.Run("rejects unknown fields and directions", func(t *testing.T) {
+		for _, orderBy := range []string{"start_time; DROP TABLE users", "deleted", "title sideways"} {
+			_, err := parseOrderBy(orderBy, appointmentFilterFields)
+			assert.ErrorIs(t, err, ErrInvalidFilter, orderBy)
+		}
+	})
+}
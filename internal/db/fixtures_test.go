@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func createTestDB(t *testing.T) *Database {
+	ctx := context.Background()
+
+	container, err := postgres.Run(
+		ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("testdb"),
+		postgres.WithUsername("user"),
+		postgres.WithPassword("password"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(30*time.Second),
+		),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = container.Terminate(context.Background())
+	})
+
+	connStr, err := container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	absPath, err := filepath.Abs("../migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := migrate.New("file://"+absPath, connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		t.Fatal(err)
+	}
+
+	db, err := NewDatabase(ctx, connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+// newTestUser creates a user called name, with an email made from the name.
+func newTestUser(t *testing.T, db *Database, name string) *pb.User {
+	t.Helper()
+
+	user, err := db.CreateUser(context.Background(), &pb.User{
+		Id:    uuid.NewString(),
+		Name:  name,
+		Email: strings.ToLower(name) + "@example.com",
+	})
+	require.NoError(t, err)
+
+	return user
+}
+
+// newTestAppointment returns an unsaved appointment of user's from start
+// for length, with the user as its contact.
+func newTestAppointment(user *pb.User, start time.Time, length time.Duration) *pb.Appointment {
+	return &pb.Appointment{
+		Id:                 uuid.NewString(),
+		UserId:             user.Id,
+		Title:              "Test title",
+		Description:        "Test description",
+		Date:               timestamppb.New(start),
+		ContactInformation: &pb.ContactInformation{Name: user.Name, Email: user.Email},
+		StartTime:          timestamppb.New(start),
+		EndTime:            timestamppb.New(start.Add(length)),
+	}
+}
@@ -0,0 +1,253 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrHoldNotFound = errors.New("slot hold not found")
+	ErrHoldExpired  = errors.New("slot hold has expired")
+)
+
+// A hold is a placeholder row in appointments with hold_expires_at set, so
+// no_overlapping_active_appointments keeps its slot free just as it does for
+// a booking. Holds are left out wherever appointments are read. Confirming a
+// hold replaces it with the appointment, under the same id and in the same
+// transaction, so the slot is never free in between.
+//
+// An expired hold still takes part in the constraint until it is released,
+// either by the sweeper or by a booking that wants its slot.
+
+const slotHoldColumns = `id, user_id, contact_name, contact_email, start_time, end_time, hold_expires_at, created_at`
+
+func scanSlotHold(row pgx.Row) (*pb.SlotHold, error) {
+	var h pb.SlotHold
+	var c pb.ContactInformation
+	var start, end, expiresAt, createdAt time.Time
+
+	if err := row.Scan(&h.Id, &h.UserId, &c.Name, &c.Email, &start, &end, &expiresAt, &createdAt); err != nil {
+		return nil, err
+	}
+
+	h.ContactInformation = &c
+	h.StartTime = timestamppb.New(start)
+	h.EndTime = timestamppb.New(end)
+	h.ExpiresAt = timestamppb.New(expiresAt)
+	h.CreatedAt = timestamppb.New(createdAt)
+
+	return &h, nil
+}
+
+// releaseExpiredHolds deletes the expired holds in the way of appt, which
+// is judged with the schedule's buffers if it has none of its own. Holds
+// locked by another transaction are left to it.
+func releaseExpiredHolds(ctx context.Context, q querier, appt *pb.Appointment) error {
+	query := `
+	DELETE FROM appointments
+	WHERE id IN (
+		SELECT id FROM appointments
+		WHERE hold_expires_at <= NOW()
+			AND appointment_busy_range(start_time, end_time, buffer_before_seconds, buffer_after_seconds)
+			&& appointment_busy_range($1, $2,
+				COALESCE($3, (SELECT buffer_before_seconds FROM schedule), 0),
+				COALESCE($4, (SELECT buffer_after_seconds FROM schedule), 0))
+		FOR UPDATE SKIP LOCKED
+	)`
+
+	_, err := q.Exec(ctx, query, appt.StartTime.AsTime(), appt.EndTime.AsTime(),
+		optionalSeconds(appt.BufferBefore), optionalSeconds(appt.BufferAfter))
+	return err
+}
+
+// HoldSlot holds the slot of appt, which names who it is for and when, for
+// the schedule's hold TTL. The slot must be one appt's user could book at
+// now. When replaces is set that hold of the same user is released, or
+// ErrHoldNotFound returned if they have no such hold. Holds are only ever
+// released by id: users are found by email, so anyone could otherwise
+// release someone else's hold by asking for one in their name.
+func (db *Database) HoldSlot(ctx context.Context, appt *pb.Appointment, replaces string, now time.Time) (*pb.SlotHold, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := checkBookingPolicy(ctx, tx, appt, now); err != nil {
+		return nil, err
+	}
+
+	var released []timeRange
+	if replaces != "" {
+		release := `
+		DELETE FROM appointments
+		WHERE id = $1 AND user_id = $2 AND hold_expires_at IS NOT NULL
+		RETURNING start_time, end_time`
+
+		rows, err := tx.Query(ctx, release, replaces, appt.UserId)
+		if err != nil {
+			return nil, err
+		}
+		released, err = collectTimeRanges(rows)
+		if err != nil {
+			return nil, err
+		}
+		if len(released) == 0 {
+			return nil, ErrHoldNotFound
+		}
+	}
+
+	if err := releaseExpiredHolds(ctx, tx, appt); err != nil {
+		return nil, err
+	}
+
+	query := `
+	INSERT INTO appointments (id, user_id, contact_name, contact_email, start_time, end_time, date, time_zone,
+		buffer_before_seconds, buffer_after_seconds, hold_expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $5,
+		COALESCE(NULLIF($7, ''), (SELECT time_zone FROM users WHERE id = $2), 'UTC'),
+		(SELECT buffer_before_seconds FROM schedule),
+		(SELECT buffer_after_seconds FROM schedule),
+		NOW() + make_interval(secs => (SELECT hold_seconds FROM schedule)))
+	RETURNING ` + slotHoldColumns + `, buffer_before_seconds, buffer_after_seconds`
+
+	var bufferBefore, bufferAfter int64
+	row := tx.QueryRow(ctx, query, appt.Id, appt.UserId, appt.ContactInformation.Name, appt.ContactInformation.Email,
+		appt.StartTime.AsTime(), appt.EndTime.AsTime(), appt.TimeZone)
+	hold, err := scanSlotHold(extraColumnsRow{Row: row, extra: []any{&bufferBefore, &bufferAfter}})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23P01" {
+			return nil, ErrAppointmentConflict
+		}
+		return nil, err
+	}
+
+	appt.BufferBefore = durationpb.New(time.Duration(bufferBefore) * time.Second)
+	appt.BufferAfter = durationpb.New(time.Duration(bufferAfter) * time.Second)
+	if err := checkSlotOffers(ctx, tx, appt); err != nil {
+		return nil, err
+	}
+
+	for _, r := range released {
+		if err := offerFreedSlot(ctx, tx, r.start, r.end); err != nil {
+			return nil, err
+		}
+	}
+
+	return hold, tx.Commit(ctx)
+}
+
+// ConfirmHold books the slot of the hold id as appt, which carries what to
+// book there, as BookAppointment would at now. The appointment takes the
+// hold's id, user and times. A hold that is not userId's is reported as
+// ErrHoldNotFound.
+func (db *Database) ConfirmHold(ctx context.Context, id, userId string, appt *pb.Appointment, now time.Time) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	lock := `
+	SELECT ` + slotHoldColumns + `, time_zone
+	FROM appointments
+	WHERE id = $1 AND user_id = $2 AND hold_expires_at IS NOT NULL
+	FOR UPDATE`
+
+	var timeZone string
+	hold, err := scanSlotHold(extraColumnsRow{Row: tx.QueryRow(ctx, lock, id, userId), extra: []any{&timeZone}})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrHoldNotFound
+		}
+		return err
+	}
+	if !hold.ExpiresAt.AsTime().After(now) {
+		return ErrHoldExpired
+	}
+
+	// The slot stays taken: nobody else sees it free until this commits, and
+	// by then the appointment has it.
+	if _, err := tx.Exec(ctx, `DELETE FROM appointments WHERE id = $1`, id); err != nil {
+		return err
+	}
+
+	appt.Id = hold.Id
+	appt.UserId = hold.UserId
+	appt.ContactInformation = hold.ContactInformation
+	appt.StartTime = hold.StartTime
+	appt.EndTime = hold.EndTime
+	appt.Date = hold.StartTime
+	appt.TimeZone = timeZone
+	if err := bookAppointment(ctx, tx, appt, "", "", now, db.ReminderOffsets); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ReleaseExpiredHolds deletes up to limit holds past their expiry and offers
+// their slots to the waitlist, returning how many were released.
+func (db *Database) ReleaseExpiredHolds(ctx context.Context, limit int) (int, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+	DELETE FROM appointments
+	WHERE id IN (
+		SELECT id FROM appointments
+		WHERE hold_expires_at <= NOW()
+		ORDER BY hold_expires_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING start_time, end_time`
+
+	rows, err := tx.Query(ctx, query, limit)
+	if err != nil {
+		return 0, err
+	}
+	released, err := collectTimeRanges(rows)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, r := range released {
+		if err := offerFreedSlot(ctx, tx, r.start, r.end); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(released), tx.Commit(ctx)
+}
+
+type timeRange struct {
+	start, end time.Time
+}
+
+func collectTimeRanges(rows pgx.Rows) ([]timeRange, error) {
+	defer rows.Close()
+
+	var ranges []timeRange
+	for rows.Next() {
+		var r timeRange
+		if err := rows.Scan(&r.start, &r.end); err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		ranges = append(ranges, r)
+	}
+
+	return ranges, rows.Err()
+}
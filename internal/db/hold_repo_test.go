@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSlotHolds(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	newUser := func(name string) *pb.User {
+		user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: name, Email: strings.ToLower(name) + "@example.com"})
+		require.NoError(t, err)
+		return user
+	}
+	ann, bob := newUser("Ann"), newUser("Bob")
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	newAppointment := func(user *pb.User, start time.Time) *pb.Appointment {
+		return &pb.Appointment{
+			Id:                 uuid.NewString(),
+			Title:              "Consultation",
+			UserId:             user.Id,
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: user.Name, Email: user.Email},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+		}
+	}
+	expire := func(hold *pb.SlotHold) {
+		_, err := db.Pool.Exec(ctx, `UPDATE appointments SET hold_expires_at = NOW() - interval '1 second' WHERE id = $1`, hold.Id)
+		require.NoError(t, err)
+	}
+
+	hold, err := db.HoldSlot(ctx, newAppointment(ann, start), "", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, ann.Id, hold.UserId)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), hold.ExpiresAt.AsTime(), time.Minute)
+
+	t.Run("a held slot cannot be booked", func(t *testing.T) {
+		err := db.BookAppointment(ctx, newAppointment(bob, start.Add(30*time.Minute)), time.Now())
+		assert.ErrorIs(t, err, ErrAppointmentConflict)
+	})
+
+	t.Run("holds are not appointments", func(t *testing.T) {
+		_, err := db.GetAppointment(ctx, hold.Id)
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+
+		appts, _, err := db.GetAppointments(ctx, ann.Id, AppointmentFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, appts)
+	})
+
+	t.Run("only the user it is held for can confirm a hold", func(t *testing.T) {
+		err := db.ConfirmHold(ctx, hold.Id, bob.Id, &pb.Appointment{Title: "Taken"}, time.Now())
+		assert.ErrorIs(t, err, ErrHoldNotFound)
+	})
+
+	t.Run("confirming a hold books its slot under the same id", func(t *testing.T) {
+		appt := &pb.Appointment{Title: "Consultation"}
+		require.NoError(t, db.ConfirmHold(ctx, hold.Id, ann.Id, appt, time.Now()))
+		assert.Equal(t, hold.Id, appt.Id)
+		assert.True(t, appt.StartTime.AsTime().Equal(start))
+
+		stored, err := db.GetAppointment(ctx, hold.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "Consultation", stored.Title)
+		assert.Equal(t, ann.Id, stored.UserId)
+
+		err = db.ConfirmHold(ctx, hold.Id, ann.Id, &pb.Appointment{}, time.Now())
+		assert.ErrorIs(t, err, ErrHoldNotFound)
+	})
+
+	t.Run("an expired hold gives way to a booking", func(t *testing.T) {
+		expired, err := db.HoldSlot(ctx, newAppointment(ann, start.Add(2*time.Hour)), "", time.Now())
+		require.NoError(t, err)
+		expire(expired)
+
+		err = db.ConfirmHold(ctx, expired.Id, ann.Id, &pb.Appointment{}, time.Now())
+		assert.ErrorIs(t, err, ErrHoldExpired)
+
+		require.NoError(t, db.BookAppointment(ctx, newAppointment(bob, start.Add(2*time.Hour)), time.Now()))
+	})
+
+	t.Run("holding another slot in place of the first releases it", func(t *testing.T) {
+		first, err := db.HoldSlot(ctx, newAppointment(ann, start.Add(4*time.Hour)), "", time.Now())
+		require.NoError(t, err)
+
+		_, err = db.HoldSlot(ctx, newAppointment(bob, start.Add(6*time.Hour)), first.Id, time.Now())
+		assert.ErrorIs(t, err, ErrHoldNotFound)
+
+		other, err := db.HoldSlot(ctx, newAppointment(ann, start.Add(8*time.Hour)), "", time.Now())
+		require.NoError(t, err)
+
+		second, err := db.HoldSlot(ctx, newAppointment(ann, start.Add(6*time.Hour)), first.Id, time.Now())
+		require.NoError(t, err)
+
+		require.NoError(t, db.BookAppointment(ctx, newAppointment(bob, start.Add(4*time.Hour)), time.Now()))
+
+		err = db.ConfirmHold(ctx, first.Id, ann.Id, &pb.Appointment{}, time.Now())
+		assert.ErrorIs(t, err, ErrHoldNotFound)
+
+		t.Run("and the sweeper releases expired holds", func(t *testing.T) {
+			expire(second)
+			expire(other)
+
+			released, err := db.ReleaseExpiredHolds(ctx, 10)
+			require.NoError(t, err)
+			assert.Equal(t, 2, released)
+
+			err = db.ConfirmHold(ctx, second.Id, ann.Id, &pb.Appointment{}, time.Now())
+			assert.ErrorIs(t, err, ErrHoldNotFound)
+		})
+	})
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ImportCandidate is a calendar event already mapped onto an appointment.
+type ImportCandidate struct {
+	UID         string
+	Appointment *pb.Appointment
+}
+
+// ImportAppointments inserts candidates in one transaction, giving each its
+// own savepoint so an overlap on one event doesn't abort the rest. Events
+// whose UID the user already has, or which are one of our own exported
+// appointments, are skipped as duplicates. With dryRun the transaction is
+// rolled back, so the results describe what an import would do.
+func (db *Database) ImportAppointments(ctx context.Context, candidates []*ImportCandidate, dryRun bool) ([]*pb.ImportEventResult, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	duplicateQuery := `
+	SELECT EXISTS (
+		SELECT 1 FROM appointments
+		WHERE user_id = $1 AND (ical_uid = $2 OR id::text || '@appointment-scheduler' = $2)
+	)`
+
+	results := make([]*pb.ImportEventResult, 0, len(candidates))
+	for _, c := range candidates {
+		result := &pb.ImportEventResult{
+			Uid:     c.UID,
+			Summary: c.Appointment.Title,
+		}
+		results = append(results, result)
+
+		var exists bool
+		if err := tx.QueryRow(ctx, duplicateQuery, c.Appointment.UserId, c.UID).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if exists {
+			result.Status = pb.ImportEventStatus_IMPORT_EVENT_STATUS_DUPLICATE
+			result.Message = "an appointment with this UID already exists"
+			continue
+		}
+
+		savepoint, err := tx.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = insertAppointment(ctx, savepoint, c.Appointment, c.UID, "", db.ReminderOffsets)
+		if err != nil {
+			if rbErr := savepoint.Rollback(ctx); rbErr != nil {
+				return nil, rbErr
+			}
+
+			var pgErr *pgconn.PgError
+			switch {
+			case errors.Is(err, ErrAppointmentConflict):
+				result.Status = pb.ImportEventStatus_IMPORT_EVENT_STATUS_CONFLICT
+				result.Message = err.Error()
+			case errors.As(err, &pgErr) && pgErr.Code == "23505":
+				result.Status = pb.ImportEventStatus_IMPORT_EVENT_STATUS_DUPLICATE
+				result.Message = "an appointment with this UID already exists"
+			default:
+				return nil, fmt.Errorf("failed to import event %q: %w", c.UID, err)
+			}
+			continue
+		}
+
+		if err := savepoint.Commit(ctx); err != nil {
+			return nil, err
+		}
+
+		result.Status = pb.ImportEventStatus_IMPORT_EVENT_STATUS_IMPORTED
+		result.AppointmentId = c.Appointment.Id
+	}
+
+	if dryRun {
+		return results, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
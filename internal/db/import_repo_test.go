@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImportAppointments(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{
+		Id:    uuid.NewString(),
+		Name:  "Test user",
+		Email: "test@user.com",
+	})
+	require.NoError(t, err)
+
+	start := time.Now().Add(24 * time.Hour)
+	candidate := func(uid string, offset time.Duration) *ImportCandidate {
+		return &ImportCandidate{
+			UID: uid,
+			Appointment: &pb.Appointment{
+				Id:          uuid.NewString(),
+				UserId:      user.Id,
+				Title:       "Imported",
+				Description: "",
+				Date:        timestamppb.New(start),
+				ContactInformation: &pb.ContactInformation{
+					Name:  user.Name,
+					Email: user.Email,
+				},
+				StartTime: timestamppb.New(start.Add(offset)),
+				EndTime:   timestamppb.New(start.Add(offset + time.Hour)),
+			},
+		}
+	}
+
+	t.Run("dry run reports results without writing", func(t *testing.T) {
+		results, err := db.ImportAppointments(ctx, []*ImportCandidate{candidate("a", 0)}, true)
+		assert.NoError(t, err)
+		assert.Equal(t, pb.ImportEventStatus_IMPORT_EVENT_STATUS_IMPORTED, results[0].Status)
+
+		appts, _, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{})
+		assert.NoError(t, err)
+		assert.Empty(t, appts)
+	})
+
+	t.Run("imports, skips duplicates and rejects overlaps per event", func(t *testing.T) {
+		_, err := db.ImportAppointments(ctx, []*ImportCandidate{candidate("a", 0)}, false)
+		require.NoError(t, err)
+
+		results, err := db.ImportAppointments(ctx, []*ImportCandidate{
+			candidate("a", 5*time.Hour),
+			candidate("b", 30*time.Minute),
+			candidate("c", 2*time.Hour),
+		}, false)
+		assert.NoError(t, err)
+
+		assert.Equal(t, pb.ImportEventStatus_IMPORT_EVENT_STATUS_DUPLICATE, results[0].Status)
+		assert.Equal(t, pb.ImportEventStatus_IMPORT_EVENT_STATUS_CONFLICT, results[1].Status)
+		assert.Equal(t, pb.ImportEventStatus_IMPORT_EVENT_STATUS_IMPORTED, results[2].Status)
+
+		appts, _, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{})
+		assert.NoError(t, err)
+		assert.Len(t, appts, 2)
+	})
+}
@@ -0,0 +1,446 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrOutboxSinkNotFound = errors.New("outbox sink not found")
+
+var ErrOutboxEventNotFound = errors.New("outbox event not found")
+
+var ErrOutboxEventNotNext = errors.New("outbox event is not the next event pending for the sink")
+
+var ErrOutboxLeaseLost = errors.New("outbox sink lease was lost")
+
+const (
+	EventAppointmentCreated     = "appointment.created"
+	EventAppointmentUpdated     = "appointment.updated"
+	EventAppointmentCancelled   = "appointment.cancelled"
+	EventAppointmentRestored    = "appointment.restored"
+	EventAppointmentRescheduled = "appointment.rescheduled"
+	EventUserCreated            = "user.created"
+	EventUserUpdated            = "user.updated"
+	EventUserErased             = "user.erased"
+	EventWaitlistOffered        = "waitlist.offered"
+)
+
+// The outbox records a domain event in the same transaction as the change it
+// describes, so an event exists if and only if its change was committed.
+// Relays then publish the events to each sink in order.
+//
+// Events are ordered by the id of the transaction that wrote them and then by
+// seq. A relay only reads events whose transaction is older than every
+// transaction still running, so a transaction that commits late can never
+// slip an event in behind a sink's position. The price is that one long
+// running transaction holds back every sink until it ends.
+//
+// Each sink keeps its own position in outbox_cursors, so a sink that keeps
+// failing only holds back its own events. Relays lease a sink before
+// publishing to it, so only one replica publishes to a sink at a time.
+
+const snapshotXmin = `pg_snapshot_xmin(pg_current_snapshot())::text::bigint`
+
+// appendOutboxEvent records eventType for the aggregate with msg, the
+// aggregate after the change, as its payload. q must be the transaction that
+// makes the change.
+func appendOutboxEvent(ctx context.Context, q querier, aggregateType, aggregateId, eventType string, msg proto.Message) error {
+	payload, err := protojson.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO outbox_events (id, aggregate_type, aggregate_id, event_type, payload)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	if _, err := q.Exec(ctx, query, uuid.NewString(), aggregateType, aggregateId, eventType, payload); err != nil {
+		return fmt.Errorf("failed to record %s event: %w", eventType, err)
+	}
+
+	return nil
+}
+
+func appendAppointmentEvent(ctx context.Context, q querier, eventType string, appt *pb.Appointment) error {
+	return appendOutboxEvent(ctx, q, "appointment", appt.Id, eventType, appt)
+}
+
+// OutboxEvent is a recorded domain event. Payload is the JSON encoding of the
+// aggregate after the change.
+type OutboxEvent struct {
+	Id            string
+	Sequence      int64
+	AggregateType string
+	AggregateId   string
+	Type          string
+	Payload       []byte
+	CreatedAt     time.Time
+
+	txid int64
+}
+
+const outboxEventColumns = `id, seq, aggregate_type, aggregate_id, event_type, payload, created_at, txid`
+
+func scanOutboxEvent(row pgx.Row) (*OutboxEvent, error) {
+	var e OutboxEvent
+	err := row.Scan(&e.Id, &e.Sequence, &e.AggregateType, &e.AggregateId, &e.Type, &e.Payload, &e.CreatedAt, &e.txid)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func collectOutboxEvents(rows pgx.Rows) ([]*OutboxEvent, error) {
+	defer rows.Close()
+
+	var result []*OutboxEvent
+	for rows.Next() {
+		e, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		result = append(result, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// OutboxBatch is a run of events that a relay holds the lease to publish to a
+// sink.
+type OutboxBatch struct {
+	Sink  string
+	Owner string
+	// Attempts is how many times in a row publishing to the sink has failed.
+	Attempts int
+	Events   []*OutboxEvent
+}
+
+// ClaimOutboxEvents leases sink to owner for lease and returns up to limit of
+// the events pending for it. It returns nil when another relay holds the
+// sink or the sink is waiting to retry a failure. A sink seen for the first
+// time starts at the end of the outbox rather than receiving every past
+// event.
+func (db *Database) ClaimOutboxEvents(ctx context.Context, sink, owner string, limit int, lease time.Duration) (*OutboxBatch, error) {
+	register := `
+	WITH head AS (
+		SELECT txid, seq FROM outbox_events
+		WHERE txid < ` + snapshotXmin + `
+		ORDER BY txid DESC, seq DESC
+		LIMIT 1
+	)
+	INSERT INTO outbox_cursors (sink, position_txid, position_seq)
+	SELECT $1, COALESCE((SELECT txid FROM head), 0), COALESCE((SELECT seq FROM head), 0)
+	ON CONFLICT (sink) DO NOTHING`
+
+	if _, err := db.Pool.Exec(ctx, register, sink); err != nil {
+		return nil, fmt.Errorf("failed to register outbox sink: %w", err)
+	}
+
+	acquire := `
+	UPDATE outbox_cursors
+	SET locked_by = $2, locked_until = NOW() + make_interval(secs => $3)
+	WHERE sink = $1 AND next_attempt_at <= NOW()
+		AND (locked_by IS NULL OR locked_by = $2 OR locked_until < NOW())
+	RETURNING position_txid, position_seq, attempts`
+
+	var txid, seq int64
+	batch := &OutboxBatch{Sink: sink, Owner: owner}
+
+	err := db.Pool.QueryRow(ctx, acquire, sink, owner, lease.Seconds()).Scan(&txid, &seq, &batch.Attempts)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pending := `
+	SELECT ` + outboxEventColumns + `
+	FROM outbox_events
+	WHERE (txid, seq) > ($1, $2) AND txid < ` + snapshotXmin + `
+	ORDER BY txid, seq
+	LIMIT $3`
+
+	rows, err := db.Pool.Query(ctx, pending, txid, seq, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	batch.Events, err = collectOutboxEvents(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// AckOutboxEvent moves the batch's sink past event, which the sink accepted.
+func (db *Database) AckOutboxEvent(ctx context.Context, batch *OutboxBatch, event *OutboxEvent) error {
+	query := `
+	UPDATE outbox_cursors
+	SET position_txid = $3, position_seq = $4, attempts = 0, last_error = NULL, updated_at = NOW()
+	WHERE sink = $1 AND locked_by = $2`
+
+	commandTag, err := db.Pool.Exec(ctx, query, batch.Sink, batch.Owner, event.txid, event.Sequence)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return ErrOutboxLeaseLost
+	}
+
+	return nil
+}
+
+// NackOutboxEvent records that the sink rejected the next event and releases
+// the sink until retryAt.
+func (db *Database) NackOutboxEvent(ctx context.Context, batch *OutboxBatch, lastError string, retryAt time.Time) error {
+	query := `
+	UPDATE outbox_cursors
+	SET attempts = attempts + 1, last_error = $3, next_attempt_at = $4,
+		locked_by = NULL, locked_until = NULL, updated_at = NOW()
+	WHERE sink = $1 AND locked_by = $2`
+
+	commandTag, err := db.Pool.Exec(ctx, query, batch.Sink, batch.Owner, lastError, retryAt)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return ErrOutboxLeaseLost
+	}
+
+	return nil
+}
+
+const outboxSinkColumns = `c.sink, c.position_seq, c.attempts, COALESCE(c.last_error, ''), c.next_attempt_at, c.updated_at,
+		(SELECT COUNT(*) FROM outbox_events e WHERE (e.txid, e.seq) > (c.position_txid, c.position_seq))`
+
+func scanOutboxSink(row pgx.Row) (*pb.OutboxSink, error) {
+	var s pb.OutboxSink
+	var attempts int
+	var nextAttemptAt, updatedAt time.Time
+
+	err := row.Scan(&s.Name, &s.Position, &attempts, &s.LastError, &nextAttemptAt, &updatedAt, &s.Pending)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Attempts = int32(attempts)
+	s.UpdatedAt = timestamppb.New(updatedAt)
+	if attempts > 0 {
+		s.NextAttemptAt = timestamppb.New(nextAttemptAt)
+	}
+
+	return &s, nil
+}
+
+func (db *Database) ListOutboxSinks(ctx context.Context) ([]*pb.OutboxSink, error) {
+	query := `SELECT ` + outboxSinkColumns + ` FROM outbox_cursors c ORDER BY c.sink`
+
+	rows, err := db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*pb.OutboxSink
+	for rows.Next() {
+		s, err := scanOutboxSink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		result = append(result, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func getOutboxSink(ctx context.Context, q querier, sink string) (*pb.OutboxSink, error) {
+	query := `SELECT ` + outboxSinkColumns + ` FROM outbox_cursors c WHERE c.sink = $1`
+
+	s, err := scanOutboxSink(q.QueryRow(ctx, query, sink))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrOutboxSinkNotFound
+	}
+
+	return s, err
+}
+
+// ListOutboxEvents lists events in delivery order. When sink is set only the
+// events still pending for it are listed; when aggregateId is set only the
+// events about that appointment or user are.
+func (db *Database) ListOutboxEvents(ctx context.Context, sink, aggregateId string, pageSize int, pageToken string) ([]*OutboxEvent, string, error) {
+	scope := pageTokenScope(sink, aggregateId)
+	offset := 0
+	if pageToken != "" {
+		var err error
+		offset, err = decodeOffsetToken(pageToken, scope)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	if sink != "" {
+		if _, err := getOutboxSink(ctx, db.Pool, sink); err != nil {
+			return nil, "", err
+		}
+	}
+
+	query := `
+	SELECT ` + outboxEventColumns + `
+	FROM outbox_events
+	WHERE ($1 = '' OR (txid, seq) > (
+			SELECT position_txid, position_seq FROM outbox_cursors WHERE sink = $1))
+		AND ($2 = '' OR aggregate_id::text = $2)
+	ORDER BY txid, seq
+	LIMIT $3 OFFSET $4`
+
+	rows, err := db.Pool.Query(ctx, query, sink, aggregateId, pageSize+1, offset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	result, err := collectOutboxEvents(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(result) > pageSize {
+		result = result[:pageSize]
+		nextPageToken = encodeOffsetToken(offset+pageSize, scope)
+	}
+
+	return result, nextPageToken, nil
+}
+
+// ReplayOutboxEvents makes sink retry straight away, first rewinding it to
+// just before fromEventId when that is set. Rewinding to an event the sink
+// has not reached yet leaves its position alone. The sink's lease is taken
+// away so a relay halfway through a batch stops instead of moving it on.
+func (db *Database) ReplayOutboxEvents(ctx context.Context, sink, fromEventId string) (*pb.OutboxSink, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var txid, seq int64
+	err = tx.QueryRow(ctx, `SELECT position_txid, position_seq FROM outbox_cursors WHERE sink = $1 FOR UPDATE`, sink).Scan(&txid, &seq)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrOutboxSinkNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if fromEventId != "" {
+		var eventTxid, eventSeq int64
+		err := tx.QueryRow(ctx, `SELECT txid, seq FROM outbox_events WHERE id::text = $1`, fromEventId).Scan(&eventTxid, &eventSeq)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOutboxEventNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Just before the event is the same transaction with a lower seq.
+		if eventTxid < txid || (eventTxid == txid && eventSeq <= seq) {
+			txid, seq = eventTxid, eventSeq-1
+		}
+	}
+
+	query := `
+	UPDATE outbox_cursors
+	SET position_txid = $2, position_seq = $3, attempts = 0, last_error = NULL, next_attempt_at = NOW(),
+		locked_by = NULL, locked_until = NULL, updated_at = NOW()
+	WHERE sink = $1`
+
+	if _, err := tx.Exec(ctx, query, sink, txid, seq); err != nil {
+		return nil, err
+	}
+
+	s, err := getOutboxSink(ctx, tx, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, tx.Commit(ctx)
+}
+
+// SkipOutboxEvent moves sink past eventId, which must be the next event
+// pending for it, and lets it continue with the event after.
+func (db *Database) SkipOutboxEvent(ctx context.Context, sink, eventId string) (*pb.OutboxSink, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var txid, seq int64
+	err = tx.QueryRow(ctx, `SELECT position_txid, position_seq FROM outbox_cursors WHERE sink = $1 FOR UPDATE`, sink).Scan(&txid, &seq)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrOutboxSinkNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	next := `
+	SELECT ` + outboxEventColumns + `
+	FROM outbox_events
+	WHERE (txid, seq) > ($1, $2) AND txid < ` + snapshotXmin + `
+	ORDER BY txid, seq
+	LIMIT 1`
+
+	event, err := scanOutboxEvent(tx.QueryRow(ctx, next, txid, seq))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	if event == nil || event.Id != eventId {
+		return nil, ErrOutboxEventNotNext
+	}
+
+	query := `
+	UPDATE outbox_cursors
+	SET position_txid = $2, position_seq = $3, attempts = 0, last_error = NULL, next_attempt_at = NOW(),
+		locked_by = NULL, locked_until = NULL, updated_at = NOW()
+	WHERE sink = $1`
+
+	if _, err := tx.Exec(ctx, query, sink, event.txid, event.Sequence); err != nil {
+		return nil, err
+	}
+
+	s, err := getOutboxSink(ctx, tx, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, tx.Commit(ctx)
+}
@@ -0,0 +1,139 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestOutbox(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	claim := func(sink, owner string) *OutboxBatch {
+		batch, err := db.ClaimOutboxEvents(ctx, sink, owner, 10, time.Minute)
+		require.NoError(t, err)
+		return batch
+	}
+
+	eventTypes := func(batch *OutboxBatch) []string {
+		var types []string
+		for _, e := range batch.Events {
+			types = append(types, e.Type)
+		}
+		return types
+	}
+
+	// A new sink starts at the end of the outbox.
+	_, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Before", Email: "before@user.com"})
+	require.NoError(t, err)
+	assert.Empty(t, claim("test", "relay-1").Events)
+
+	user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Test user", Email: "test@user.com"})
+	require.NoError(t, err)
+
+	start := time.Now().Add(24 * time.Hour)
+	appt := &pb.Appointment{
+		Id:                 uuid.NewString(),
+		UserId:             user.Id,
+		Title:              "Test title",
+		Date:               timestamppb.New(start),
+		ContactInformation: &pb.ContactInformation{Name: "Test", Email: "test@example.com"},
+		StartTime:          timestamppb.New(start),
+		EndTime:            timestamppb.New(start.Add(time.Hour)),
+	}
+
+	t.Run("mutations record events in the same transaction", func(t *testing.T) {
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+
+		overlapping := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Date:               appt.Date,
+			ContactInformation: appt.ContactInformation,
+			StartTime:          appt.StartTime,
+			EndTime:            appt.EndTime,
+		}
+		assert.ErrorIs(t, db.CreateAppointment(ctx, overlapping), ErrAppointmentConflict)
+
+		_, err := db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
+		require.NoError(t, err)
+
+		batch := claim("test", "relay-1")
+		require.NotNil(t, batch)
+		assert.Equal(t, []string{EventUserCreated, EventAppointmentCreated, EventAppointmentCancelled}, eventTypes(batch))
+		assert.Equal(t, appt.Id, batch.Events[2].AggregateId)
+		assert.Contains(t, string(batch.Events[2].Payload), "deletedAt")
+	})
+
+	t.Run("a leased sink is not handed to another relay", func(t *testing.T) {
+		assert.Nil(t, claim("test", "relay-2"))
+	})
+
+	t.Run("acks advance the sink and nacks back it off", func(t *testing.T) {
+		batch := claim("test", "relay-1")
+		require.Len(t, batch.Events, 3)
+
+		require.NoError(t, db.AckOutboxEvent(ctx, batch, batch.Events[0]))
+		require.NoError(t, db.NackOutboxEvent(ctx, batch, "boom", time.Now().Add(time.Hour)))
+
+		// The nack released the lease, but the sink waits for its retry.
+		assert.Nil(t, claim("test", "relay-2"))
+
+		sinks, err := db.ListOutboxSinks(ctx)
+		require.NoError(t, err)
+		require.Len(t, sinks, 1)
+		assert.Equal(t, batch.Events[0].Sequence, sinks[0].Position)
+		assert.Equal(t, int64(2), sinks[0].Pending)
+		assert.Equal(t, int32(1), sinks[0].Attempts)
+		assert.Equal(t, "boom", sinks[0].LastError)
+
+		pending, _, err := db.ListOutboxEvents(ctx, "test", "", 0, "")
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		assert.Equal(t, EventAppointmentCreated, pending[0].Type)
+
+		byAggregate, _, err := db.ListOutboxEvents(ctx, "", appt.Id, 0, "")
+		require.NoError(t, err)
+		assert.Len(t, byAggregate, 2)
+	})
+
+	t.Run("skip moves past only the next pending event", func(t *testing.T) {
+		pending, _, err := db.ListOutboxEvents(ctx, "test", "", 0, "")
+		require.NoError(t, err)
+
+		_, err = db.SkipOutboxEvent(ctx, "test", pending[1].Id)
+		assert.ErrorIs(t, err, ErrOutboxEventNotNext)
+
+		sink, err := db.SkipOutboxEvent(ctx, "test", pending[0].Id)
+		require.NoError(t, err)
+		assert.Equal(t, pending[0].Sequence, sink.Position)
+		assert.Zero(t, sink.Attempts)
+
+		batch := claim("test", "relay-2")
+		require.NotNil(t, batch)
+		assert.Equal(t, []string{EventAppointmentCancelled}, eventTypes(batch))
+	})
+
+	t.Run("replay rewinds the sink and takes its lease", func(t *testing.T) {
+		events, _, err := db.ListOutboxEvents(ctx, "", user.Id, 0, "")
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		_, err = db.ReplayOutboxEvents(ctx, "test", events[0].Id)
+		require.NoError(t, err)
+
+		batch := claim("test", "relay-1")
+		require.NotNil(t, batch)
+		assert.Equal(t, []string{EventUserCreated, EventAppointmentCreated, EventAppointmentCancelled}, eventTypes(batch))
+
+		_, err = db.ReplayOutboxEvents(ctx, "missing", "")
+		assert.ErrorIs(t, err, ErrOutboxSinkNotFound)
+	})
+}
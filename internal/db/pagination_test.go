@@ -0,0 +1,71 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageToken(t *testing.T) {
+	start := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	id := uuid.NewString()
+	userId := uuid.NewString()
+	scope := pageTokenScope(userId, start, time.Time{}, false)
+	token := encodePageToken(start, id, scope)
+
+	t.Run("round trips within its scope", func(t *testing.T) {
+		gotStart, gotId, err := decodePageToken(token, scope)
+		assert.NoError(t, err)
+		assert.True(t, start.Equal(gotStart))
+		assert.Equal(t, id, gotId)
+	})
+
+	t.Run("scopes compare times by instant", func(t *testing.T) {
+		local := start.In(time.FixedZone("UTC+2", 2*60*60))
+		assert.Equal(t, scope, pageTokenScope(userId, local, time.Time{}, false))
+	})
+
+	t.Run("is rejected for other parameters", func(t *testing.T) {
+		for _, other := range []string{
+			pageTokenScope(uuid.NewString(), start, time.Time{}, false),
+			pageTokenScope(userId, start.Add(time.Hour), time.Time{}, false),
+			pageTokenScope(userId, start, time.Time{}, true),
+		} {
+			_, _, err := decodePageToken(token, other)
+			assert.ErrorIs(t, err, ErrInvalidPageToken)
+		}
+	})
+
+	t.Run("is rejected when malformed", func(t *testing.T) {
+		_, _, err := decodePageToken("not a token", scope)
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+}
+
+func TestOffsetToken(t *testing.T) {
+	scope := pageTokenScope(`deleted = false`, "start_time desc")
+	token := encodeOffsetToken(50, scope)
+
+	t.Run("round trips within its scope", func(t *testing.T) {
+		offset, err := decodeOffsetToken(token, scope)
+		assert.NoError(t, err)
+		assert.Equal(t, 50, offset)
+	})
+
+	t.Run("is rejected for another filter or order", func(t *testing.T) {
+		for _, other := range []string{
+			pageTokenScope(`deleted = true`, "start_time desc"),
+			pageTokenScope(`deleted = false`, "start_time"),
+		} {
+			_, err := decodeOffsetToken(token, other)
+			assert.ErrorIs(t, err, ErrInvalidPageToken)
+		}
+	})
+
+	t.Run("is rejected when malformed", func(t *testing.T) {
+		_, err := decodeOffsetToken("not a token", scope)
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+}
@@ -0,0 +1,333 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrParticipantNotFound = errors.New("not a participant of this appointment")
+	ErrOrganizerRsvp       = errors.New("the organizer cannot answer their own appointment")
+)
+
+// ParticipantConflictError is an ErrAppointmentConflict naming the required
+// participant who is already busy and the appointment keeping them busy.
+type ParticipantConflictError struct {
+	UserId   string
+	Blocking *pb.Appointment
+}
+
+func (e *ParticipantConflictError) Error() string {
+	return fmt.Sprintf("%v (participant %s in appointment %s)", ErrAppointmentConflict, e.UserId, e.Blocking.Id)
+}
+
+func (e *ParticipantConflictError) Unwrap() error {
+	return ErrAppointmentConflict
+}
+
+var participantRoles = map[pb.ParticipantRole]string{
+	pb.ParticipantRole_PARTICIPANT_ROLE_ORGANIZER: "organizer",
+	pb.ParticipantRole_PARTICIPANT_ROLE_REQUIRED:  "required",
+	pb.ParticipantRole_PARTICIPANT_ROLE_OPTIONAL:  "optional",
+}
+
+var rsvpStatuses = map[pb.RsvpStatus]string{
+	pb.RsvpStatus_RSVP_STATUS_NEEDS_ACTION: "needs_action",
+	pb.RsvpStatus_RSVP_STATUS_ACCEPTED:     "accepted",
+	pb.RsvpStatus_RSVP_STATUS_DECLINED:     "declined",
+	pb.RsvpStatus_RSVP_STATUS_TENTATIVE:    "tentative",
+}
+
+func participantRoleFromDB(role string) pb.ParticipantRole {
+	for k, v := range participantRoles {
+		if v == role {
+			return k
+		}
+	}
+	return pb.ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED
+}
+
+func rsvpStatusFromDB(status string) pb.RsvpStatus {
+	for k, v := range rsvpStatuses {
+		if v == status {
+			return k
+		}
+	}
+	return pb.RsvpStatus_RSVP_STATUS_UNSPECIFIED
+}
+
+// visibleToUser matches the appointments the user in $1 organizes or has
+// been invited to.
+const visibleToUser = `(user_id = $1 OR id IN (SELECT appointment_id FROM appointment_participants WHERE user_id = $1))`
+
+const participantColumns = `p.user_id, u.name, COALESCE(u.email, ''), p.role, p.rsvp_status, p.responded_at`
+
+// participantOrder lists the organizer first, then required and optional
+// participants by name.
+const participantOrder = `array_position(ARRAY['organizer', 'required', 'optional'], p.role), lower(u.name), p.user_id`
+
+func scanParticipant(row pgx.Row) (*pb.Participant, error) {
+	var p pb.Participant
+	var role, status string
+	var respondedAt *time.Time
+
+	if err := row.Scan(&p.UserId, &p.Name, &p.Email, &role, &status, &respondedAt); err != nil {
+		return nil, err
+	}
+
+	p.Role = participantRoleFromDB(role)
+	p.RsvpStatus = rsvpStatusFromDB(status)
+	if respondedAt != nil {
+		p.RespondedAt = timestamppb.New(*respondedAt)
+	}
+
+	return &p, nil
+}
+
+// resolveParticipants gives each of participants known only by name and
+// email the id of the user with that email, creating users who do not exist
+// yet and returning them. Existing users keep their name, so inviting
+// someone cannot rename them. The new users' user.created events are left to
+// the caller, to be recorded once the appointment's own locks are held.
+func resolveParticipants(ctx context.Context, q querier, participants []*pb.Participant) ([]*pb.User, error) {
+	insert := `
+	INSERT INTO users (id, name, email, time_zone) VALUES ($1, $2, $3, 'UTC')
+	ON CONFLICT (email) DO NOTHING
+	RETURNING id, name, email, time_zone`
+
+	var created []*pb.User
+	for _, p := range participants {
+		if p.UserId != "" {
+			continue
+		}
+
+		var user pb.User
+		err := q.QueryRow(ctx, insert, uuid.NewString(), p.Name, p.Email).Scan(&user.Id, &user.Name, &user.Email, &user.TimeZone)
+		switch {
+		case err == nil:
+			created = append(created, &user)
+		case errors.Is(err, pgx.ErrNoRows):
+			if err := q.QueryRow(ctx, `SELECT id FROM users WHERE email = $1`, p.Email).Scan(&user.Id); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, err
+		}
+		p.UserId = user.Id
+	}
+
+	return created, nil
+}
+
+// insertParticipants records appt's user as its organizer, who has accepted
+// by booking it, and invites the other participants in appt.Participants.
+// appt.Participants is then reloaded with everyone's details.
+func insertParticipants(ctx context.Context, q querier, appt *pb.Appointment) error {
+	query := `
+	INSERT INTO appointment_participants (appointment_id, user_id, role, rsvp_status, responded_at)
+	VALUES ($1, $2, $3, $4, CASE WHEN $4 = 'needs_action' THEN NULL ELSE NOW() END)`
+
+	if _, err := q.Exec(ctx, query, appt.Id, appt.UserId, "organizer", "accepted"); err != nil {
+		return err
+	}
+
+	for _, p := range appt.Participants {
+		if p.Role == pb.ParticipantRole_PARTICIPANT_ROLE_ORGANIZER || p.UserId == appt.UserId {
+			continue
+		}
+		role, ok := participantRoles[p.Role]
+		if !ok {
+			return fmt.Errorf("unknown participant role %v", p.Role)
+		}
+		if _, err := q.Exec(ctx, query, appt.Id, p.UserId, role, "needs_action"); err != nil {
+			return err
+		}
+	}
+
+	return loadParticipants(ctx, q, []*pb.Appointment{appt})
+}
+
+// loadParticipants fills in the participants of each of appts.
+func loadParticipants(ctx context.Context, q querier, appts []*pb.Appointment) error {
+	if len(appts) == 0 {
+		return nil
+	}
+
+	byId := make(map[string]*pb.Appointment, len(appts))
+	ids := make([]string, 0, len(appts))
+	for _, appt := range appts {
+		appt.Participants = nil
+		byId[appt.Id] = appt
+		ids = append(ids, appt.Id)
+	}
+
+	query := `
+	SELECT ` + participantColumns + `, p.appointment_id
+	FROM appointment_participants p JOIN users u ON u.id = p.user_id
+	WHERE p.appointment_id = ANY($1)
+	ORDER BY ` + participantOrder
+
+	rows, err := q.Query(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var appointmentId string
+		p, err := scanParticipant(extraColumnsRow{Row: rows, extra: []any{&appointmentId}})
+		if err != nil {
+			return fmt.Errorf("error scanning row: %w", err)
+		}
+		appt := byId[appointmentId]
+		appt.Participants = append(appt.Participants, p)
+	}
+
+	return rows.Err()
+}
+
+// lockUsers locks the rows of userIds in a fixed order, so transactions
+// booking overlapping sets of people queue up rather than deadlock.
+func lockUsers(ctx context.Context, tx pgx.Tx, userIds []string) error {
+	_, err := tx.Exec(ctx, `SELECT id FROM users WHERE id = ANY($1) ORDER BY id FOR UPDATE`, userIds)
+	return err
+}
+
+// busyParticipants returns the organizer and required participants of appt,
+// whose other appointments may not overlap it.
+func busyParticipants(appt *pb.Appointment) []string {
+	userIds := []string{appt.UserId}
+	for _, p := range appt.Participants {
+		if p.Role == pb.ParticipantRole_PARTICIPANT_ROLE_REQUIRED && !slices.Contains(userIds, p.UserId) {
+			userIds = append(userIds, p.UserId)
+		}
+	}
+	return userIds
+}
+
+// participantConflict returns a *ParticipantConflictError if any of userIds
+// is busy in another active appointment overlapping appt, buffers included.
+// Someone is busy in the appointments they organize and those they are
+// required at and have not declined. Without buffers of its own appt is
+// judged with the schedule's, as it will be saved with them.
+func participantConflict(ctx context.Context, q querier, userIds []string, appt *pb.Appointment) error {
+	query := `
+	SELECT ` + calendarEntryColumns + `, busy.busy_user_id
+	FROM appointments, LATERAL (
+		SELECT p.user_id::text AS busy_user_id
+		FROM appointment_participants p
+		WHERE p.appointment_id = appointments.id AND p.user_id = ANY($1)
+			AND p.role IN ('organizer', 'required') AND p.rsvp_status <> 'declined'
+		ORDER BY p.user_id
+		LIMIT 1
+	) busy
+	WHERE appointments.deleted_at IS NULL AND appointments.id <> $2
+		AND appointment_busy_range(appointments.start_time, appointments.end_time,
+			appointments.buffer_before_seconds, appointments.buffer_after_seconds)
+		&& appointment_busy_range($3, $4,
+			COALESCE($5, (SELECT buffer_before_seconds FROM schedule), 0),
+			COALESCE($6, (SELECT buffer_after_seconds FROM schedule), 0))
+	ORDER BY appointments.start_time
+	LIMIT 1`
+
+	var busyUserId string
+	row := q.QueryRow(ctx, query, userIds, appt.Id, appt.StartTime.AsTime(), appt.EndTime.AsTime(),
+		optionalSeconds(appt.BufferBefore), optionalSeconds(appt.BufferAfter))
+	blocking, err := scanCalendarEntry(extraColumnsRow{Row: row, extra: []any{&busyUserId}})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	return &ParticipantConflictError{UserId: busyUserId, Blocking: blocking.Appointment}
+}
+
+// UpdateRsvpStatus records userId's answer to their invitation to the active
+// appointment appointmentId. A required participant taking back a decline
+// becomes busy again, so that fails with a *ParticipantConflictError if they
+// have been booked elsewhere at the time since.
+func (db *Database) UpdateRsvpStatus(ctx context.Context, appointmentId, userId string, status pb.RsvpStatus) (*pb.Participant, error) {
+	dbStatus, ok := rsvpStatuses[status]
+	if !ok {
+		return nil, fmt.Errorf("unknown RSVP status %v", status)
+	}
+
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	entry, err := lockActiveAppointment(ctx, tx, "id = $1", appointmentId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAppointmentNotFound
+		}
+		return nil, err
+	}
+
+	if err := lockUsers(ctx, tx, []string{userId}); err != nil {
+		return nil, err
+	}
+
+	lock := `
+	SELECT ` + participantColumns + `
+	FROM appointment_participants p JOIN users u ON u.id = p.user_id
+	WHERE p.appointment_id = $1 AND p.user_id = $2
+	FOR UPDATE OF p`
+
+	before, err := scanParticipant(tx.QueryRow(ctx, lock, appointmentId, userId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrParticipantNotFound
+		}
+		return nil, err
+	}
+	if before.Role == pb.ParticipantRole_PARTICIPANT_ROLE_ORGANIZER {
+		return nil, ErrOrganizerRsvp
+	}
+
+	if before.Role == pb.ParticipantRole_PARTICIPANT_ROLE_REQUIRED &&
+		before.RsvpStatus == pb.RsvpStatus_RSVP_STATUS_DECLINED &&
+		status != pb.RsvpStatus_RSVP_STATUS_DECLINED {
+		if err := participantConflict(ctx, tx, []string{userId}, entry.Appointment); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+	WITH p AS (
+		UPDATE appointment_participants SET rsvp_status = $3, responded_at = NOW()
+		WHERE appointment_id = $1 AND user_id = $2
+		RETURNING *
+	)
+	SELECT ` + participantColumns + `
+	FROM p JOIN users u ON u.id = p.user_id`
+
+	after, err := scanParticipant(tx.QueryRow(ctx, query, appointmentId, userId, dbStatus))
+	if err != nil {
+		return nil, err
+	}
+
+	// The snapshots leave out contact details, which live with the user. They
+	// are kept apart from the appointment's own snapshots, which
+	// GetAppointmentAsOf reads back, under the appointment's id.
+	snapshot := func(p *pb.Participant) *pb.Participant {
+		return &pb.Participant{UserId: p.UserId, Role: p.Role, RsvpStatus: p.RsvpStatus, RespondedAt: p.RespondedAt}
+	}
+	if err := appendAuditEvent(ctx, tx, "appointment_participant", appointmentId, "appointment_participant.rsvp_updated", snapshot(before), snapshot(after)); err != nil {
+		return nil, err
+	}
+
+	return after, tx.Commit(ctx)
+}
@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParticipants(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	newUser := func(name string) *pb.User {
+		user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: name, Email: strings.ToLower(name) + "@example.com"})
+		require.NoError(t, err)
+		return user
+	}
+	ann, bob, cara, dan := newUser("Ann"), newUser("Bob"), newUser("Cara"), newUser("Dan")
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	newAppointment := func(organizer *pb.User, start time.Time, participants ...*pb.Participant) *pb.Appointment {
+		return &pb.Appointment{
+			Id:                 uuid.NewString(),
+			Title:              "Planning",
+			UserId:             organizer.Id,
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: organizer.Name, Email: organizer.Email},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+			Participants:       participants,
+		}
+	}
+
+	meeting := newAppointment(ann, start,
+		&pb.Participant{UserId: cara.Id, Role: pb.ParticipantRole_PARTICIPANT_ROLE_OPTIONAL},
+		&pb.Participant{UserId: bob.Id, Role: pb.ParticipantRole_PARTICIPANT_ROLE_REQUIRED},
+	)
+	require.NoError(t, db.BookAppointment(ctx, meeting, time.Now()))
+
+	t.Run("the organizer comes first and has accepted", func(t *testing.T) {
+		stored, err := db.GetAppointment(ctx, meeting.Id)
+		require.NoError(t, err)
+		require.Len(t, stored.Participants, 3)
+
+		assert.Equal(t, ann.Id, stored.Participants[0].UserId)
+		assert.Equal(t, pb.ParticipantRole_PARTICIPANT_ROLE_ORGANIZER, stored.Participants[0].Role)
+		assert.Equal(t, pb.RsvpStatus_RSVP_STATUS_ACCEPTED, stored.Participants[0].RsvpStatus)
+
+		assert.Equal(t, bob.Id, stored.Participants[1].UserId)
+		assert.Equal(t, "bob@example.com", stored.Participants[1].Email)
+		assert.Equal(t, pb.RsvpStatus_RSVP_STATUS_NEEDS_ACTION, stored.Participants[1].RsvpStatus)
+		assert.Nil(t, stored.Participants[1].RespondedAt)
+
+		assert.Equal(t, cara.Id, stored.Participants[2].UserId)
+		assert.Equal(t, pb.ParticipantRole_PARTICIPANT_ROLE_OPTIONAL, stored.Participants[2].Role)
+	})
+
+	t.Run("every participant sees the appointment", func(t *testing.T) {
+		for _, user := range []*pb.User{ann, bob, cara} {
+			appts, _, err := db.GetAppointments(ctx, user.Id, AppointmentFilter{})
+			require.NoError(t, err)
+			require.Len(t, appts, 1, user.Name)
+			assert.Equal(t, meeting.Id, appts[0].Id)
+			assert.Len(t, appts[0].Participants, 3)
+		}
+
+		appts, _, err := db.GetAppointments(ctx, dan.Id, AppointmentFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, appts)
+	})
+
+	t.Run("required participants cannot be double-booked", func(t *testing.T) {
+		clash := newAppointment(dan, start.Add(30*time.Minute),
+			&pb.Participant{UserId: bob.Id, Role: pb.ParticipantRole_PARTICIPANT_ROLE_REQUIRED})
+		err := db.BookAppointment(ctx, clash, time.Now())
+
+		var conflict *ParticipantConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.ErrorIs(t, err, ErrAppointmentConflict)
+		assert.Equal(t, bob.Id, conflict.UserId)
+		assert.Equal(t, meeting.Id, conflict.Blocking.Id)
+
+		_, err = db.GetAppointment(ctx, clash.Id)
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+	})
+
+	t.Run("optional participants are not checked", func(t *testing.T) {
+		// The slot is still taken, but not because of Cara.
+		clash := newAppointment(dan, start.Add(30*time.Minute),
+			&pb.Participant{UserId: cara.Id, Role: pb.ParticipantRole_PARTICIPANT_ROLE_OPTIONAL})
+		err := db.BookAppointment(ctx, clash, time.Now())
+
+		var conflict *ParticipantConflictError
+		assert.ErrorIs(t, err, ErrAppointmentConflict)
+		assert.False(t, errors.As(err, &conflict))
+	})
+
+	t.Run("participants answer their invitations", func(t *testing.T) {
+		declined, err := db.UpdateRsvpStatus(ctx, meeting.Id, bob.Id, pb.RsvpStatus_RSVP_STATUS_DECLINED)
+		require.NoError(t, err)
+		assert.Equal(t, pb.RsvpStatus_RSVP_STATUS_DECLINED, declined.RsvpStatus)
+		assert.NotNil(t, declined.RespondedAt)
+
+		accepted, err := db.UpdateRsvpStatus(ctx, meeting.Id, bob.Id, pb.RsvpStatus_RSVP_STATUS_ACCEPTED)
+		require.NoError(t, err)
+		assert.Equal(t, pb.RsvpStatus_RSVP_STATUS_ACCEPTED, accepted.RsvpStatus)
+
+		_, err = db.UpdateRsvpStatus(ctx, meeting.Id, ann.Id, pb.RsvpStatus_RSVP_STATUS_DECLINED)
+		assert.ErrorIs(t, err, ErrOrganizerRsvp)
+
+		_, err = db.UpdateRsvpStatus(ctx, meeting.Id, dan.Id, pb.RsvpStatus_RSVP_STATUS_ACCEPTED)
+		assert.ErrorIs(t, err, ErrParticipantNotFound)
+
+		_, err = db.UpdateRsvpStatus(ctx, uuid.NewString(), bob.Id, pb.RsvpStatus_RSVP_STATUS_ACCEPTED)
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+	})
+
+	t.Run("answers are audited apart from the appointment", func(t *testing.T) {
+		_, err := db.UpdateRsvpStatus(ctx, meeting.Id, cara.Id, pb.RsvpStatus_RSVP_STATUS_TENTATIVE)
+		require.NoError(t, err)
+
+		events, _, err := db.ListAuditEvents(ctx, AuditFilter{EntityType: "appointment_participant", EntityId: meeting.Id, PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "appointment_participant.rsvp_updated", events[0].Action)
+
+		asOf, err := db.GetAppointmentAsOf(ctx, meeting.Id, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, meeting.Id, asOf.Id)
+		assert.Equal(t, "Planning", asOf.Title)
+	})
+
+	t.Run("invitees are found or created by email without renaming anyone", func(t *testing.T) {
+		clash := newAppointment(dan, start.Add(30*time.Minute),
+			&pb.Participant{Name: "Eve", Email: "eve@example.com", Role: pb.ParticipantRole_PARTICIPANT_ROLE_OPTIONAL},
+			&pb.Participant{UserId: bob.Id, Role: pb.ParticipantRole_PARTICIPANT_ROLE_REQUIRED})
+		require.ErrorIs(t, db.BookAppointment(ctx, clash, time.Now()), ErrAppointmentConflict)
+
+		_, err := db.FindUserByEmail(ctx, "eve@example.com")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+
+		invite := newAppointment(dan, start.Add(5*time.Hour),
+			&pb.Participant{Name: "Robert", Email: "bob@example.com", Role: pb.ParticipantRole_PARTICIPANT_ROLE_REQUIRED},
+			&pb.Participant{Name: "Eve", Email: "eve@example.com", Role: pb.ParticipantRole_PARTICIPANT_ROLE_OPTIONAL})
+		require.NoError(t, db.BookAppointment(ctx, invite, time.Now()))
+
+		stored, err := db.GetUser(ctx, bob.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "Bob", stored.Name)
+
+		eve, err := db.FindUserByEmail(ctx, "eve@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "Eve", eve.Name)
+
+		require.Len(t, invite.Participants, 3)
+		assert.Equal(t, bob.Id, invite.Participants[1].UserId)
+		assert.Equal(t, eve.Id, invite.Participants[2].UserId)
+	})
+}
@@ -0,0 +1,206 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrUserOnLegalHold = errors.New("user is under a legal hold")
+
+var ErrUserErased = errors.New("user has been erased")
+
+// erasedName replaces the name of an erased user wherever it was stored.
+const erasedName = "[erased]"
+
+// A user's name and email are copied into their appointments and into the
+// JSON snapshots kept by the audit log, the outbox and webhook deliveries, so
+// erasing a user scrubs all of those. The users row stays behind with
+// erased_at set: it keeps the user's appointments valid, and lets restores
+// and later erasure runs see that the user is gone.
+
+// Snapshots are protojson, so the fields are named as in the proto.
+const (
+	scrubAppointmentSnapshot = `CASE WHEN %[1]s ? 'contactInformation'
+		THEN jsonb_set(%[1]s, '{contactInformation}', jsonb_build_object('name', $2::text)) ELSE %[1]s END`
+	scrubUserSnapshot = `(%[1]s || jsonb_build_object('name', $2::text)) - 'email'`
+
+	// Appointment snapshots also list their participants, the organizer
+	// included, by name and email.
+	scrubParticipantSnapshot = `CASE WHEN jsonb_typeof(%[1]s->'participants') = 'array'
+		THEN jsonb_set(%[1]s, '{participants}', COALESCE((
+			SELECT jsonb_agg(CASE WHEN p->>'userId' = $1::uuid::text
+				THEN (p || jsonb_build_object('name', $2::text)) - 'email' ELSE p END ORDER BY n)
+			FROM jsonb_array_elements(%[1]s->'participants') WITH ORDINALITY AS e(p, n)), '[]'))
+		ELSE %[1]s END`
+	listsParticipant = `jsonb_path_exists(%[1]s, '$.participants[*] ? (@.userId == $id && @.name != $name)',
+		jsonb_build_object('id', $1::uuid::text, 'name', $2::text))`
+)
+
+// UserExport is everything stored about a user.
+type UserExport struct {
+	User      *pb.User
+	CreatedAt time.Time
+	ErasedAt  *time.Time
+	// Appointments includes deleted and archived appointments.
+	Appointments []*pb.Appointment
+}
+
+// ExportUserData collects a user's record and all of their appointments,
+// including deleted ones and those the retention policy has archived.
+func (db *Database) ExportUserData(ctx context.Context, userId string) (*UserExport, error) {
+	query := `SELECT id, name, COALESCE(email, ''), created_at, erased_at FROM users WHERE id = $1`
+
+	export := &UserExport{User: &pb.User{}}
+	err := db.Pool.QueryRow(ctx, query, userId).Scan(
+		&export.User.Id,
+		&export.User.Name,
+		&export.User.Email,
+		&export.CreatedAt,
+		&export.ErasedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	// Archived rows are stored as JSON of the appointments row, so they are
+	// read back through the table's row type.
+	appointments := `
+	SELECT ` + calendarEntryColumns + `
+	FROM (
+		SELECT * FROM appointments WHERE user_id = $1
+		UNION ALL
+		SELECT (jsonb_populate_record(NULL::appointments, appointment)).*
+		FROM archived_appointments WHERE user_id = $1
+	) a
+	ORDER BY start_time, id`
+
+	rows, err := db.Pool.Query(ctx, appointments, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	export.Appointments, err = collectAppointments(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// EraseUser anonymizes a user: their record, every appointment they made,
+// whether active, deleted or archived, the snapshots of those kept elsewhere,
+// and their entries in the participants of any appointment snapshot. It also
+// revokes their feed tokens, cancels their reminders and takes them off the
+// waitlist. Erasing a user twice scrubs again, which catches anything
+// restored since, but only records the erasure once. Users under a legal hold cannot be
+// erased. It returns when the user was first erased.
+func (db *Database) EraseUser(ctx context.Context, userId string) (time.Time, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var legalHold bool
+	var previouslyErased *time.Time
+	lock := `SELECT legal_hold, erased_at FROM users WHERE id = $1 FOR UPDATE`
+	if err := tx.QueryRow(ctx, lock, userId).Scan(&legalHold, &previouslyErased); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, ErrUserNotFound
+		}
+		return time.Time{}, err
+	}
+	if legalHold {
+		return time.Time{}, ErrUserOnLegalHold
+	}
+
+	var erasedAt time.Time
+	query := `
+	UPDATE users SET name = $2, email = NULL, erased_at = COALESCE(erased_at, NOW()), updated_at = NOW()
+	WHERE id = $1
+	RETURNING erased_at`
+	if err := tx.QueryRow(ctx, query, userId, erasedName).Scan(&erasedAt); err != nil {
+		return time.Time{}, err
+	}
+
+	statements := []string{
+		`UPDATE appointments SET contact_name = $2, contact_email = '', updated_at = NOW()
+		WHERE user_id = $1 AND (contact_name <> $2 OR contact_email <> '')`,
+
+		`UPDATE archived_appointments
+		SET appointment = appointment || jsonb_build_object('contact_name', $2::text, 'contact_email', '')
+		WHERE user_id = $1`,
+
+		`UPDATE audit_events SET
+			before = CASE entity_type WHEN 'user' THEN ` + fmt.Sprintf(scrubUserSnapshot, "before") + ` ELSE ` + fmt.Sprintf(scrubAppointmentSnapshot, "before") + ` END,
+			after = CASE entity_type WHEN 'user' THEN ` + fmt.Sprintf(scrubUserSnapshot, "after") + ` ELSE ` + fmt.Sprintf(scrubAppointmentSnapshot, "after") + ` END,
+			redacted_at = NOW()
+		WHERE redacted_at IS NULL AND ((entity_type = 'user' AND entity_id = $1)
+			OR (entity_type = 'appointment' AND (COALESCE(after, before)->>'userId')::uuid = $1))`,
+
+		`UPDATE outbox_events SET
+			payload = CASE aggregate_type WHEN 'user' THEN ` + fmt.Sprintf(scrubUserSnapshot, "payload") + ` ELSE ` + fmt.Sprintf(scrubAppointmentSnapshot, "payload") + ` END
+		WHERE (aggregate_type = 'user' AND aggregate_id = $1)
+			OR (aggregate_type IN ('appointment', 'waitlist_entry') AND (payload->>'userId')::uuid = $1)`,
+
+		`UPDATE webhook_deliveries
+		SET payload = jsonb_set(payload, '{data,appointment,contactInformation}', jsonb_build_object('name', $2::text))
+		WHERE (payload #>> '{data,appointment,userId}')::uuid = $1
+			AND payload #> '{data,appointment}' ? 'contactInformation'`,
+
+		`UPDATE audit_events SET
+			before = ` + fmt.Sprintf(scrubParticipantSnapshot, "before") + `,
+			after = ` + fmt.Sprintf(scrubParticipantSnapshot, "after") + `,
+			redacted_at = COALESCE(redacted_at, NOW())
+		WHERE entity_type = 'appointment'
+			AND (` + fmt.Sprintf(listsParticipant, "before") + ` OR ` + fmt.Sprintf(listsParticipant, "after") + `)`,
+
+		`UPDATE outbox_events SET payload = ` + fmt.Sprintf(scrubParticipantSnapshot, "payload") + `
+		WHERE aggregate_type = 'appointment' AND ` + fmt.Sprintf(listsParticipant, "payload"),
+
+		`UPDATE webhook_deliveries
+		SET payload = jsonb_set(payload, '{data,appointment}', ` + fmt.Sprintf(scrubParticipantSnapshot, "(payload #> '{data,appointment}')") + `)
+		WHERE ` + fmt.Sprintf(listsParticipant, "(payload #> '{data,appointment}')"),
+	}
+
+	for _, statement := range statements {
+		if _, err := tx.Exec(ctx, statement, userId, erasedName); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE feed_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`, userId); err != nil {
+		return time.Time{}, err
+	}
+
+	cancel := `
+	UPDATE reminders SET status = 'cancelled'
+	WHERE status IN ('pending', 'sending')
+		AND appointment_id IN (SELECT id FROM appointments WHERE user_id = $1)`
+	if _, err := tx.Exec(ctx, cancel, userId); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := leaveWaitlistEntries(ctx, tx, userId); err != nil {
+		return time.Time{}, err
+	}
+
+	if previouslyErased == nil {
+		if err := appendAuditEvent(ctx, tx, "user", userId, EventUserErased, nil, nil); err != nil {
+			return time.Time{}, err
+		}
+		if err := appendOutboxEvent(ctx, tx, "user", userId, EventUserErased, &pb.User{Id: userId}); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return erasedAt, tx.Commit(ctx)
+}
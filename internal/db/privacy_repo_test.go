@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEraseUser(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Jane Doe", Email: "jane@example.com"})
+	require.NoError(t, err)
+
+	start := time.Now().Add(24 * time.Hour)
+	newAppointment := func() *pb.Appointment {
+		appt := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Title:              "Test title",
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: "Jane Doe", Email: "jane@example.com"},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+		}
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		start = start.Add(2 * time.Hour)
+		return appt
+	}
+
+	active := newAppointment()
+	deleted := newAppointment()
+	archived := newAppointment()
+	for _, appt := range []*pb.Appointment{deleted, archived} {
+		_, err := db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.SetLegalHold(ctx, "appointment", deleted.Id, true))
+	_, err = db.PurgeDeletedAppointments(ctx, time.Now().Add(time.Minute), true, 10)
+	require.NoError(t, err)
+	require.NoError(t, db.SetLegalHold(ctx, "appointment", deleted.Id, false))
+
+	t.Run("exports active, deleted and archived appointments", func(t *testing.T) {
+		export, err := db.ExportUserData(ctx, user.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "jane@example.com", export.User.Email)
+		assert.Nil(t, export.ErasedAt)
+
+		var ids []string
+		for _, appt := range export.Appointments {
+			ids = append(ids, appt.Id)
+		}
+		assert.Equal(t, []string{active.Id, deleted.Id, archived.Id}, ids)
+		assert.NotNil(t, export.Appointments[2].DeletedAt)
+		assert.Equal(t, "Jane Doe", export.Appointments[2].ContactInformation.Name)
+
+		_, err = db.ExportUserData(ctx, uuid.NewString())
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("refuses users under a legal hold", func(t *testing.T) {
+		require.NoError(t, db.SetLegalHold(ctx, "user", user.Id, true))
+		_, err := db.EraseUser(ctx, user.Id)
+		assert.ErrorIs(t, err, ErrUserOnLegalHold)
+		require.NoError(t, db.SetLegalHold(ctx, "user", user.Id, false))
+	})
+
+	t.Run("anonymizes every copy of the user's details", func(t *testing.T) {
+		erasedAt, err := db.EraseUser(ctx, user.Id)
+		require.NoError(t, err)
+
+		export, err := db.ExportUserData(ctx, user.Id)
+		require.NoError(t, err)
+		assert.Equal(t, erasedName, export.User.Name)
+		assert.Empty(t, export.User.Email)
+		require.NotNil(t, export.ErasedAt)
+		assert.WithinDuration(t, erasedAt, *export.ErasedAt, 0)
+		for _, appt := range export.Appointments {
+			assert.Equal(t, erasedName, appt.ContactInformation.Name)
+			assert.Empty(t, appt.ContactInformation.Email)
+		}
+
+		for _, table := range []string{"audit_events", "outbox_events"} {
+			var leaks int
+			query := `SELECT COUNT(*) FROM ` + table + ` e WHERE to_jsonb(e)::text LIKE '%jane%' OR to_jsonb(e)::text LIKE '%Jane%'`
+			require.NoError(t, db.Pool.QueryRow(ctx, query).Scan(&leaks))
+			assert.Zero(t, leaks, table)
+		}
+
+		result, err := db.VerifyAuditLog(ctx)
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+	})
+
+	t.Run("frees the email for a new user", func(t *testing.T) {
+		_, err := db.FindUserByEmail(ctx, "jane@example.com")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+
+		again, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Jane Doe", Email: "jane@example.com"})
+		require.NoError(t, err)
+		assert.NotEqual(t, user.Id, again.Id)
+	})
+
+	t.Run("keeps erased appointments from being restored", func(t *testing.T) {
+		_, err := db.RestoreAppointment(ctx, deleted.Id)
+		assert.ErrorIs(t, err, ErrUserErased)
+	})
+
+	t.Run("records the erasure once", func(t *testing.T) {
+		_, err := db.EraseUser(ctx, user.Id)
+		require.NoError(t, err)
+
+		events, _, err := db.ListAuditEvents(ctx, AuditFilter{EntityId: user.Id})
+		require.NoError(t, err)
+
+		var erasures int
+		for _, e := range events {
+			if e.Action == EventUserErased {
+				erasures++
+			}
+		}
+		assert.Equal(t, 1, erasures)
+	})
+
+	t.Run("scrubs erased invitees from other users' appointments", func(t *testing.T) {
+		olive := newTestUser(t, db, "Olive")
+		appt := newTestAppointment(olive, start, time.Hour)
+		appt.Participants = []*pb.Participant{
+			{Name: "Ivan Petrov", Email: "ivan@example.com", Role: pb.ParticipantRole_PARTICIPANT_ROLE_OPTIONAL},
+		}
+		require.NoError(t, db.BookAppointment(ctx, appt, time.Now()))
+
+		ivan, err := db.FindUserByEmail(ctx, "ivan@example.com")
+		require.NoError(t, err)
+		_, err = db.EraseUser(ctx, ivan.Id)
+		require.NoError(t, err)
+
+		for _, table := range []string{"audit_events", "outbox_events", "webhook_deliveries"} {
+			var leaks, kept int
+			query := `SELECT COUNT(*) FILTER (WHERE to_jsonb(e)::text ILIKE '%ivan%'),
+				COUNT(*) FILTER (WHERE to_jsonb(e)::text LIKE '%olive@example.com%')
+			FROM ` + table + ` e`
+			require.NoError(t, db.Pool.QueryRow(ctx, query).Scan(&leaks, &kept))
+			assert.Zero(t, leaks, table)
+			if table != "webhook_deliveries" {
+				assert.NotZero(t, kept, table)
+			}
+		}
+
+		stored, err := db.GetAppointment(ctx, appt.Id)
+		require.NoError(t, err)
+		require.Len(t, stored.Participants, 2)
+		assert.Equal(t, erasedName, stored.Participants[1].Name)
+		assert.Empty(t, stored.Participants[1].Email)
+
+		result, err := db.VerifyAuditLog(ctx)
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+	})
+}
@@ -0,0 +1,204 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reminders are jobs to email an appointment's contact some time before it
+// starts. Workers claim due jobs with FOR UPDATE SKIP LOCKED and hold them
+// under a lease, so several server replicas can poll the table without
+// sending the same reminder twice, and a job claimed by a replica that dies
+// becomes claimable again once its lease runs out.
+
+// ScheduleReminders (re)schedules a reminder offset before start for each of
+// offsets. Bookings already schedule db.ReminderOffsets in their own
+// transaction; this is for setting up other offsets afterwards.
+func (db *Database) ScheduleReminders(ctx context.Context, appointmentId string, start time.Time, offsets []time.Duration) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := scheduleReminders(ctx, tx, appointmentId, start, offsets); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// scheduleReminders does the work of ScheduleReminders within q, so the
+// reminders of an appointment commit or roll back with the appointment
+// itself. Reminders that were already sent or cancelled are reset, so
+// rescheduling an appointment reminds the contact of the new time. Offsets
+// that already lie in the past are dropped.
+func scheduleReminders(ctx context.Context, q querier, appointmentId string, start time.Time, offsets []time.Duration) error {
+	upsert := `
+	INSERT INTO reminders (id, appointment_id, offset_seconds, send_at)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (appointment_id, offset_seconds) DO UPDATE
+	SET send_at = EXCLUDED.send_at, status = 'pending', attempts = 0,
+		locked_until = NULL, last_error = NULL, sent_at = NULL`
+
+	cancel := `
+	UPDATE reminders SET status = 'cancelled'
+	WHERE appointment_id = $1 AND offset_seconds = $2 AND status IN ('pending', 'sending')`
+
+	now := time.Now()
+	for _, offset := range offsets {
+		seconds := int(offset / time.Second)
+		sendAt := start.Add(-offset)
+
+		if !sendAt.After(now) {
+			if _, err := q.Exec(ctx, cancel, appointmentId, seconds); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := q.Exec(ctx, upsert, uuid.NewString(), appointmentId, seconds, sendAt); err != nil {
+			return fmt.Errorf("failed to schedule reminder: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// cancelReminders stops any reminders still waiting to go out for an
+// appointment.
+func cancelReminders(ctx context.Context, q querier, appointmentId string) error {
+	query := `
+	UPDATE reminders SET status = 'cancelled'
+	WHERE appointment_id = $1 AND status IN ('pending', 'sending')`
+
+	_, err := q.Exec(ctx, query, appointmentId)
+	return err
+}
+
+// DueReminder is a claimed reminder along with the appointment it is for.
+type DueReminder struct {
+	Id          string
+	Offset      time.Duration
+	Attempts    int
+	Appointment *pb.Appointment
+}
+
+// ClaimDueReminders claims up to limit reminders that are due, leasing them
+// for lease. Reminders for appointments that have already started are
+// cancelled rather than sent late.
+func (db *Database) ClaimDueReminders(ctx context.Context, limit int, lease time.Duration) ([]*DueReminder, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	expire := `
+	UPDATE reminders r SET status = 'cancelled', last_error = 'appointment already started'
+	FROM appointments a
+	WHERE a.id = r.appointment_id AND r.status IN ('pending', 'sending')
+		AND r.send_at <= NOW() AND a.start_time <= NOW()`
+
+	if _, err := tx.Exec(ctx, expire); err != nil {
+		return nil, err
+	}
+
+	claim := `
+	WITH due AS (
+		SELECT r.id FROM reminders r
+		JOIN appointments a ON a.id = r.appointment_id
+		WHERE r.send_at <= NOW() AND a.deleted_at IS NULL
+			AND (r.status = 'pending' OR (r.status = 'sending' AND r.locked_until < NOW()))
+		ORDER BY r.send_at
+		LIMIT $1
+		FOR UPDATE OF r SKIP LOCKED
+	)
+	UPDATE reminders r
+	SET status = 'sending', locked_until = NOW() + make_interval(secs => $2), attempts = r.attempts + 1
+	FROM due, appointments a
+	WHERE r.id = due.id AND a.id = r.appointment_id
+	RETURNING r.id, r.offset_seconds, r.attempts,
+		a.id, a.user_id, a.title, a.description, a.date, a.contact_name, a.contact_email, a.start_time, a.end_time`
+
+	rows, err := tx.Query(ctx, claim, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*DueReminder
+	for rows.Next() {
+		var r DueReminder
+		var offsetSeconds int
+		var appt pb.Appointment
+		var contact pb.ContactInformation
+		var date, start, end time.Time
+
+		err := rows.Scan(
+			&r.Id,
+			&offsetSeconds,
+			&r.Attempts,
+			&appt.Id,
+			&appt.UserId,
+			&appt.Title,
+			&appt.Description,
+			&date,
+			&contact.Name,
+			&contact.Email,
+			&start,
+			&end,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+
+		appt.ContactInformation = &contact
+		appt.Date = timestamppb.New(date)
+		appt.StartTime = timestamppb.New(start)
+		appt.EndTime = timestamppb.New(end)
+
+		r.Offset = time.Duration(offsetSeconds) * time.Second
+		r.Appointment = &appt
+		result = append(result, &r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// MarkReminderSent records that a claimed reminder went out.
+func (db *Database) MarkReminderSent(ctx context.Context, id string) error {
+	query := `
+	UPDATE reminders SET status = 'sent', sent_at = NOW(), locked_until = NULL, last_error = NULL
+	WHERE id = $1 AND status = 'sending'`
+
+	_, err := db.Pool.Exec(ctx, query, id)
+	return err
+}
+
+// RetryReminder releases a claimed reminder that failed to send so it is
+// tried again at retryAt, or marks it failed once it has used up
+// maxAttempts.
+func (db *Database) RetryReminder(ctx context.Context, id, lastError string, retryAt time.Time, maxAttempts int) error {
+	query := `
+	UPDATE reminders
+	SET status = CASE WHEN attempts >= $4 THEN 'failed' ELSE 'pending' END,
+		send_at = $3, locked_until = NULL, last_error = $2
+	WHERE id = $1 AND status = 'sending'`
+
+	_, err := db.Pool.Exec(ctx, query, id, lastError, retryAt, maxAttempts)
+	return err
+}
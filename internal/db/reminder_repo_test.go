@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestReminders(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{
+		Id:    uuid.NewString(),
+		Name:  "Test user",
+		Email: "test@user.com",
+	})
+	require.NoError(t, err)
+
+	newAppointment := func(start time.Time) *pb.Appointment {
+		appt := &pb.Appointment{
+			Id:          uuid.NewString(),
+			UserId:      user.Id,
+			Title:       "Test title",
+			Description: "Test description",
+			Date:        timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{
+				Name:  "Test",
+				Email: "test@user.com",
+			},
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(start.Add(30 * time.Minute)),
+		}
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		return appt
+	}
+
+	countByStatus := func(appointmentId, status string) int {
+		var n int
+		err := db.Pool.QueryRow(ctx, "SELECT COUNT(*) FROM reminders WHERE appointment_id = $1 AND status = $2", appointmentId, status).Scan(&n)
+		require.NoError(t, err)
+		return n
+	}
+
+	t.Run("skips offsets that are already in the past", func(t *testing.T) {
+		appt := newAppointment(time.Now().Add(2 * time.Hour))
+		require.NoError(t, db.ScheduleReminders(ctx, appt.Id, appt.StartTime.AsTime(), []time.Duration{24 * time.Hour, time.Hour}))
+
+		assert.Equal(t, 1, countByStatus(appt.Id, "pending"))
+	})
+
+	t.Run("concurrent claims never hand out the same reminder", func(t *testing.T) {
+		appt := newAppointment(time.Now().Add(10 * time.Minute))
+		require.NoError(t, db.ScheduleReminders(ctx, appt.Id, appt.StartTime.AsTime(), []time.Duration{time.Hour}))
+		_, err := db.Pool.Exec(ctx, "INSERT INTO reminders (id, appointment_id, offset_seconds, send_at) VALUES ($1, $2, 1800, NOW())", uuid.NewString(), appt.Id)
+		require.NoError(t, err)
+
+		// The 1h reminder lies in the past, so only the one inserted above is due.
+		var wg sync.WaitGroup
+		claimed := make([][]*DueReminder, 4)
+		for i := range claimed {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				claimed[i], _ = db.ClaimDueReminders(ctx, 10, time.Minute)
+			}()
+		}
+		wg.Wait()
+
+		total := 0
+		for _, c := range claimed {
+			total += len(c)
+		}
+		assert.Equal(t, 1, total)
+
+		more, err := db.ClaimDueReminders(ctx, 10, time.Minute)
+		assert.NoError(t, err)
+		assert.Empty(t, more)
+	})
+
+	t.Run("sent and failed reminders leave the queue", func(t *testing.T) {
+		appt := newAppointment(time.Now().Add(3 * time.Hour))
+		_, err := db.Pool.Exec(ctx, "INSERT INTO reminders (id, appointment_id, offset_seconds, send_at) VALUES ($1, $2, 1800, NOW())", uuid.NewString(), appt.Id)
+		require.NoError(t, err)
+
+		due, err := db.ClaimDueReminders(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		assert.Equal(t, appt.Id, due[0].Appointment.Id)
+		assert.Equal(t, 30*time.Minute, due[0].Offset)
+
+		require.NoError(t, db.RetryReminder(ctx, due[0].Id, "boom", time.Now(), 2))
+		due, err = db.ClaimDueReminders(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		assert.Equal(t, 2, due[0].Attempts)
+
+		require.NoError(t, db.RetryReminder(ctx, due[0].Id, "boom", time.Now(), 2))
+		assert.Equal(t, 1, countByStatus(appt.Id, "failed"))
+	})
+
+	t.Run("soft deleting an appointment cancels its reminders", func(t *testing.T) {
+		appt := newAppointment(time.Now().Add(48 * time.Hour))
+		require.NoError(t, db.ScheduleReminders(ctx, appt.Id, appt.StartTime.AsTime(), []time.Duration{24 * time.Hour, time.Hour}))
+
+		_, err := db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, countByStatus(appt.Id, "pending"))
+		assert.Equal(t, 2, countByStatus(appt.Id, "cancelled"))
+	})
+
+	t.Run("bookings schedule reminders in their own transaction", func(t *testing.T) {
+		db.ReminderOffsets = []time.Duration{24 * time.Hour, time.Hour}
+		t.Cleanup(func() { db.ReminderOffsets = nil })
+
+		start := time.Now().Add(72 * time.Hour).Truncate(time.Hour)
+		appt := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Title:              "Booked",
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: "Test", Email: "test@user.com"},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(30 * time.Minute)),
+		}
+		require.NoError(t, db.BookAppointment(ctx, appt, time.Now()))
+		assert.Equal(t, 2, countByStatus(appt.Id, "pending"))
+
+		clash := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Title:              "Clash",
+			Date:               appt.Date,
+			ContactInformation: appt.ContactInformation,
+			StartTime:          appt.StartTime,
+			EndTime:            appt.EndTime,
+		}
+		require.Error(t, db.BookAppointment(ctx, clash, time.Now()))
+		assert.Equal(t, 0, countByStatus(clash.Id, "pending"))
+
+		moved := time.Now().Add(90 * time.Minute).Truncate(time.Minute)
+		_, err := db.RescheduleAppointment(ctx, appt.Id, moved, moved.Add(30*time.Minute), "", time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, countByStatus(appt.Id, "pending"))
+		assert.Equal(t, 1, countByStatus(appt.Id, "cancelled"))
+
+		_, err = db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 0, countByStatus(appt.Id, "pending"))
+
+		_, err = db.RestoreAppointment(ctx, appt.Id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, countByStatus(appt.Id, "pending"))
+	})
+}
@@ -0,0 +1,180 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const rescheduleColumns = `id, appointment_id, previous_start_time, previous_end_time, start_time, end_time, actor, reason, created_at`
+
+func scanReschedule(row pgx.Row) (*pb.Reschedule, error) {
+	var r pb.Reschedule
+	var previousStart, previousEnd, start, end, createdAt time.Time
+
+	err := row.Scan(&r.Id, &r.AppointmentId, &previousStart, &previousEnd, &start, &end, &r.Actor, &r.Reason, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	r.PreviousStartTime = timestamppb.New(previousStart)
+	r.PreviousEndTime = timestamppb.New(previousEnd)
+	r.StartTime = timestamppb.New(start)
+	r.EndTime = timestamppb.New(end)
+	r.CreatedAt = timestamppb.New(createdAt)
+
+	return &r, nil
+}
+
+// RescheduleAppointment moves the active appointment id to [start, end) if
+// the booking policy allows it at now, keeping its id, buffers and
+// participants, and records the move with reason. Its date moves with it.
+// If the new time is taken it returns an *AppointmentConflictError, or a
+// *ParticipantConflictError for a busy required participant, and leaves the
+// appointment as it was. The slot it leaves is offered to the waitlist.
+func (db *Database) RescheduleAppointment(ctx context.Context, id string, start, end time.Time, reason string, now time.Time) (*pb.Appointment, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := lockActiveAppointment(ctx, tx, "id = $1", id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAppointmentNotFound
+		}
+		return nil, err
+	}
+	if err := loadParticipants(ctx, tx, []*pb.Appointment{before.Appointment}); err != nil {
+		return nil, err
+	}
+
+	after, err := db.moveAppointment(ctx, tx, before.Appointment, start, end, reason, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordAppointmentChange(ctx, tx, EventAppointmentRescheduled, before.Appointment, after.Appointment); err != nil {
+		return nil, err
+	}
+
+	return after.Appointment, tx.Commit(ctx)
+}
+
+// moveAppointment does the work of RescheduleAppointment within tx for
+// before, which must be locked and have its participants loaded, and returns
+// the appointment as moved. Recording the change is left to the caller. On
+// an overlap it rolls tx back to find the appointment in the way.
+func (db *Database) moveAppointment(ctx context.Context, tx pgx.Tx, before *pb.Appointment, start, end time.Time, reason string, now time.Time) (*CalendarEntry, error) {
+	moved := &pb.Appointment{
+		Id:           before.Id,
+		UserId:       before.UserId,
+		StartTime:    timestamppb.New(start),
+		EndTime:      timestamppb.New(end),
+		TimeZone:     before.TimeZone,
+		BufferBefore: before.BufferBefore,
+		BufferAfter:  before.BufferAfter,
+		Participants: before.Participants,
+	}
+
+	busy := busyParticipants(moved)
+	if err := lockUsers(ctx, tx, busy); err != nil {
+		return nil, err
+	}
+
+	if err := checkBookingPolicy(ctx, tx, moved, now); err != nil {
+		return nil, err
+	}
+
+	if err := participantConflict(ctx, tx, busy, moved); err != nil {
+		return nil, err
+	}
+
+	if err := releaseExpiredHolds(ctx, tx, moved); err != nil {
+		return nil, err
+	}
+
+	query := `
+	UPDATE appointments
+	SET start_time = $2, end_time = $3, date = date + ($2 - start_time), updated_at = NOW()
+	WHERE id = $1
+	RETURNING ` + calendarEntryColumns
+
+	after, err := scanCalendarEntry(tx.QueryRow(ctx, query, before.Id, start, end))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23P01" {
+			tx.Rollback(ctx)
+			return nil, db.appointmentConflict(ctx, moved)
+		}
+		return nil, err
+	}
+	after.Appointment.Participants = before.Participants
+
+	if err := checkSlotOffers(ctx, tx, after.Appointment); err != nil {
+		return nil, err
+	}
+
+	if err := offerFreedSlot(ctx, tx, before.StartTime.AsTime(), before.EndTime.AsTime()); err != nil {
+		return nil, err
+	}
+
+	if err := scheduleReminders(ctx, tx, before.Id, start, db.ReminderOffsets); err != nil {
+		return nil, err
+	}
+
+	insert := `
+	INSERT INTO appointment_reschedules (id, appointment_id, previous_start_time, previous_end_time, start_time, end_time, actor, reason)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err = tx.Exec(ctx, insert, uuid.NewString(), before.Id, before.StartTime.AsTime(), before.EndTime.AsTime(),
+		start, end, AuditFromContext(ctx).Actor, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return after, nil
+}
+
+// ListReschedules returns how the appointment id, active or deleted, has
+// been moved, oldest first.
+func (db *Database) ListReschedules(ctx context.Context, id string) ([]*pb.Reschedule, error) {
+	var exists bool
+	if err := db.Pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM appointments WHERE id = $1 AND hold_expires_at IS NULL)`, id).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrAppointmentNotFound
+	}
+
+	query := `
+	SELECT ` + rescheduleColumns + `
+	FROM appointment_reschedules
+	WHERE appointment_id = $1
+	ORDER BY created_at, id`
+
+	rows, err := db.Pool.Query(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reschedules []*pb.Reschedule
+	for rows.Next() {
+		r, err := scanReschedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		reschedules = append(reschedules, r)
+	}
+
+	return reschedules, rows.Err()
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestReschedule(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	newUser := func(name string) *pb.User {
+		user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: name, Email: strings.ToLower(name) + "@example.com"})
+		require.NoError(t, err)
+		return user
+	}
+	ann, bob := newUser("Ann"), newUser("Bob")
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	newAppointment := func(user *pb.User, start time.Time) *pb.Appointment {
+		appt := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			Title:              "Consultation",
+			UserId:             user.Id,
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: user.Name, Email: user.Email},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+		}
+		require.NoError(t, db.BookAppointment(ctx, appt, time.Now()))
+		return appt
+	}
+	appt := newAppointment(ann, start)
+	newAppointment(bob, start.Add(4*time.Hour))
+
+	t.Run("moves the appointment under the same id", func(t *testing.T) {
+		moved, err := db.RescheduleAppointment(ctx, appt.Id, start.Add(2*time.Hour), start.Add(3*time.Hour), "running late", time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, appt.Id, moved.Id)
+		assert.Equal(t, "Consultation", moved.Title)
+		assert.True(t, moved.StartTime.AsTime().Equal(start.Add(2*time.Hour)))
+		assert.True(t, moved.Date.AsTime().Equal(start.Add(2*time.Hour)))
+
+		// The slot it left is free again.
+		newAppointment(bob, start)
+	})
+
+	t.Run("a taken slot leaves the appointment where it was", func(t *testing.T) {
+		_, err := db.RescheduleAppointment(ctx, appt.Id, start.Add(4*time.Hour), start.Add(5*time.Hour), "", time.Now())
+		var conflict *AppointmentConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.NotEqual(t, appt.Id, conflict.Blocking.Id)
+
+		stored, err := db.GetAppointment(ctx, appt.Id)
+		require.NoError(t, err)
+		assert.True(t, stored.StartTime.AsTime().Equal(start.Add(2*time.Hour)))
+	})
+
+	t.Run("records each move", func(t *testing.T) {
+		reschedules, err := db.ListReschedules(ctx, appt.Id)
+		require.NoError(t, err)
+		require.Len(t, reschedules, 1)
+		assert.True(t, reschedules[0].PreviousStartTime.AsTime().Equal(start))
+		assert.True(t, reschedules[0].StartTime.AsTime().Equal(start.Add(2*time.Hour)))
+		assert.Equal(t, "system", reschedules[0].Actor)
+		assert.Equal(t, "running late", reschedules[0].Reason)
+
+		_, err = db.ListReschedules(ctx, uuid.NewString())
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+	})
+
+	t.Run("unknown appointments cannot be moved", func(t *testing.T) {
+		_, err := db.RescheduleAppointment(ctx, uuid.NewString(), start, start.Add(time.Hour), "", time.Now())
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+	})
+}
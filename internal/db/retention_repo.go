@@ -0,0 +1,179 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrInvalidLegalHold = errors.New("legal holds apply to a user or an appointment")
+
+// purgeableCondition matches deleted appointments past the cutoff in $1,
+// joined to their user as u, that no legal hold keeps.
+const purgeableCondition = `a.deleted_at < $1 AND NOT a.legal_hold AND NOT u.legal_hold`
+
+// CountPurgeableAppointments counts the appointments deleted before cutoff
+// that a purge would remove, and those it would keep for a legal hold.
+func (db *Database) CountPurgeableAppointments(ctx context.Context, cutoff time.Time) (int, int, error) {
+	query := `
+	SELECT
+		COUNT(*) FILTER (WHERE NOT a.legal_hold AND NOT u.legal_hold),
+		COUNT(*) FILTER (WHERE a.legal_hold OR u.legal_hold)
+	FROM appointments a
+	JOIN users u ON u.id = a.user_id
+	WHERE a.deleted_at < $1`
+
+	var purgeable, held int
+	if err := db.Pool.QueryRow(ctx, query, cutoff).Scan(&purgeable, &held); err != nil {
+		return 0, 0, err
+	}
+
+	return purgeable, held, nil
+}
+
+// PurgeDeletedAppointments permanently removes up to limit appointments
+// deleted before cutoff, along with their reminders, and returns how many it
+// removed. With archive set each appointment is first copied into
+// archived_appointments. Rows another purge is working on are skipped, so
+// several can run at once.
+func (db *Database) PurgeDeletedAppointments(ctx context.Context, cutoff time.Time, archive bool, limit int) (int, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	claim := `
+	SELECT a.id FROM appointments a
+	JOIN users u ON u.id = a.user_id
+	WHERE ` + purgeableCondition + `
+	ORDER BY a.deleted_at
+	LIMIT $2
+	FOR UPDATE OF a SKIP LOCKED`
+
+	rows, err := tx.Query(ctx, claim, cutoff, limit)
+	if err != nil {
+		return 0, err
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return 0, fmt.Errorf("error scanning row: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	action := "appointment.purged"
+	if archive {
+		action = "appointment.archived"
+
+		query := `
+		INSERT INTO archived_appointments (id, user_id, deleted_at, appointment)
+		SELECT id, user_id, deleted_at, to_jsonb(a) - 'search_vector'
+		FROM appointments a
+		WHERE id = ANY($1)
+		ON CONFLICT (id) DO NOTHING`
+
+		if _, err := tx.Exec(ctx, query, ids); err != nil {
+			return 0, fmt.Errorf("failed to archive appointments: %w", err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM reminders WHERE appointment_id = ANY($1)`, ids); err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM appointments WHERE id = ANY($1)`, ids); err != nil {
+		return 0, err
+	}
+
+	for _, id := range ids {
+		if err := appendAuditEvent(ctx, tx, "appointment", id, action, nil, nil); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(ids), tx.Commit(ctx)
+}
+
+// SetLegalHold places or releases a legal hold on a user or an appointment.
+func (db *Database) SetLegalHold(ctx context.Context, entityType, id string, hold bool) error {
+	var query string
+	var notFound error
+	switch entityType {
+	case "user":
+		query, notFound = `UPDATE users SET legal_hold = $2 WHERE id = $1 AND legal_hold <> $2`, ErrUserNotFound
+	case "appointment":
+		query, notFound = `UPDATE appointments SET legal_hold = $2 WHERE id = $1 AND legal_hold <> $2`, ErrAppointmentNotFound
+	default:
+		return ErrInvalidLegalHold
+	}
+
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, query, id, hold)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		// Either there is nothing to change or there is no such entity.
+		var exists bool
+		existsQuery := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %ss WHERE id = $1)`, entityType)
+		if err := tx.QueryRow(ctx, existsQuery, id).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return notFound
+		}
+		return nil
+	}
+
+	action := "legal_hold.released"
+	if hold {
+		action = "legal_hold.placed"
+	}
+	if err := appendAuditEvent(ctx, tx, entityType, id, action, nil, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListLegalHolds lists the users and appointments currently under a legal
+// hold.
+func (db *Database) ListLegalHolds(ctx context.Context) ([]*pb.LegalHold, error) {
+	query := `
+	SELECT 'user', id::text FROM users WHERE legal_hold
+	UNION ALL
+	SELECT 'appointment', id::text FROM appointments WHERE legal_hold
+	ORDER BY 1 DESC, 2`
+
+	rows, err := db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*pb.LegalHold
+	for rows.Next() {
+		hold := pb.LegalHold{Hold: true}
+		if err := rows.Scan(&hold.EntityType, &hold.EntityId); err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		result = append(result, &hold)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
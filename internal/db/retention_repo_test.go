@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRetention(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	newUser := func(email string) *pb.User {
+		user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Test user", Email: email})
+		require.NoError(t, err)
+		return user
+	}
+
+	start := time.Now().Add(24 * time.Hour)
+	newDeletedAppointment := func(user *pb.User) string {
+		appt := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Title:              "Test title",
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: "Test", Email: "test@example.com"},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+		}
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		require.NoError(t, db.ScheduleReminders(ctx, appt.Id, start, []time.Duration{time.Hour}))
+		_, err := db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
+		require.NoError(t, err)
+		start = start.Add(2 * time.Hour)
+		return appt.Id
+	}
+
+	user := newUser("test@user.com")
+	heldUser := newUser("held@user.com")
+
+	purged := newDeletedAppointment(user)
+	heldAppt := newDeletedAppointment(user)
+	heldByUser := newDeletedAppointment(heldUser)
+
+	require.NoError(t, db.SetLegalHold(ctx, "appointment", heldAppt, true))
+	require.NoError(t, db.SetLegalHold(ctx, "user", heldUser.Id, true))
+
+	cutoff := time.Now().Add(time.Minute)
+
+	t.Run("counts what a purge would remove", func(t *testing.T) {
+		purgeable, held, err := db.CountPurgeableAppointments(ctx, cutoff)
+		require.NoError(t, err)
+		assert.Equal(t, 1, purgeable)
+		assert.Equal(t, 2, held)
+
+		purgeable, held, err = db.CountPurgeableAppointments(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, purgeable)
+		assert.Zero(t, held)
+	})
+
+	t.Run("archives purged appointments and keeps held ones", func(t *testing.T) {
+		n, err := db.PurgeDeletedAppointments(ctx, cutoff, true, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		var archived string
+		err = db.Pool.QueryRow(ctx, `SELECT appointment->>'title' FROM archived_appointments WHERE id = $1`, purged).Scan(&archived)
+		require.NoError(t, err)
+		assert.Equal(t, "Test title", archived)
+
+		deleted, _, err := db.ListDeletedAppointments(ctx, user.Id, 0, "")
+		require.NoError(t, err)
+		require.Len(t, deleted, 1)
+		assert.Equal(t, heldAppt, deleted[0].Id)
+
+		events, _, err := db.ListAuditEvents(ctx, AuditFilter{EntityId: purged})
+		require.NoError(t, err)
+		assert.Equal(t, "appointment.archived", events[0].Action)
+	})
+
+	t.Run("released holds no longer keep appointments", func(t *testing.T) {
+		holds, err := db.ListLegalHolds(ctx)
+		require.NoError(t, err)
+		assert.Len(t, holds, 2)
+
+		require.NoError(t, db.SetLegalHold(ctx, "user", heldUser.Id, false))
+
+		n, err := db.PurgeDeletedAppointments(ctx, cutoff, false, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		_, err = db.RestoreAppointment(ctx, heldByUser)
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+	})
+
+	t.Run("rejects unknown entities", func(t *testing.T) {
+		assert.ErrorIs(t, db.SetLegalHold(ctx, "user", uuid.NewString(), true), ErrUserNotFound)
+		assert.ErrorIs(t, db.SetLegalHold(ctx, "appointment", uuid.NewString(), true), ErrAppointmentNotFound)
+		assert.ErrorIs(t, db.SetLegalHold(ctx, "webhook", uuid.NewString(), true), ErrInvalidLegalHold)
+	})
+}
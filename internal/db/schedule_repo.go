@@ -0,0 +1,191 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/policy"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrInvalidUpdateMask    = errors.New("invalid update mask")
+	ErrInvalidBookingPolicy = errors.New("max_advance must be at least min_notice and max_duration at least min_duration")
+)
+
+// The schedule is a single row holding the settings of the one calendar that
+// every appointment is booked into.
+
+const scheduleColumns = `time_zone, updated_at, min_notice_seconds, max_advance_seconds,
+	min_duration_seconds, max_duration_seconds, slot_alignment_seconds, max_per_day, max_per_week,
+	buffer_before_seconds, buffer_after_seconds, waitlist_offer_seconds, hold_seconds,
+	cancellation_cutoff_seconds, late_cancellation_seconds`
+
+type scheduleColumn struct {
+	name  string
+	value any
+}
+
+// scheduleFields maps the update mask paths of a Schedule to the columns they
+// set.
+var scheduleFields = map[string]func(s *pb.Schedule) []scheduleColumn{
+	"time_zone": func(s *pb.Schedule) []scheduleColumn {
+		return []scheduleColumn{{"time_zone", s.TimeZone}}
+	},
+	"buffer_before": func(s *pb.Schedule) []scheduleColumn {
+		return []scheduleColumn{{"buffer_before_seconds", seconds(s.BufferBefore.AsDuration())}}
+	},
+	"buffer_after": func(s *pb.Schedule) []scheduleColumn {
+		return []scheduleColumn{{"buffer_after_seconds", seconds(s.BufferAfter.AsDuration())}}
+	},
+	"waitlist_offer_ttl": func(s *pb.Schedule) []scheduleColumn {
+		return []scheduleColumn{{"waitlist_offer_seconds", seconds(s.WaitlistOfferTtl.AsDuration())}}
+	},
+	"hold_ttl": func(s *pb.Schedule) []scheduleColumn {
+		return []scheduleColumn{{"hold_seconds", seconds(s.HoldTtl.AsDuration())}}
+	},
+	"cancellation_cutoff": func(s *pb.Schedule) []scheduleColumn {
+		return []scheduleColumn{{"cancellation_cutoff_seconds", seconds(s.CancellationCutoff.AsDuration())}}
+	},
+	"late_cancellation_window": func(s *pb.Schedule) []scheduleColumn {
+		return []scheduleColumn{{"late_cancellation_seconds", seconds(s.LateCancellationWindow.AsDuration())}}
+	},
+}
+
+// policyFields are the rules of a BookingPolicy, which can be updated
+// together with the "policy" path or one at a time with "policy.<field>".
+var policyFields = []struct {
+	path   string
+	column string
+	value  func(p policy.Policy) any
+}{
+	{"min_notice", "min_notice_seconds", func(p policy.Policy) any { return seconds(p.MinNotice) }},
+	{"max_advance", "max_advance_seconds", func(p policy.Policy) any { return seconds(p.MaxAdvance) }},
+	{"min_duration", "min_duration_seconds", func(p policy.Policy) any { return seconds(p.MinDuration) }},
+	{"max_duration", "max_duration_seconds", func(p policy.Policy) any { return seconds(p.MaxDuration) }},
+	{"slot_alignment", "slot_alignment_seconds", func(p policy.Policy) any { return seconds(p.SlotAlignment) }},
+	{"max_per_day", "max_per_day", func(p policy.Policy) any { return p.MaxPerDay }},
+	{"max_per_week", "max_per_week", func(p policy.Policy) any { return p.MaxPerWeek }},
+}
+
+func init() {
+	scheduleFields["policy"] = func(s *pb.Schedule) []scheduleColumn {
+		p := policy.FromProto(s.Policy)
+		columns := make([]scheduleColumn, len(policyFields))
+		for i, field := range policyFields {
+			columns[i] = scheduleColumn{field.column, field.value(p)}
+		}
+		return columns
+	}
+
+	for _, field := range policyFields {
+		scheduleFields["policy."+field.path] = func(s *pb.Schedule) []scheduleColumn {
+			return []scheduleColumn{{field.column, field.value(policy.FromProto(s.Policy))}}
+		}
+	}
+}
+
+func seconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
+
+func (db *Database) GetSchedule(ctx context.Context) (*pb.Schedule, error) {
+	return getSchedule(ctx, db.Pool, "")
+}
+
+// getSchedule reads the schedule, locking it with lock ("FOR SHARE", say) if
+// given.
+func getSchedule(ctx context.Context, q querier, lock string) (*pb.Schedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM schedule ` + lock
+	return scanSchedule(q.QueryRow(ctx, query))
+}
+
+func scanSchedule(row pgx.Row) (*pb.Schedule, error) {
+	var s pb.Schedule
+	var updatedAt time.Time
+	var minNotice, maxAdvance, minDuration, maxDuration, slotAlignment int64
+	var bufferBefore, bufferAfter, waitlistOffer, hold, cancellationCutoff, lateCancellation int64
+	var p policy.Policy
+
+	err := row.Scan(
+		&s.TimeZone,
+		&updatedAt,
+		&minNotice,
+		&maxAdvance,
+		&minDuration,
+		&maxDuration,
+		&slotAlignment,
+		&p.MaxPerDay,
+		&p.MaxPerWeek,
+		&bufferBefore,
+		&bufferAfter,
+		&waitlistOffer,
+		&hold,
+		&cancellationCutoff,
+		&lateCancellation,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.MinNotice = time.Duration(minNotice) * time.Second
+	p.MaxAdvance = time.Duration(maxAdvance) * time.Second
+	p.MinDuration = time.Duration(minDuration) * time.Second
+	p.MaxDuration = time.Duration(maxDuration) * time.Second
+	p.SlotAlignment = time.Duration(slotAlignment) * time.Second
+
+	s.UpdatedAt = timestamppb.New(updatedAt)
+	s.Policy = p.Proto()
+	s.BufferBefore = durationpb.New(time.Duration(bufferBefore) * time.Second)
+	s.BufferAfter = durationpb.New(time.Duration(bufferAfter) * time.Second)
+	s.WaitlistOfferTtl = durationpb.New(time.Duration(waitlistOffer) * time.Second)
+	s.HoldTtl = durationpb.New(time.Duration(hold) * time.Second)
+	s.CancellationCutoff = durationpb.New(time.Duration(cancellationCutoff) * time.Second)
+	s.LateCancellationWindow = durationpb.New(time.Duration(lateCancellation) * time.Second)
+	return &s, nil
+}
+
+// UpdateSchedule sets the fields of the schedule named by paths to their
+// values in schedule, which should already have been validated.
+func (db *Database) UpdateSchedule(ctx context.Context, schedule *pb.Schedule, paths []string) (*pb.Schedule, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w: no fields to update", ErrInvalidUpdateMask)
+	}
+
+	var sets []string
+	var args []any
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		field, ok := scheduleFields[path]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidUpdateMask, path)
+		}
+		for _, column := range field(schedule) {
+			if seen[column.name] {
+				return nil, fmt.Errorf("%w: %q overlaps another path", ErrInvalidUpdateMask, path)
+			}
+			seen[column.name] = true
+			args = append(args, column.value)
+			sets = append(sets, fmt.Sprintf("%s = $%d", column.name, len(args)))
+		}
+	}
+
+	query := fmt.Sprintf(`UPDATE schedule SET %s, updated_at = NOW() RETURNING %s`, strings.Join(sets, ", "), scheduleColumns)
+	updated, err := scanSchedule(db.Pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23514" {
+			return nil, ErrInvalidBookingPolicy
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
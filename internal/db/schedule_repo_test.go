@@ -0,0 +1,187 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBookingPolicy(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Test user", Email: "test@user.com", TimeZone: "America/New_York"})
+	require.NoError(t, err)
+
+	// 10:00 on Monday 2 November 2026 in New York.
+	monday := time.Date(2026, 11, 2, 15, 0, 0, 0, time.UTC)
+	now := monday.Add(-24 * time.Hour)
+
+	newAppointment := func(start time.Time) *pb.Appointment {
+		return &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Title:              "Test title",
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: "Test", Email: "test@example.com"},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(30 * time.Minute)),
+		}
+	}
+
+	_, err = db.UpdateSchedule(ctx, &pb.Schedule{Policy: &pb.BookingPolicy{
+		MinNotice:     durationpb.New(time.Hour),
+		SlotAlignment: durationpb.New(15 * time.Minute),
+		MaxPerDay:     2,
+		MaxPerWeek:    3,
+	}}, []string{"policy"})
+	require.NoError(t, err)
+
+	t.Run("reports the rules a booking breaks", func(t *testing.T) {
+		err := db.BookAppointment(ctx, newAppointment(now.Add(10*time.Minute)), now)
+
+		var violation *BookingPolicyError
+		require.ErrorAs(t, err, &violation)
+		assert.ErrorIs(t, err, ErrBookingPolicy)
+		require.Len(t, violation.Violations, 2)
+		assert.Equal(t, pb.BookingPolicyRule_BOOKING_POLICY_RULE_MIN_NOTICE, violation.Violations[0].Rule)
+		assert.Equal(t, pb.BookingPolicyRule_BOOKING_POLICY_RULE_SLOT_ALIGNMENT, violation.Violations[1].Rule)
+	})
+
+	t.Run("enforces quotas in the user's zone", func(t *testing.T) {
+		require.NoError(t, db.BookAppointment(ctx, newAppointment(monday), now))
+		require.NoError(t, db.BookAppointment(ctx, newAppointment(monday.Add(time.Hour)), now))
+
+		// 20:00 in New York is still Monday there, though Tuesday in UTC.
+		var violation *BookingPolicyError
+		err := db.BookAppointment(ctx, newAppointment(monday.Add(10*time.Hour)), now)
+		require.ErrorAs(t, err, &violation)
+		assert.Equal(t, pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_PER_DAY, violation.Violations[0].Rule)
+
+		require.NoError(t, db.BookAppointment(ctx, newAppointment(monday.Add(24*time.Hour)), now))
+
+		err = db.BookAppointment(ctx, newAppointment(monday.Add(48*time.Hour)), now)
+		require.ErrorAs(t, err, &violation)
+		assert.Equal(t, pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_PER_WEEK, violation.Violations[0].Rule)
+
+		require.NoError(t, db.BookAppointment(ctx, newAppointment(monday.Add(7*24*time.Hour)), now))
+	})
+
+	t.Run("concurrent bookings cannot exceed a quota", func(t *testing.T) {
+		other := newTestUser(t, db, "Other")
+
+		friday := monday.Add(4 * 24 * time.Hour)
+		errs := make(chan error, 4)
+		for i := range 4 {
+			go func() {
+				appt := newAppointment(friday.Add(time.Duration(i) * time.Hour))
+				appt.UserId = other.Id
+				errs <- db.BookAppointment(ctx, appt, now)
+			}()
+		}
+
+		booked := 0
+		for range 4 {
+			if err := <-errs; err == nil {
+				booked++
+			} else {
+				assert.ErrorIs(t, err, ErrBookingPolicy)
+			}
+		}
+		assert.Equal(t, 2, booked)
+	})
+
+	t.Run("updates single rules and checks they agree", func(t *testing.T) {
+		schedule, err := db.UpdateSchedule(ctx, &pb.Schedule{Policy: &pb.BookingPolicy{MaxDuration: durationpb.New(2 * time.Hour)}}, []string{"policy.max_duration"})
+		require.NoError(t, err)
+		assert.Equal(t, time.Hour, schedule.Policy.MinNotice.AsDuration())
+		assert.Equal(t, 2*time.Hour, schedule.Policy.MaxDuration.AsDuration())
+
+		_, err = db.UpdateSchedule(ctx, &pb.Schedule{Policy: &pb.BookingPolicy{MinDuration: durationpb.New(3 * time.Hour)}}, []string{"policy.min_duration"})
+		assert.ErrorIs(t, err, ErrInvalidBookingPolicy)
+
+		_, err = db.UpdateSchedule(ctx, &pb.Schedule{}, []string{"policy", "policy.max_per_day"})
+		assert.ErrorIs(t, err, ErrInvalidUpdateMask)
+	})
+}
+
+func TestBuffers(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Test user", Email: "test@user.com"})
+	require.NoError(t, err)
+
+	_, err = db.UpdateSchedule(ctx, &pb.Schedule{
+		BufferBefore: durationpb.New(15 * time.Minute),
+		BufferAfter:  durationpb.New(10 * time.Minute),
+	}, []string{"buffer_before", "buffer_after"})
+	require.NoError(t, err)
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	newAppointment := func(start time.Time) *pb.Appointment {
+		return &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             user.Id,
+			Title:              "Test title",
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: "Test", Email: "test@example.com"},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+		}
+	}
+
+	first := newAppointment(start)
+	require.NoError(t, db.CreateAppointment(ctx, first))
+
+	t.Run("appointments take the schedule's buffers outside their times", func(t *testing.T) {
+		appt, err := db.GetAppointment(ctx, first.Id)
+		require.NoError(t, err)
+		assert.Equal(t, start.UTC(), appt.StartTime.AsTime())
+		assert.Equal(t, start.Add(time.Hour).UTC(), appt.EndTime.AsTime())
+		assert.Equal(t, 15*time.Minute, appt.BufferBefore.AsDuration())
+		assert.Equal(t, 10*time.Minute, appt.BufferAfter.AsDuration())
+	})
+
+	t.Run("buffers cannot overlap other appointments or their buffers", func(t *testing.T) {
+		err := db.CreateAppointment(ctx, newAppointment(start.Add(time.Hour)))
+		assert.ErrorIs(t, err, ErrAppointmentConflict)
+
+		err = db.CreateAppointment(ctx, newAppointment(start.Add(80*time.Minute)))
+		assert.ErrorIs(t, err, ErrAppointmentConflict)
+
+		require.NoError(t, db.CreateAppointment(ctx, newAppointment(start.Add(85*time.Minute))))
+	})
+
+	t.Run("explicit buffers override the schedule's", func(t *testing.T) {
+		// Ending 20 minutes before the first appointment, the schedule's
+		// buffers would overlap.
+		appt := newAppointment(start.Add(-80 * time.Minute))
+		appt.BufferBefore = durationpb.New(0)
+		appt.BufferAfter = durationpb.New(0)
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		assert.Zero(t, appt.BufferAfter.AsDuration())
+	})
+
+	t.Run("restores report the appointment whose buffer is in the way", func(t *testing.T) {
+		late := newAppointment(start.Add(4 * time.Hour))
+		require.NoError(t, db.CreateAppointment(ctx, late))
+		_, err := db.DeleteAppointment(ctx, late.Id, nil, time.Now())
+		require.NoError(t, err)
+
+		blocking := newAppointment(start.Add(5*time.Hour + 5*time.Minute))
+		require.NoError(t, db.CreateAppointment(ctx, blocking))
+
+		_, err = db.RestoreAppointment(ctx, late.Id)
+		var conflict *AppointmentConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, blocking.Id, conflict.Blocking.Id)
+	})
+}
@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+)
+
+// prefixQuery turns free text into a to_tsquery expression that matches rows
+// containing every word, each as a prefix. Only letters and digits survive,
+// so user input can never inject tsquery operators.
+func prefixQuery(input string) string {
+	words := strings.FieldsFunc(input, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		terms = append(terms, strings.ToLower(w)+":*")
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+// SearchAppointments ranks the active appointments a user organizes or has
+// been invited to against query and returns highlighted title and
+// description snippets for each match.
+func (db *Database) SearchAppointments(ctx context.Context, userId, query string, pageSize int, pageToken string) ([]*pb.AppointmentSearchResult, string, error) {
+	tsquery := prefixQuery(query)
+	if tsquery == "" {
+		return nil, "", nil
+	}
+
+	scope := pageTokenScope(userId, tsquery)
+	offset := 0
+	if pageToken != "" {
+		var err error
+		offset, err = decodeOffsetToken(pageToken, scope)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// Source text is HTML-escaped before highlighting so the <mark> tags are
+	// the only markup in a snippet.
+	sql := fmt.Sprintf(`
+	WITH q AS (SELECT to_tsquery('english', $2) AS query)
+	SELECT %s,
+		ts_rank(search_vector, q.query) AS rank,
+		ts_headline('english', %s, q.query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true'),
+		ts_headline('english', %s, q.query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2')
+	FROM appointments, q
+	WHERE %s AND deleted_at IS NULL AND hold_expires_at IS NULL AND search_vector @@ q.query
+	ORDER BY rank DESC, start_time DESC, id
+	LIMIT %d OFFSET %d`, calendarEntryColumns, escapeHTMLSQL("coalesce(title, '')"), escapeHTMLSQL("coalesce(description, '')"),
+		visibleToUser, pageSize+1, offset)
+
+	rows, err := db.Pool.Query(ctx, sql, userId, tsquery)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var result []*pb.AppointmentSearchResult
+	for rows.Next() {
+		var r pb.AppointmentSearchResult
+		entry, err := scanCalendarEntry(extraColumnsRow{Row: rows, extra: []any{&r.Rank, &r.TitleSnippet, &r.DescriptionSnippet}})
+		if err != nil {
+			return nil, "", fmt.Errorf("error scanning row: %w", err)
+		}
+
+		r.Appointment = entry.Appointment
+		result = append(result, &r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(result) > pageSize {
+		result = result[:pageSize]
+		nextPageToken = encodeOffsetToken(offset+pageSize, scope)
+	}
+
+	appts := make([]*pb.Appointment, 0, len(result))
+	for _, r := range result {
+		appts = append(appts, r.Appointment)
+	}
+	if err := loadParticipants(ctx, db.Pool, appts); err != nil {
+		return nil, "", err
+	}
+
+	return result, nextPageToken, nil
+}
+
+func escapeHTMLSQL(expr string) string {
+	return fmt.Sprintf(`replace(replace(replace(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;')`, expr)
+}
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPrefixQuery(t *testing.T) {
+	t.Run("prefix matches every word", func(t *testing.T) {
+		assert.Equal(t, "dentist:* & ada:*", prefixQuery("Dentist  Ada"))
+	})
+
+	t.Run("splits emails into searchable parts", func(t *testing.T) {
+		assert.Equal(t, "ada:* & example:* & com:*", prefixQuery("ada@example.com"))
+	})
+
+	t.Run("drops tsquery operators", func(t *testing.T) {
+		assert.Equal(t, "a:* & b:*", prefixQuery("a & !b | (:*)"))
+		assert.Equal(t, "", prefixQuery("&|!()"))
+	})
+}
+
+func TestSearchAppointments(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	user, err := db.CreateUser(ctx, &pb.User{
+		Id:    uuid.NewString(),
+		Name:  "Test user",
+		Email: "test@user.com",
+	})
+	require.NoError(t, err)
+
+	start := time.Now().Add(24 * time.Hour)
+	appt := &pb.Appointment{
+		Id:          uuid.NewString(),
+		UserId:      user.Id,
+		Title:       "Dentist checkup",
+		Description: "Bring <x-ray> results",
+		Date:        timestamppb.New(start),
+		ContactInformation: &pb.ContactInformation{
+			Name:  "Ada Lovelace",
+			Email: "ada@example.com",
+		},
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.Add(time.Hour)),
+	}
+	require.NoError(t, db.CreateAppointment(ctx, appt))
+
+	t.Run("matches word prefixes across fields", func(t *testing.T) {
+		results, _, err := db.SearchAppointments(ctx, user.Id, "dent ada", 10, "")
+		assert.NoError(t, err)
+		if assert.Len(t, results, 1) {
+			assert.Equal(t, appt.Id, results[0].Appointment.Id)
+			assert.Contains(t, results[0].TitleSnippet, "<mark>Dentist</mark>")
+		}
+	})
+
+	t.Run("escapes HTML in snippets", func(t *testing.T) {
+		results, _, err := db.SearchAppointments(ctx, user.Id, "results", 10, "")
+		assert.NoError(t, err)
+		if assert.Len(t, results, 1) {
+			assert.NotContains(t, results[0].DescriptionSnippet, "<x-ray>")
+		}
+	})
+
+	t.Run("finds appointments the user is invited to", func(t *testing.T) {
+		guest, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Guest", Email: "guest@user.com"})
+		require.NoError(t, err)
+
+		_, err = db.Pool.Exec(ctx, `INSERT INTO appointment_participants (appointment_id, user_id, role) VALUES ($1, $2, 'required')`, appt.Id, guest.Id)
+		require.NoError(t, err)
+
+		results, _, err := db.SearchAppointments(ctx, guest.Id, "dentist", 10, "")
+		assert.NoError(t, err)
+		if assert.Len(t, results, 1) {
+			assert.Equal(t, appt.Id, results[0].Appointment.Id)
+			assert.Len(t, results[0].Appointment.Participants, 2)
+		}
+	})
+
+	t.Run("excludes deleted appointments", func(t *testing.T) {
+		_, err := db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
+		require.NoError(t, err)
+
+		results, _, err := db.SearchAppointments(ctx, user.Id, "dentist", 10, "")
+		assert.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
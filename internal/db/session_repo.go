@@ -0,0 +1,295 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrNotGroupSession  = errors.New("appointment is not a group session")
+	ErrSessionStarted   = errors.New("group session has already started")
+	ErrSessionFull      = errors.New("group session is full")
+	ErrAlreadyAttending = errors.New("already attending this group session")
+	ErrNotAttending     = errors.New("not attending this group session")
+)
+
+// A group session is an appointment with a capacity. Its host books it like
+// any other appointment, so it holds its slot against private appointments,
+// while attendees take seats in session_attendees without a slot of their
+// own.
+
+const sessionAttendeeCount = `(SELECT COUNT(*) FROM session_attendees sa WHERE sa.appointment_id = appointments.id)`
+
+// extraColumnsRow scans the columns after those a scan function such as
+// scanCalendarEntry reads into extra.
+type extraColumnsRow struct {
+	pgx.Row
+	extra []any
+}
+
+func (r extraColumnsRow) Scan(dest ...any) error {
+	return r.Row.Scan(append(dest, r.extra...)...)
+}
+
+func scanGroupSession(row pgx.Row) (*pb.GroupSession, error) {
+	var attendees int32
+	entry, err := scanCalendarEntry(extraColumnsRow{Row: row, extra: []any{&attendees}})
+	if err != nil {
+		return nil, err
+	}
+	return newGroupSession(entry.Appointment, attendees), nil
+}
+
+func newGroupSession(appt *pb.Appointment, attendees int32) *pb.GroupSession {
+	return &pb.GroupSession{
+		Appointment:    appt,
+		AttendeeCount:  attendees,
+		SeatsRemaining: max(appt.Capacity-attendees, 0),
+	}
+}
+
+// lockGroupSession locks the active group session id so seats can be taken
+// or given up one at a time, returning it with its attendee count.
+func lockGroupSession(ctx context.Context, tx pgx.Tx, id string) (*pb.Appointment, int32, error) {
+	entry, err := lockActiveAppointment(ctx, tx, "id = $1", id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, ErrAppointmentNotFound
+		}
+		return nil, 0, err
+	}
+	if entry.Appointment.Capacity == 0 {
+		return nil, 0, ErrNotGroupSession
+	}
+
+	var attendees int32
+	err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM session_attendees WHERE appointment_id = $1`, id).Scan(&attendees)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entry.Appointment, attendees, nil
+}
+
+// JoinGroupSession gives userID a seat in the group session id if one is
+// left and the session has not started by now. The session row is locked
+// while seats are counted, so concurrent joins cannot overfill it.
+func (db *Database) JoinGroupSession(ctx context.Context, id, userID string, now time.Time) (*pb.GroupSession, *pb.SessionAttendee, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	appt, attendees, err := lockGroupSession(ctx, tx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !appt.StartTime.AsTime().After(now) {
+		return nil, nil, ErrSessionStarted
+	}
+	if attendees >= appt.Capacity {
+		return nil, nil, ErrSessionFull
+	}
+
+	query := `
+	WITH joined AS (
+		INSERT INTO session_attendees (appointment_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+		RETURNING user_id, joined_at
+	)
+	SELECT joined.user_id, u.name, COALESCE(u.email, ''), joined.joined_at
+	FROM joined JOIN users u ON u.id = joined.user_id`
+
+	var attendee pb.SessionAttendee
+	var joinedAt time.Time
+	err = tx.QueryRow(ctx, query, id, userID).Scan(&attendee.UserId, &attendee.Name, &attendee.Email, &joinedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrAlreadyAttending
+		}
+		return nil, nil, err
+	}
+	attendee.JoinedAt = timestamppb.New(joinedAt)
+
+	// The snapshot leaves out contact details, which live with the user. It is
+	// kept apart from the appointment's own snapshots, which
+	// GetAppointmentAsOf reads back, under the session's id.
+	snapshot := &pb.SessionAttendee{UserId: attendee.UserId, JoinedAt: attendee.JoinedAt}
+	if err := appendAuditEvent(ctx, tx, "session_attendee", id, "session_attendee.joined", nil, snapshot); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return newGroupSession(appt, attendees+1), &attendee, nil
+}
+
+// LeaveGroupSession gives up userID's seat in the group session id.
+func (db *Database) LeaveGroupSession(ctx context.Context, id, userID string) (*pb.GroupSession, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	appt, attendees, err := lockGroupSession(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var joinedAt time.Time
+	query := `DELETE FROM session_attendees WHERE appointment_id = $1 AND user_id = $2 RETURNING joined_at`
+	if err := tx.QueryRow(ctx, query, id, userID).Scan(&joinedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotAttending
+		}
+		return nil, err
+	}
+
+	snapshot := &pb.SessionAttendee{UserId: userID, JoinedAt: timestamppb.New(joinedAt)}
+	if err := appendAuditEvent(ctx, tx, "session_attendee", id, "session_attendee.left", snapshot, nil); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return newGroupSession(appt, attendees-1), nil
+}
+
+// GroupSessionFilter narrows ListGroupSessions to sessions starting in
+// [From, To). A zero To means no upper bound.
+type GroupSessionFilter struct {
+	From          time.Time
+	To            time.Time
+	AvailableOnly bool
+	PageSize      int
+	PageToken     string
+}
+
+// sessionPageScope scopes page tokens to filter. From is left out, as it
+// defaults to the time of each request.
+func sessionPageScope(filter GroupSessionFilter) string {
+	return pageTokenScope(filter.To, filter.AvailableOnly)
+}
+
+// ListGroupSessions pages through active group sessions, soonest first,
+// with the seats each has left.
+func (db *Database) ListGroupSessions(ctx context.Context, filter GroupSessionFilter) ([]*pb.GroupSession, string, error) {
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	conditions := "capacity IS NOT NULL AND deleted_at IS NULL AND start_time >= $1"
+	args := []any{filter.From}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		conditions += fmt.Sprintf(" AND start_time < $%d", len(args))
+	}
+	if filter.AvailableOnly {
+		conditions += " AND " + sessionAttendeeCount + " < capacity"
+	}
+	if filter.PageToken != "" {
+		start, id, err := decodePageToken(filter.PageToken, sessionPageScope(filter))
+		if err != nil {
+			return nil, "", err
+		}
+		args = append(args, start, id)
+		conditions += fmt.Sprintf(" AND (start_time, id) > ($%d, $%d)", len(args)-1, len(args))
+	}
+
+	query := fmt.Sprintf(`
+	SELECT %s, %s
+	FROM appointments
+	WHERE %s
+	ORDER BY start_time, id
+	LIMIT %d`, calendarEntryColumns, sessionAttendeeCount, conditions, pageSize+1)
+
+	rows, err := db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var sessions []*pb.GroupSession
+	for rows.Next() {
+		session, err := scanGroupSession(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("error scanning row: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(sessions) > pageSize {
+		sessions = sessions[:pageSize]
+		last := sessions[pageSize-1].Appointment
+		nextPageToken = encodePageToken(last.StartTime.AsTime(), last.Id, sessionPageScope(filter))
+	}
+
+	return sessions, nextPageToken, nil
+}
+
+// GetGroupSessionRoster returns the active group session id and its
+// attendees in the order they joined.
+func (db *Database) GetGroupSessionRoster(ctx context.Context, id string) (*pb.GroupSession, []*pb.SessionAttendee, error) {
+	query := `
+	SELECT ` + calendarEntryColumns + `, ` + sessionAttendeeCount + `
+	FROM appointments
+	WHERE id = $1 AND deleted_at IS NULL`
+
+	session, err := scanGroupSession(db.Pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrAppointmentNotFound
+		}
+		return nil, nil, err
+	}
+	if session.Appointment.Capacity == 0 {
+		return nil, nil, ErrNotGroupSession
+	}
+
+	rows, err := db.Pool.Query(ctx, `
+	SELECT sa.user_id, u.name, COALESCE(u.email, ''), sa.joined_at
+	FROM session_attendees sa JOIN users u ON u.id = sa.user_id
+	WHERE sa.appointment_id = $1
+	ORDER BY sa.joined_at, sa.user_id`, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var attendees []*pb.SessionAttendee
+	for rows.Next() {
+		var a pb.SessionAttendee
+		var joinedAt time.Time
+		if err := rows.Scan(&a.UserId, &a.Name, &a.Email, &joinedAt); err != nil {
+			return nil, nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		a.JoinedAt = timestamppb.New(joinedAt)
+		attendees = append(attendees, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return session, attendees, nil
+}
@@ -0,0 +1,168 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGroupSessions(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	host, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Host", Email: "host@example.com"})
+	require.NoError(t, err)
+
+	newAttendee := func(name string) *pb.User {
+		user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: name, Email: strings.ToLower(name) + "@example.com"})
+		require.NoError(t, err)
+		return user
+	}
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	newSession := func(start time.Time, capacity int32) *pb.Appointment {
+		appt := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			Title:              "Yoga class",
+			UserId:             host.Id,
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: "Host", Email: "host@example.com"},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+			Capacity:           capacity,
+		}
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		return appt
+	}
+
+	t.Run("concurrent joins never overfill a session", func(t *testing.T) {
+		session := newSession(start, 3)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 6)
+		for i := range errs {
+			attendee := newAttendee(fmt.Sprintf("Racer%d", i))
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, errs[i] = db.JoinGroupSession(ctx, session.Id, attendee.Id, time.Now())
+			}()
+		}
+		wg.Wait()
+
+		joined := 0
+		for _, err := range errs {
+			if err == nil {
+				joined++
+			} else {
+				assert.ErrorIs(t, err, ErrSessionFull)
+			}
+		}
+		assert.Equal(t, 3, joined)
+
+		roster, attendees, err := db.GetGroupSessionRoster(ctx, session.Id)
+		require.NoError(t, err)
+		assert.Len(t, attendees, 3)
+		assert.EqualValues(t, 3, roster.AttendeeCount)
+		assert.Zero(t, roster.SeatsRemaining)
+	})
+
+	t.Run("attendees can join once and leave", func(t *testing.T) {
+		session := newSession(start.Add(2*time.Hour), 2)
+		ada, grace := newAttendee("Ada"), newAttendee("Grace")
+
+		joined, attendee, err := db.JoinGroupSession(ctx, session.Id, ada.Id, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, "Ada", attendee.Name)
+		assert.Equal(t, "ada@example.com", attendee.Email)
+		assert.EqualValues(t, 1, joined.SeatsRemaining)
+
+		_, _, err = db.JoinGroupSession(ctx, session.Id, ada.Id, time.Now())
+		assert.ErrorIs(t, err, ErrAlreadyAttending)
+
+		_, _, err = db.JoinGroupSession(ctx, session.Id, grace.Id, time.Now())
+		require.NoError(t, err)
+
+		_, attendees, err := db.GetGroupSessionRoster(ctx, session.Id)
+		require.NoError(t, err)
+		require.Len(t, attendees, 2)
+		assert.Equal(t, ada.Id, attendees[0].UserId)
+		assert.Equal(t, grace.Id, attendees[1].UserId)
+
+		left, err := db.LeaveGroupSession(ctx, session.Id, ada.Id)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, left.AttendeeCount)
+		assert.EqualValues(t, 1, left.SeatsRemaining)
+
+		_, err = db.LeaveGroupSession(ctx, session.Id, ada.Id)
+		assert.ErrorIs(t, err, ErrNotAttending)
+	})
+
+	t.Run("only upcoming group sessions can be joined", func(t *testing.T) {
+		attendee := newAttendee("Linus")
+
+		private := newSession(start.Add(4*time.Hour), 0)
+		_, _, err := db.JoinGroupSession(ctx, private.Id, attendee.Id, time.Now())
+		assert.ErrorIs(t, err, ErrNotGroupSession)
+
+		session := newSession(start.Add(6*time.Hour), 5)
+		_, _, err = db.JoinGroupSession(ctx, session.Id, attendee.Id, start.Add(6*time.Hour))
+		assert.ErrorIs(t, err, ErrSessionStarted)
+
+		_, _, err = db.JoinGroupSession(ctx, uuid.NewString(), attendee.Id, time.Now())
+		assert.ErrorIs(t, err, ErrAppointmentNotFound)
+	})
+
+	t.Run("lists sessions with the seats they have left", func(t *testing.T) {
+		all, _, err := db.ListGroupSessions(ctx, GroupSessionFilter{From: start})
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+		assert.EqualValues(t, 0, all[0].SeatsRemaining)
+		assert.EqualValues(t, 1, all[1].SeatsRemaining)
+		assert.EqualValues(t, 5, all[2].SeatsRemaining)
+
+		available, _, err := db.ListGroupSessions(ctx, GroupSessionFilter{From: start, AvailableOnly: true})
+		require.NoError(t, err)
+		assert.Len(t, available, 2)
+
+		first, next, err := db.ListGroupSessions(ctx, GroupSessionFilter{From: start, PageSize: 2})
+		require.NoError(t, err)
+		assert.Len(t, first, 2)
+		require.NotEmpty(t, next)
+
+		rest, next, err := db.ListGroupSessions(ctx, GroupSessionFilter{From: start, PageSize: 2, PageToken: next})
+		require.NoError(t, err)
+		assert.Len(t, rest, 1)
+		assert.Empty(t, next)
+	})
+
+	t.Run("attendance is audited apart from the session", func(t *testing.T) {
+		session := newSession(start.Add(8*time.Hour), 2)
+		hedy := newAttendee("Hedy")
+
+		_, _, err := db.JoinGroupSession(ctx, session.Id, hedy.Id, time.Now())
+		require.NoError(t, err)
+		_, err = db.LeaveGroupSession(ctx, session.Id, hedy.Id)
+		require.NoError(t, err)
+
+		events, _, err := db.ListAuditEvents(ctx, AuditFilter{EntityType: "session_attendee", EntityId: session.Id})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, "session_attendee.left", events[0].Action)
+		assert.Equal(t, "session_attendee.joined", events[1].Action)
+
+		asOf, err := db.GetAppointmentAsOf(ctx, session.Id, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, session.Id, asOf.Id)
+		assert.EqualValues(t, 2, asOf.Capacity)
+	})
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func (db *Database) FindUserByEmail(ctx context.Context, email string) (*pb.User, error) {
-	query := `SELECT id, name, email FROM users WHERE email=$1`
+	query := `SELECT id, name, email, time_zone FROM users WHERE email=$1`
 
 	var user pb.User
 
@@ -18,6 +18,7 @@ func (db *Database) FindUserByEmail(ctx context.Context, email string) (*pb.User
 		&user.Id,
 		&user.Name,
 		&user.Email,
+		&user.TimeZone,
 	)
 
 	if err != nil {
@@ -30,24 +31,121 @@ func (db *Database) FindUserByEmail(ctx context.Context, email string) (*pb.User
 	return &user, nil
 }
 
+func (db *Database) GetUser(ctx context.Context, id string) (*pb.User, error) {
+	query := `SELECT id, name, COALESCE(email, ''), time_zone FROM users WHERE id=$1`
+
+	var user pb.User
+
+	err := db.Pool.QueryRow(ctx, query, id).Scan(
+		&user.Id,
+		&user.Name,
+		&user.Email,
+		&user.TimeZone,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// CreateUser inserts user, or renames the existing user with the same email,
+// recording the change in the audit log and outbox. The time zone is only
+// taken for a new user, who gets UTC without one; an existing user keeps the
+// zone they saved, which only SetUserTimeZone changes.
 func (db *Database) CreateUser(ctx context.Context, user *pb.User) (*pb.User, error) {
-	query := `INSERT INTO users (id, name, email) VALUES ($1, $2, $3) ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name RETURNING id, name, email`
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+	WITH previous AS (SELECT name, time_zone FROM users WHERE email = $3)
+	INSERT INTO users (id, name, email, time_zone) VALUES ($1, $2, $3, COALESCE(NULLIF($4, ''), 'UTC'))
+	ON CONFLICT (email) DO UPDATE SET
+		name = EXCLUDED.name,
+		time_zone = CASE users.time_zone WHEN '' THEN EXCLUDED.time_zone ELSE users.time_zone END
+	RETURNING id, name, email, time_zone, (SELECT name FROM previous), (SELECT time_zone FROM previous)`
 
 	var createdUser pb.User
+	var previousName, previousTimeZone *string
 
-	err := db.Pool.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		user.Id,
 		user.Name,
 		user.Email,
+		user.TimeZone,
 	).Scan(
 		&createdUser.Id,
 		&createdUser.Name,
 		&createdUser.Email,
+		&createdUser.TimeZone,
+		&previousName,
+		&previousTimeZone,
 	)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create and return user: %w", err)
 	}
 
+	switch {
+	case previousName == nil:
+		err = recordUserChange(ctx, tx, EventUserCreated, nil, &createdUser)
+	case *previousName != createdUser.Name || *previousTimeZone != createdUser.TimeZone:
+		before := &pb.User{Id: createdUser.Id, Name: *previousName, Email: createdUser.Email, TimeZone: *previousTimeZone}
+		err = recordUserChange(ctx, tx, EventUserUpdated, before, &createdUser)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return &createdUser, nil
 }
+
+// SetUserTimeZone changes a user's time zone, which should already have been
+// validated.
+func (db *Database) SetUserTimeZone(ctx context.Context, id, timeZone string) (*pb.User, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var before pb.User
+	lock := `SELECT id, name, COALESCE(email, ''), time_zone FROM users WHERE id = $1 FOR UPDATE`
+	err = tx.QueryRow(ctx, lock, id).Scan(&before.Id, &before.Name, &before.Email, &before.TimeZone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if before.TimeZone == timeZone {
+		return &before, nil
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE users SET time_zone = $2, updated_at = NOW() WHERE id = $1`, id, timeZone); err != nil {
+		return nil, err
+	}
+
+	after := &pb.User{Id: before.Id, Name: before.Name, Email: before.Email, TimeZone: timeZone}
+	if err := recordUserChange(ctx, tx, EventUserUpdated, &before, after); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return after, nil
+}
@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTimeZones(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	newAppointment := func(userID, timeZone string) *pb.Appointment {
+		start = start.Add(2 * time.Hour)
+		appt := &pb.Appointment{
+			Id:                 uuid.NewString(),
+			UserId:             userID,
+			Title:              "Test title",
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: "Test", Email: "test@example.com"},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+			TimeZone:           timeZone,
+		}
+		require.NoError(t, db.CreateAppointment(ctx, appt))
+		return appt
+	}
+
+	t.Run("users default to UTC and keep their zone", func(t *testing.T) {
+		user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Test user", Email: "zone@user.com"})
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", user.TimeZone)
+
+		user, err = db.SetUserTimeZone(ctx, user.Id, "Africa/Lagos")
+		require.NoError(t, err)
+		assert.Equal(t, "Africa/Lagos", user.TimeZone)
+
+		user, err = db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Renamed", Email: "zone@user.com"})
+		require.NoError(t, err)
+		assert.Equal(t, "Africa/Lagos", user.TimeZone)
+
+		user, err = db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Renamed", Email: "zone@user.com", TimeZone: "Asia/Tokyo"})
+		require.NoError(t, err)
+		assert.Equal(t, "Africa/Lagos", user.TimeZone)
+
+		_, err = db.SetUserTimeZone(ctx, uuid.NewString(), "Africa/Lagos")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("appointments take their user's zone unless given one", func(t *testing.T) {
+		user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Test user", Email: "booker@user.com", TimeZone: "Asia/Tokyo"})
+		require.NoError(t, err)
+
+		inherited := newAppointment(user.Id, "")
+		assert.Equal(t, "Asia/Tokyo", inherited.TimeZone)
+
+		explicit := newAppointment(user.Id, "Europe/London")
+
+		appt, err := db.GetAppointment(ctx, explicit.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "Europe/London", appt.TimeZone)
+	})
+
+	t.Run("deleted_at is an instant", func(t *testing.T) {
+		user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: "Test user", Email: "deleted@user.com"})
+		require.NoError(t, err)
+		appt := newAppointment(user.Id, "")
+
+		before := time.Now()
+		_, err = db.DeleteAppointment(ctx, appt.Id, nil, time.Now())
+		require.NoError(t, err)
+
+		deleted, _, err := db.ListDeletedAppointments(ctx, user.Id, 1, "")
+		require.NoError(t, err)
+		require.Len(t, deleted, 1)
+		assert.WithinDuration(t, before, deleted[0].DeletedAt.AsTime(), time.Minute)
+	})
+
+	t.Run("updates the schedule by mask", func(t *testing.T) {
+		schedule, err := db.GetSchedule(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", schedule.TimeZone)
+
+		updated, err := db.UpdateSchedule(ctx, &pb.Schedule{TimeZone: "America/Chicago"}, []string{"time_zone"})
+		require.NoError(t, err)
+		assert.Equal(t, "America/Chicago", updated.TimeZone)
+		assert.True(t, updated.UpdatedAt.AsTime().After(schedule.UpdatedAt.AsTime()))
+
+		_, err = db.UpdateSchedule(ctx, &pb.Schedule{}, []string{"name"})
+		assert.ErrorIs(t, err, ErrInvalidUpdateMask)
+		_, err = db.UpdateSchedule(ctx, &pb.Schedule{}, nil)
+		assert.ErrorIs(t, err, ErrInvalidUpdateMask)
+	})
+}
@@ -0,0 +1,611 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+	ErrWaitlistEntryClosed   = errors.New("waitlist entry is no longer waiting")
+	ErrWaitlistOfferNotFound = errors.New("waitlist offer not found")
+	ErrWaitlistOfferClosed   = errors.New("waitlist offer has already been answered")
+	ErrWaitlistOfferExpired  = errors.New("waitlist offer has expired")
+)
+
+// ErrSlotOffered is returned for a booking in a slot held for someone else
+// on the waitlist. It is also an ErrAppointmentConflict.
+var ErrSlotOffered error = slotOfferedError{}
+
+type slotOfferedError struct{}
+
+func (slotOfferedError) Error() string {
+	return "conflict: this time slot is being offered to someone on the waitlist"
+}
+
+func (slotOfferedError) Is(target error) bool {
+	return target == ErrAppointmentConflict
+}
+
+// A slot freed by cancelling or moving an appointment is offered to the
+// first waitlist entry whose window covers it. Until the offer is answered
+// or expires nobody else can book the slot; pending offers themselves never
+// overlap, by the no_overlapping_pending_offers constraint. An offer that is
+// declined, withdrawn or left to expire goes to the next entry, skipping
+// entries already offered that exact slot.
+//
+// Offers live outside the appointments table, so the exclusion constraint
+// cannot keep bookings off them. Instead offering a slot and checking a
+// booking against the offers both take lockWaitlistOffers first. Appending
+// to the audit log takes a lock of its own, so a transaction takes
+// lockWaitlistOffers before it writes any audit event, never after.
+
+var waitlistStatuses = map[pb.WaitlistStatus]string{
+	pb.WaitlistStatus_WAITLIST_STATUS_WAITING:   "waiting",
+	pb.WaitlistStatus_WAITLIST_STATUS_BOOKED:    "booked",
+	pb.WaitlistStatus_WAITLIST_STATUS_CANCELLED: "cancelled",
+}
+
+var waitlistOfferStatuses = map[pb.WaitlistOfferStatus]string{
+	pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_PENDING:   "pending",
+	pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_ACCEPTED:  "accepted",
+	pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_DECLINED:  "declined",
+	pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_EXPIRED:   "expired",
+	pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_WITHDRAWN: "withdrawn",
+}
+
+func waitlistStatusFromDB(status string) pb.WaitlistStatus {
+	for k, v := range waitlistStatuses {
+		if v == status {
+			return k
+		}
+	}
+	return pb.WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED
+}
+
+func waitlistOfferStatusFromDB(status string) pb.WaitlistOfferStatus {
+	for k, v := range waitlistOfferStatuses {
+		if v == status {
+			return k
+		}
+	}
+	return pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_UNSPECIFIED
+}
+
+const waitlistEntryColumns = `e.id, e.user_id, u.name, COALESCE(u.email, ''), u.time_zone, e.window_start, e.window_end,
+	e.title, e.description, e.status, COALESCE(e.appointment_id::text, ''), e.created_at, e.updated_at`
+
+const waitlistOfferColumns = `o.id, o.entry_id, o.start_time, o.end_time, o.status, o.expires_at, o.created_at, o.responded_at`
+
+func scanWaitlistEntry(row pgx.Row) (*pb.WaitlistEntry, error) {
+	var e pb.WaitlistEntry
+	var c pb.ContactInformation
+	var windowStart, windowEnd, createdAt, updatedAt time.Time
+	var status string
+
+	err := row.Scan(&e.Id, &e.UserId, &c.Name, &c.Email, &e.TimeZone, &windowStart, &windowEnd,
+		&e.Title, &e.Description, &status, &e.AppointmentId, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	e.ContactInformation = &c
+	e.WindowStart = timestamppb.New(windowStart)
+	e.WindowEnd = timestamppb.New(windowEnd)
+	e.Status = waitlistStatusFromDB(status)
+	e.CreatedAt = timestamppb.New(createdAt)
+	e.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &e, nil
+}
+
+func scanWaitlistOffer(row pgx.Row) (*pb.WaitlistOffer, error) {
+	var o pb.WaitlistOffer
+	var start, end, expiresAt, createdAt time.Time
+	var respondedAt *time.Time
+	var status string
+
+	if err := row.Scan(&o.Id, &o.EntryId, &start, &end, &status, &expiresAt, &createdAt, &respondedAt); err != nil {
+		return nil, err
+	}
+
+	o.StartTime = timestamppb.New(start)
+	o.EndTime = timestamppb.New(end)
+	o.Status = waitlistOfferStatusFromDB(status)
+	o.ExpiresAt = timestamppb.New(expiresAt)
+	o.CreatedAt = timestamppb.New(createdAt)
+	if respondedAt != nil {
+		o.RespondedAt = timestamppb.New(*respondedAt)
+	}
+
+	return &o, nil
+}
+
+// entrySnapshot is e as recorded in the audit log, without the contact
+// details that live with the user.
+func entrySnapshot(e *pb.WaitlistEntry) *pb.WaitlistEntry {
+	return &pb.WaitlistEntry{
+		Id:            e.Id,
+		UserId:        e.UserId,
+		WindowStart:   e.WindowStart,
+		WindowEnd:     e.WindowEnd,
+		Status:        e.Status,
+		AppointmentId: e.AppointmentId,
+	}
+}
+
+// getWaitlistEntry reads the entry id, locking it with lock if given, along
+// with its open offer. Offers past their expiry count as closed even before
+// the sweeper marks them.
+func getWaitlistEntry(ctx context.Context, q querier, id, lock string) (*pb.WaitlistEntry, error) {
+	query := `
+	SELECT ` + waitlistEntryColumns + `
+	FROM waitlist_entries e JOIN users u ON u.id = e.user_id
+	WHERE e.id = $1 ` + lock
+
+	entry, err := scanWaitlistEntry(q.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWaitlistEntryNotFound
+		}
+		return nil, err
+	}
+
+	offer := `
+	SELECT ` + waitlistOfferColumns + `
+	FROM waitlist_offers o
+	WHERE o.entry_id = $1 AND o.status = 'pending' AND o.expires_at > NOW()`
+
+	entry.Offer, err = scanWaitlistOffer(q.QueryRow(ctx, offer, id))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// lockWaitlistOffers serializes offering slots with checking bookings
+// against the offers, so a slot is never both booked and offered.
+//
+// The lock is global rather than per slot or per day: every booking,
+// cancellation and move waits on it, even ones nowhere near each other.
+// Narrower locks would have to be taken up front, in order, for every range
+// a transaction goes on to touch, and rescheduling, releasing holds and
+// expiring offers only learn their ranges as they go, interleaved with audit
+// writes. Those audit writes already serialize the same transactions on the
+// audit log's own global lock, so a narrower lock here would add deadlock
+// risk for little concurrency. Revisit this together with that one.
+func lockWaitlistOffers(ctx context.Context, q querier) error {
+	_, err := q.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('waitlist_offers'))`)
+	return err
+}
+
+// checkSlotOffers returns ErrSlotOffered if appt, already written, lies on a
+// slot offered to someone other than its user. Checking after the write
+// means a booking that had to wait for the cancellation freeing its slot
+// still sees the offer that cancellation made.
+func checkSlotOffers(ctx context.Context, q querier, appt *pb.Appointment) error {
+	if err := lockWaitlistOffers(ctx, q); err != nil {
+		return err
+	}
+
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM waitlist_offers o JOIN waitlist_entries e ON e.id = o.entry_id
+		WHERE o.status = 'pending' AND o.expires_at > NOW() AND e.user_id <> $1
+			AND tstzrange(o.start_time, o.end_time) && appointment_busy_range($2, $3, $4, $5)
+	)`
+
+	var offered bool
+	err := q.QueryRow(ctx, query, appt.UserId, appt.StartTime.AsTime(), appt.EndTime.AsTime(),
+		seconds(appt.BufferBefore.AsDuration()), seconds(appt.BufferAfter.AsDuration())).Scan(&offered)
+	if err != nil {
+		return err
+	}
+	if offered {
+		return ErrSlotOffered
+	}
+
+	return nil
+}
+
+// offerFreedSlot offers [start, end), just freed, to the first entry waiting
+// for a slot within it. Nothing is offered for a slot that has started or is
+// still taken, say by the appointment that freed it moving only a little.
+// Entries locked by another transaction are leaving, booking or already
+// hold an offer, so they are passed over.
+func offerFreedSlot(ctx context.Context, q querier, start, end time.Time) error {
+	if err := lockWaitlistOffers(ctx, q); err != nil {
+		return err
+	}
+
+	// Lapsed offers the sweeper has not reached yet give way to this one.
+	// Rows locked elsewhere are skipped rather than waited on while holding
+	// the lock, and the slot is then left for the sweeper.
+	lapsed := `
+	UPDATE waitlist_offers SET status = 'expired', responded_at = NOW()
+	WHERE id IN (
+		SELECT id FROM waitlist_offers
+		WHERE status = 'pending' AND expires_at <= NOW() AND tstzrange(start_time, end_time) && tstzrange($1, $2)
+		FOR UPDATE SKIP LOCKED
+	)`
+
+	if _, err := q.Exec(ctx, lapsed, start, end); err != nil {
+		return err
+	}
+
+	free := `
+	SELECT $1 > NOW()
+		AND NOT EXISTS (
+			SELECT 1 FROM appointments
+			WHERE deleted_at IS NULL
+				AND appointment_busy_range(start_time, end_time, buffer_before_seconds, buffer_after_seconds) && tstzrange($1, $2)
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM waitlist_offers
+			WHERE status = 'pending' AND tstzrange(start_time, end_time) && tstzrange($1, $2)
+		)`
+
+	var isFree bool
+	if err := q.QueryRow(ctx, free, start, end).Scan(&isFree); err != nil {
+		return err
+	}
+	if !isFree {
+		return nil
+	}
+
+	query := `
+	WITH next AS (
+		SELECT e.id FROM waitlist_entries e
+		WHERE e.status = 'waiting' AND e.window_start <= $1 AND e.window_end >= $2
+			AND NOT EXISTS (
+				SELECT 1 FROM waitlist_offers o
+				WHERE o.entry_id = e.id AND (o.status = 'pending' OR (o.start_time = $1 AND o.end_time = $2))
+			)
+		ORDER BY e.created_at, e.id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	)
+	INSERT INTO waitlist_offers (id, entry_id, start_time, end_time, expires_at)
+	SELECT $3::uuid, id, $1, $2, NOW() + make_interval(secs => (SELECT waitlist_offer_seconds FROM schedule))
+	FROM next
+	RETURNING entry_id`
+
+	var entryId string
+	if err := q.QueryRow(ctx, query, start, end, uuid.NewString()).Scan(&entryId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Nobody is waiting for this slot.
+			return nil
+		}
+		return err
+	}
+
+	entry, err := getWaitlistEntry(ctx, q, entryId, "")
+	if err != nil {
+		return err
+	}
+
+	if err := appendAuditEvent(ctx, q, "waitlist_entry", entry.Id, "waitlist_entry.offered", nil, entry.Offer); err != nil {
+		return err
+	}
+	return appendOutboxEvent(ctx, q, "waitlist_entry", entry.Id, EventWaitlistOffered, entry)
+}
+
+// JoinWaitlist adds entry, which should already have been validated, to the
+// end of the waitlist.
+func (db *Database) JoinWaitlist(ctx context.Context, entry *pb.WaitlistEntry) (*pb.WaitlistEntry, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+	INSERT INTO waitlist_entries (id, user_id, window_start, window_end, title, description)
+	VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err = tx.Exec(ctx, query, entry.Id, entry.UserId, entry.WindowStart.AsTime(), entry.WindowEnd.AsTime(),
+		entry.Title, entry.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	joined, err := getWaitlistEntry(ctx, tx, entry.Id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := appendAuditEvent(ctx, tx, "waitlist_entry", joined.Id, "waitlist_entry.joined", nil, entrySnapshot(joined)); err != nil {
+		return nil, err
+	}
+
+	return joined, tx.Commit(ctx)
+}
+
+func (db *Database) GetWaitlistEntry(ctx context.Context, id string) (*pb.WaitlistEntry, error) {
+	return getWaitlistEntry(ctx, db.Pool, id, "")
+}
+
+// LeaveWaitlist takes the entry id off the waitlist, passing any offer it
+// holds on to the next entry.
+func (db *Database) LeaveWaitlist(ctx context.Context, id string) (*pb.WaitlistEntry, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Offers are locked before entries throughout, so answering an offer
+	// and leaving cannot deadlock.
+	lockOffer := `
+	SELECT ` + waitlistOfferColumns + `
+	FROM waitlist_offers o
+	WHERE o.entry_id = $1 AND o.status = 'pending'
+	FOR UPDATE`
+
+	offer, err := scanWaitlistOffer(tx.QueryRow(ctx, lockOffer, id))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	before, err := getWaitlistEntry(ctx, tx, id, "FOR UPDATE OF e")
+	if err != nil {
+		return nil, err
+	}
+	if before.Status != pb.WaitlistStatus_WAITLIST_STATUS_WAITING {
+		return nil, ErrWaitlistEntryClosed
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE waitlist_entries SET status = 'cancelled', updated_at = NOW() WHERE id = $1`, id); err != nil {
+		return nil, err
+	}
+
+	if offer != nil {
+		if _, err := tx.Exec(ctx, `UPDATE waitlist_offers SET status = 'withdrawn', responded_at = NOW() WHERE id = $1`, offer.Id); err != nil {
+			return nil, err
+		}
+		if err := offerFreedSlot(ctx, tx, offer.StartTime.AsTime(), offer.EndTime.AsTime()); err != nil {
+			return nil, err
+		}
+	}
+
+	after, err := getWaitlistEntry(ctx, tx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := appendAuditEvent(ctx, tx, "waitlist_entry", id, "waitlist_entry.left", entrySnapshot(before), entrySnapshot(after)); err != nil {
+		return nil, err
+	}
+
+	return after, tx.Commit(ctx)
+}
+
+// leaveWaitlistEntries takes every entry of userId off the waitlist,
+// passing any offers they hold on.
+func leaveWaitlistEntries(ctx context.Context, tx pgx.Tx, userId string) error {
+	query := `
+	WITH cancelled AS (
+		UPDATE waitlist_entries SET status = 'cancelled', updated_at = NOW()
+		WHERE user_id = $1 AND status = 'waiting'
+		RETURNING id
+	)
+	UPDATE waitlist_offers SET status = 'withdrawn', responded_at = NOW()
+	WHERE status = 'pending' AND entry_id IN (SELECT id FROM cancelled)
+	RETURNING start_time, end_time`
+
+	rows, err := tx.Query(ctx, query, userId)
+	if err != nil {
+		return err
+	}
+	withdrawn, err := collectTimeRanges(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range withdrawn {
+		if err := offerFreedSlot(ctx, tx, r.start, r.end); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lockWaitlistOffer reads and locks the offer id and then its entry.
+func lockWaitlistOffer(ctx context.Context, tx pgx.Tx, id string) (*pb.WaitlistOffer, *pb.WaitlistEntry, error) {
+	query := `SELECT ` + waitlistOfferColumns + ` FROM waitlist_offers o WHERE o.id = $1 FOR UPDATE`
+
+	offer, err := scanWaitlistOffer(tx.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrWaitlistOfferNotFound
+		}
+		return nil, nil, err
+	}
+
+	entry, err := getWaitlistEntry(ctx, tx, offer.EntryId, "FOR UPDATE OF e")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return offer, entry, nil
+}
+
+// answerWaitlistOffer closes offer with status and records the answer.
+func answerWaitlistOffer(ctx context.Context, q querier, offer *pb.WaitlistOffer, status pb.WaitlistOfferStatus) error {
+	query := `
+	UPDATE waitlist_offers o SET status = $2, responded_at = NOW()
+	WHERE o.id = $1
+	RETURNING ` + waitlistOfferColumns
+
+	after, err := scanWaitlistOffer(q.QueryRow(ctx, query, offer.Id, waitlistOfferStatuses[status]))
+	if err != nil {
+		return err
+	}
+
+	action := "waitlist_entry.offer_" + waitlistOfferStatuses[status]
+	return appendAuditEvent(ctx, q, "waitlist_entry", offer.EntryId, action, offer, after)
+}
+
+// AcceptWaitlistOffer books the slot of the offer id for the entry holding
+// it, as BookAppointment would at now, and takes the entry off the
+// waitlist.
+func (db *Database) AcceptWaitlistOffer(ctx context.Context, id string, now time.Time) (*pb.WaitlistEntry, *pb.Appointment, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	offer, entry, err := lockWaitlistOffer(ctx, tx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if offer.Status != pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_PENDING {
+		return nil, nil, ErrWaitlistOfferClosed
+	}
+	if !offer.ExpiresAt.AsTime().After(now) {
+		return nil, nil, ErrWaitlistOfferExpired
+	}
+
+	appt := &pb.Appointment{
+		Id:                 uuid.NewString(),
+		UserId:             entry.UserId,
+		Title:              entry.Title,
+		Description:        entry.Description,
+		ContactInformation: entry.ContactInformation,
+		StartTime:          offer.StartTime,
+		EndTime:            offer.EndTime,
+		Date:               offer.StartTime,
+		TimeZone:           entry.TimeZone,
+	}
+	if err := bookAppointment(ctx, tx, appt, "", "", now, db.ReminderOffsets); err != nil {
+		return nil, nil, err
+	}
+
+	if err := answerWaitlistOffer(ctx, tx, offer, pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_ACCEPTED); err != nil {
+		return nil, nil, err
+	}
+
+	query := `UPDATE waitlist_entries SET status = 'booked', appointment_id = $2, updated_at = NOW() WHERE id = $1`
+	if _, err := tx.Exec(ctx, query, entry.Id, appt.Id); err != nil {
+		return nil, nil, err
+	}
+
+	booked, err := getWaitlistEntry(ctx, tx, entry.Id, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := appendAuditEvent(ctx, tx, "waitlist_entry", entry.Id, "waitlist_entry.booked", entrySnapshot(entry), entrySnapshot(booked)); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return booked, appt, nil
+}
+
+// DeclineWaitlistOffer turns down the offer id, which then goes to the next
+// entry. The entry that declined stays on the waitlist for other slots.
+func (db *Database) DeclineWaitlistOffer(ctx context.Context, id string) (*pb.WaitlistEntry, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	offer, entry, err := lockWaitlistOffer(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if offer.Status != pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_PENDING {
+		return nil, ErrWaitlistOfferClosed
+	}
+
+	if err := lockWaitlistOffers(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	if err := answerWaitlistOffer(ctx, tx, offer, pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_DECLINED); err != nil {
+		return nil, err
+	}
+
+	if err := offerFreedSlot(ctx, tx, offer.StartTime.AsTime(), offer.EndTime.AsTime()); err != nil {
+		return nil, err
+	}
+
+	entry.Offer = nil
+	return entry, tx.Commit(ctx)
+}
+
+// ExpireWaitlistOffers closes up to limit offers left unanswered past their
+// expiry and passes each slot on to the next entry, returning how many
+// expired.
+func (db *Database) ExpireWaitlistOffers(ctx context.Context, limit int) (int, error) {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+	SELECT ` + waitlistOfferColumns + `
+	FROM waitlist_offers o
+	WHERE o.status = 'pending' AND o.expires_at <= NOW()
+	ORDER BY o.expires_at
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.Query(ctx, query, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var expired []*pb.WaitlistOffer
+	for rows.Next() {
+		offer, err := scanWaitlistOffer(rows)
+		if err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("error scanning row: %w", err)
+		}
+		expired = append(expired, offer)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	if err := lockWaitlistOffers(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	for _, offer := range expired {
+		if err := answerWaitlistOffer(ctx, tx, offer, pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_EXPIRED); err != nil {
+			return 0, err
+		}
+	}
+
+	// Every slot is closed before any is offered again, so one freed next
+	// to another does not find it still pending.
+	for _, offer := range expired {
+		if err := offerFreedSlot(ctx, tx, offer.StartTime.AsTime(), offer.EndTime.AsTime()); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(expired), tx.Commit(ctx)
+}
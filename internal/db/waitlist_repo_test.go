@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWaitlist(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	newUser := func(name string) *pb.User {
+		user, err := db.CreateUser(ctx, &pb.User{Id: uuid.NewString(), Name: name, Email: strings.ToLower(name) + "@example.com"})
+		require.NoError(t, err)
+		return user
+	}
+	ann, dan := newUser("Ann"), newUser("Dan")
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	newAppointment := func(user *pb.User, start time.Time) *pb.Appointment {
+		return &pb.Appointment{
+			Id:                 uuid.NewString(),
+			Title:              "Check-up",
+			UserId:             user.Id,
+			Date:               timestamppb.New(start),
+			ContactInformation: &pb.ContactInformation{Name: user.Name, Email: user.Email},
+			StartTime:          timestamppb.New(start),
+			EndTime:            timestamppb.New(start.Add(time.Hour)),
+		}
+	}
+	join := func(name string) *pb.WaitlistEntry {
+		entry, err := db.JoinWaitlist(ctx, &pb.WaitlistEntry{
+			Id:          uuid.NewString(),
+			UserId:      newUser(name).Id,
+			WindowStart: timestamppb.New(start.Add(-time.Hour)),
+			WindowEnd:   timestamppb.New(start.Add(4 * time.Hour)),
+			Title:       "Check-up",
+		})
+		require.NoError(t, err)
+		return entry
+	}
+	offerOf := func(entry *pb.WaitlistEntry) *pb.WaitlistOffer {
+		stored, err := db.GetWaitlistEntry(ctx, entry.Id)
+		require.NoError(t, err)
+		return stored.Offer
+	}
+
+	booked := newAppointment(ann, start)
+	require.NoError(t, db.CreateAppointment(ctx, booked))
+
+	bob, cara := join("Bob"), join("Cara")
+	assert.Equal(t, pb.WaitlistStatus_WAITLIST_STATUS_WAITING, bob.Status)
+	assert.Nil(t, bob.Offer)
+
+	t.Run("a cancelled slot is offered to the first in line", func(t *testing.T) {
+		_, err := db.DeleteAppointment(ctx, booked.Id, nil, time.Now())
+		require.NoError(t, err)
+
+		offer := offerOf(bob)
+		require.NotNil(t, offer)
+		assert.True(t, offer.StartTime.AsTime().Equal(start))
+		assert.True(t, offer.EndTime.AsTime().Equal(start.Add(time.Hour)))
+		assert.Equal(t, pb.WaitlistOfferStatus_WAITLIST_OFFER_STATUS_PENDING, offer.Status)
+		assert.Nil(t, offerOf(cara))
+
+		events, _, err := db.ListOutboxEvents(ctx, "", bob.Id, 0, "")
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, EventWaitlistOffered, events[0].Type)
+	})
+
+	t.Run("nobody else can book an offered slot", func(t *testing.T) {
+		err := db.BookAppointment(ctx, newAppointment(dan, start.Add(30*time.Minute)), time.Now())
+		assert.ErrorIs(t, err, ErrSlotOffered)
+		assert.ErrorIs(t, err, ErrAppointmentConflict)
+	})
+
+	t.Run("a declined offer goes to the next in line", func(t *testing.T) {
+		entry, err := db.DeclineWaitlistOffer(ctx, offerOf(bob).Id)
+		require.NoError(t, err)
+		assert.Equal(t, pb.WaitlistStatus_WAITLIST_STATUS_WAITING, entry.Status)
+		assert.Nil(t, entry.Offer)
+
+		require.NotNil(t, offerOf(cara))
+	})
+
+	t.Run("an expired offer skips those already offered the slot", func(t *testing.T) {
+		erin := join("Erin")
+
+		_, err := db.Pool.Exec(ctx, `UPDATE waitlist_offers SET expires_at = NOW() - interval '1 second' WHERE status = 'pending'`)
+		require.NoError(t, err)
+		assert.Nil(t, offerOf(cara))
+
+		expired, err := db.ExpireWaitlistOffers(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, expired)
+
+		assert.Nil(t, offerOf(bob))
+		assert.Nil(t, offerOf(cara))
+		offer := offerOf(erin)
+		require.NotNil(t, offer)
+
+		t.Run("and accepting an offer books the slot", func(t *testing.T) {
+			entry, appt, err := db.AcceptWaitlistOffer(ctx, offer.Id, time.Now())
+			require.NoError(t, err)
+			assert.Equal(t, pb.WaitlistStatus_WAITLIST_STATUS_BOOKED, entry.Status)
+			assert.Equal(t, appt.Id, entry.AppointmentId)
+			assert.Equal(t, erin.UserId, appt.UserId)
+			assert.True(t, appt.StartTime.AsTime().Equal(start))
+
+			_, _, err = db.AcceptWaitlistOffer(ctx, offer.Id, time.Now())
+			assert.ErrorIs(t, err, ErrWaitlistOfferClosed)
+		})
+	})
+
+	t.Run("leaving withdraws an open offer", func(t *testing.T) {
+		later := newAppointment(ann, start.Add(2*time.Hour))
+		require.NoError(t, db.CreateAppointment(ctx, later))
+		_, err := db.DeleteAppointment(ctx, later.Id, nil, time.Now())
+		require.NoError(t, err)
+		require.NotNil(t, offerOf(bob))
+
+		left, err := db.LeaveWaitlist(ctx, bob.Id)
+		require.NoError(t, err)
+		assert.Equal(t, pb.WaitlistStatus_WAITLIST_STATUS_CANCELLED, left.Status)
+		assert.Nil(t, left.Offer)
+		require.NotNil(t, offerOf(cara))
+
+		_, err = db.LeaveWaitlist(ctx, bob.Id)
+		assert.ErrorIs(t, err, ErrWaitlistEntryClosed)
+	})
+
+	t.Run("unknown entries and offers are not found", func(t *testing.T) {
+		_, err := db.GetWaitlistEntry(ctx, uuid.NewString())
+		assert.ErrorIs(t, err, ErrWaitlistEntryNotFound)
+
+		_, err = db.DeclineWaitlistOffer(ctx, uuid.NewString())
+		assert.ErrorIs(t, err, ErrWaitlistOfferNotFound)
+	})
+}
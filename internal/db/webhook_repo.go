@@ -0,0 +1,372 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+var ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+// Each event produces one delivery per subscribed webhook. Dispatchers claim
+// due deliveries with FOR UPDATE SKIP LOCKED under a lease, the same way
+// reminders are claimed.
+
+const webhookColumns = `id, url, event_types, enabled, consecutive_failures, disabled_at, created_at`
+
+func scanWebhook(row pgx.Row) (*pb.Webhook, error) {
+	var w pb.Webhook
+	var failures int
+	var disabledAt *time.Time
+	var createdAt time.Time
+
+	err := row.Scan(&w.Id, &w.Url, &w.EventTypes, &w.Enabled, &failures, &disabledAt, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	w.ConsecutiveFailures = int32(failures)
+	w.CreatedAt = timestamppb.New(createdAt)
+	if disabledAt != nil {
+		w.DisabledAt = timestamppb.New(*disabledAt)
+	}
+
+	return &w, nil
+}
+
+func (db *Database) CreateWebhook(ctx context.Context, id, url string, eventTypes []string, secret string) (*pb.Webhook, error) {
+	query := `
+	INSERT INTO webhooks (id, url, event_types, secret)
+	VALUES ($1, $2, $3, $4)
+	RETURNING ` + webhookColumns
+
+	w, err := scanWebhook(db.Pool.QueryRow(ctx, query, id, url, eventTypes, secret))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return w, nil
+}
+
+func (db *Database) ListWebhooks(ctx context.Context) ([]*pb.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks ORDER BY created_at`
+
+	rows, err := db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*pb.Webhook
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		result = append(result, w)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateWebhook replaces the URL and event types when they are set, and
+// enables or disables the webhook. Re-enabling clears its failure count.
+func (db *Database) UpdateWebhook(ctx context.Context, id, url string, eventTypes []string, enabled bool) (*pb.Webhook, error) {
+	query := `
+	UPDATE webhooks SET
+		url = COALESCE(NULLIF($2, ''), url),
+		event_types = CASE WHEN cardinality($3::text[]) > 0 THEN $3 ELSE event_types END,
+		enabled = $4,
+		consecutive_failures = CASE WHEN $4 THEN 0 ELSE consecutive_failures END,
+		disabled_at = CASE WHEN $4 THEN NULL ELSE COALESCE(disabled_at, NOW()) END,
+		updated_at = NOW()
+	WHERE id = $1
+	RETURNING ` + webhookColumns
+
+	w, err := scanWebhook(db.Pool.QueryRow(ctx, query, id, url, eventTypes, enabled))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (db *Database) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	query := `DELETE FROM webhooks WHERE id = $1`
+
+	commandTag, err := db.Pool.Exec(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+
+	return commandTag.RowsAffected() > 0, nil
+}
+
+// EnqueueWebhookEvent creates a pending delivery of payload for every enabled
+// webhook subscribed to eventType, returning how many were created.
+func (db *Database) EnqueueWebhookEvent(ctx context.Context, eventId, eventType string, payload []byte) (int, error) {
+	query := `
+	INSERT INTO webhook_deliveries (id, webhook_id, event_id, event_type, payload)
+	SELECT gen_random_uuid(), id, $1, $2, $3
+	FROM webhooks
+	WHERE enabled AND $2 = ANY(event_types)`
+
+	commandTag, err := db.Pool.Exec(ctx, query, eventId, eventType, payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue webhook event: %w", err)
+	}
+
+	return int(commandTag.RowsAffected()), nil
+}
+
+var webhookDeliveryStatuses = map[string]pb.WebhookDeliveryStatus{
+	"pending":    pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+	"delivering": pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+	"succeeded":  pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED,
+	"failed":     pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED,
+}
+
+const webhookDeliveryColumns = `id, webhook_id, event_id, event_type, status, attempts,
+		COALESCE(response_code, 0), COALESCE(last_error, ''), created_at, next_attempt_at, delivered_at`
+
+func scanWebhookDelivery(row pgx.Row) (*pb.WebhookDelivery, error) {
+	var d pb.WebhookDelivery
+	var status string
+	var attempts, responseCode int
+	var createdAt, nextAttemptAt time.Time
+	var deliveredAt *time.Time
+
+	err := row.Scan(
+		&d.Id,
+		&d.WebhookId,
+		&d.EventId,
+		&d.EventType,
+		&status,
+		&attempts,
+		&responseCode,
+		&d.LastError,
+		&createdAt,
+		&nextAttemptAt,
+		&deliveredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Status = webhookDeliveryStatuses[status]
+	d.Attempts = int32(attempts)
+	d.ResponseCode = int32(responseCode)
+	d.CreatedAt = timestamppb.New(createdAt)
+	if d.Status == pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING {
+		d.NextAttemptAt = timestamppb.New(nextAttemptAt)
+	}
+	if deliveredAt != nil {
+		d.DeliveredAt = timestamppb.New(*deliveredAt)
+	}
+
+	return &d, nil
+}
+
+// ListWebhookDeliveries returns a webhook's delivery history, newest first.
+func (db *Database) ListWebhookDeliveries(ctx context.Context, webhookId string, pageSize int, pageToken string) ([]*pb.WebhookDelivery, string, error) {
+	scope := pageTokenScope(webhookId)
+	offset := 0
+	if pageToken != "" {
+		var err error
+		offset, err = decodeOffsetToken(pageToken, scope)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	query := `
+	SELECT ` + webhookDeliveryColumns + `
+	FROM webhook_deliveries
+	WHERE webhook_id = $1
+	ORDER BY created_at DESC, id
+	LIMIT $2 OFFSET $3`
+
+	rows, err := db.Pool.Query(ctx, query, webhookId, pageSize+1, offset)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var result []*pb.WebhookDelivery
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("error scanning row: %w", err)
+		}
+		result = append(result, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(result) > pageSize {
+		result = result[:pageSize]
+		nextPageToken = encodeOffsetToken(offset+pageSize, scope)
+	}
+
+	return result, nextPageToken, nil
+}
+
+// RedeliverWebhook queues the event of an earlier delivery again as a new
+// delivery to the same webhook.
+func (db *Database) RedeliverWebhook(ctx context.Context, deliveryId, newId string) (*pb.WebhookDelivery, error) {
+	query := `
+	INSERT INTO webhook_deliveries (id, webhook_id, event_id, event_type, payload)
+	SELECT $2, webhook_id, event_id, event_type, payload
+	FROM webhook_deliveries WHERE id = $1
+	RETURNING ` + webhookDeliveryColumns
+
+	d, err := scanWebhookDelivery(db.Pool.QueryRow(ctx, query, deliveryId, newId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// PendingWebhookDelivery is a claimed delivery with what is needed to send
+// it.
+type PendingWebhookDelivery struct {
+	Id        string
+	WebhookId string
+	URL       string
+	Secret    string
+	EventId   string
+	EventType string
+	Payload   []byte
+	Attempts  int
+}
+
+// ClaimWebhookDeliveries claims up to limit due deliveries to enabled
+// webhooks, leasing them for lease.
+func (db *Database) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*PendingWebhookDelivery, error) {
+	query := `
+	WITH due AS (
+		SELECT d.id FROM webhook_deliveries d
+		JOIN webhooks w ON w.id = d.webhook_id
+		WHERE w.enabled AND d.next_attempt_at <= NOW()
+			AND (d.status = 'pending' OR (d.status = 'delivering' AND d.locked_until < NOW()))
+		ORDER BY d.next_attempt_at
+		LIMIT $1
+		FOR UPDATE OF d SKIP LOCKED
+	)
+	UPDATE webhook_deliveries d
+	SET status = 'delivering', locked_until = NOW() + make_interval(secs => $2), attempts = d.attempts + 1
+	FROM due, webhooks w
+	WHERE d.id = due.id AND w.id = d.webhook_id
+	RETURNING d.id, d.webhook_id, w.url, w.secret, d.event_id, d.event_type, d.payload, d.attempts`
+
+	rows, err := db.Pool.Query(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*PendingWebhookDelivery
+	for rows.Next() {
+		var d PendingWebhookDelivery
+		if err := rows.Scan(&d.Id, &d.WebhookId, &d.URL, &d.Secret, &d.EventId, &d.EventType, &d.Payload, &d.Attempts); err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		result = append(result, &d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// WebhookDeliveryResult is the outcome of one delivery attempt. A failed
+// attempt is retried at RetryAt, or given up on when RetryAt is zero.
+type WebhookDeliveryResult struct {
+	Succeeded    bool
+	ResponseCode int
+	Error        string
+	RetryAt      time.Time
+}
+
+// RecordWebhookDelivery stores the outcome of a claimed delivery and updates
+// the webhook's run of consecutive failures, disabling it once the run
+// reaches disableAfter.
+func (db *Database) RecordWebhookDelivery(ctx context.Context, id string, result WebhookDeliveryResult, disableAfter int) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	status := "succeeded"
+	switch {
+	case result.Succeeded:
+	case result.RetryAt.IsZero():
+		status = "failed"
+	default:
+		status = "pending"
+	}
+
+	delivery := `
+	UPDATE webhook_deliveries SET
+		status = $2,
+		response_code = NULLIF($3, 0),
+		last_error = NULLIF($4, ''),
+		next_attempt_at = COALESCE($5, next_attempt_at),
+		delivered_at = CASE WHEN $2 = 'succeeded' THEN NOW() END,
+		locked_until = NULL
+	WHERE id = $1 AND status = 'delivering'
+	RETURNING webhook_id`
+
+	var webhookId string
+	err = tx.QueryRow(ctx, delivery, id, status, result.ResponseCode, result.Error, nullableTime(result.RetryAt)).Scan(&webhookId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrWebhookDeliveryNotFound
+		}
+		return err
+	}
+
+	webhook := `
+	UPDATE webhooks SET
+		consecutive_failures = CASE WHEN $2 THEN 0 ELSE consecutive_failures + 1 END,
+		enabled = enabled AND ($2 OR consecutive_failures + 1 < $3),
+		disabled_at = CASE WHEN enabled AND NOT $2 AND consecutive_failures + 1 >= $3 THEN NOW() ELSE disabled_at END
+	WHERE id = $1`
+
+	if _, err := tx.Exec(ctx, webhook, webhookId, result.Succeeded, disableAfter); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooks(t *testing.T) {
+	db := createTestDB(t)
+	ctx := context.Background()
+
+	created, err := db.CreateWebhook(ctx, uuid.NewString(), "http://example.com/created", []string{"appointment.created"}, "secret")
+	require.NoError(t, err)
+	assert.True(t, created.Enabled)
+
+	all, err := db.CreateWebhook(ctx, uuid.NewString(), "http://example.com/all", []string{"appointment.created", "appointment.cancelled"}, "secret")
+	require.NoError(t, err)
+
+	t.Run("events go only to enabled subscribers", func(t *testing.T) {
+		n, err := db.EnqueueWebhookEvent(ctx, uuid.NewString(), "appointment.cancelled", []byte(`{}`))
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		_, err = db.UpdateWebhook(ctx, created.Id, "", nil, false)
+		require.NoError(t, err)
+
+		n, err = db.EnqueueWebhookEvent(ctx, uuid.NewString(), "appointment.created", []byte(`{}`))
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		_, err = db.UpdateWebhook(ctx, created.Id, "", nil, true)
+		require.NoError(t, err)
+	})
+
+	t.Run("claimed deliveries are not claimed again while leased", func(t *testing.T) {
+		due, err := db.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, due, 2)
+		assert.Equal(t, all.Id, due[0].WebhookId)
+		assert.Equal(t, "http://example.com/all", due[0].URL)
+		assert.Equal(t, 1, due[0].Attempts)
+
+		more, err := db.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, more)
+
+		for _, d := range due {
+			require.NoError(t, db.RecordWebhookDelivery(ctx, d.Id, WebhookDeliveryResult{Succeeded: true, ResponseCode: 200}, 3))
+		}
+	})
+
+	t.Run("failed deliveries are retried and logged", func(t *testing.T) {
+		_, err := db.EnqueueWebhookEvent(ctx, uuid.NewString(), "appointment.cancelled", []byte(`{}`))
+		require.NoError(t, err)
+
+		due, err := db.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+
+		result := WebhookDeliveryResult{ResponseCode: 503, Error: "unexpected status 503", RetryAt: time.Now()}
+		require.NoError(t, db.RecordWebhookDelivery(ctx, due[0].Id, result, 3))
+
+		due, err = db.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		assert.Equal(t, 2, due[0].Attempts)
+
+		require.NoError(t, db.RecordWebhookDelivery(ctx, due[0].Id, WebhookDeliveryResult{ResponseCode: 500}, 3))
+
+		deliveries, _, err := db.ListWebhookDeliveries(ctx, all.Id, 0, "")
+		require.NoError(t, err)
+		require.Len(t, deliveries, 3)
+		assert.Equal(t, pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED, deliveries[0].Status)
+		assert.Equal(t, int32(500), deliveries[0].ResponseCode)
+		assert.Equal(t, int32(2), deliveries[0].Attempts)
+	})
+
+	t.Run("repeated failures disable the webhook", func(t *testing.T) {
+		latest, _, err := db.ListWebhookDeliveries(ctx, all.Id, 1, "")
+		require.NoError(t, err)
+		require.Len(t, latest, 1)
+
+		delivery, err := db.RedeliverWebhook(ctx, latest[0].Id, uuid.NewString())
+		require.NoError(t, err)
+		assert.Equal(t, latest[0].EventId, delivery.EventId)
+		assert.Equal(t, pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING, delivery.Status)
+
+		due, err := db.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		assert.Equal(t, delivery.Id, due[0].Id)
+		require.NoError(t, db.RecordWebhookDelivery(ctx, due[0].Id, WebhookDeliveryResult{ResponseCode: 500, RetryAt: time.Now()}, 3))
+
+		webhooks, err := db.ListWebhooks(ctx)
+		require.NoError(t, err)
+		for _, w := range webhooks {
+			if w.Id == all.Id {
+				assert.False(t, w.Enabled)
+				assert.Equal(t, int32(3), w.ConsecutiveFailures)
+				assert.NotNil(t, w.DisabledAt)
+			}
+		}
+
+		more, err := db.ClaimWebhookDeliveries(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, more)
+	})
+
+	t.Run("redelivering an unknown delivery", func(t *testing.T) {
+		_, err := db.RedeliverWebhook(ctx, uuid.NewString(), uuid.NewString())
+		assert.ErrorIs(t, err, ErrWebhookDeliveryNotFound)
+	})
+}
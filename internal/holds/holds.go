@@ -0,0 +1,74 @@
+// Package holds releases slot holds that have expired without being
+// confirmed.
+package holds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Store is the part of the database the sweeper uses.
+type Store interface {
+	ReleaseExpiredHolds(ctx context.Context, limit int) (int, error)
+}
+
+type Options struct {
+	// Interval is how often the sweeper looks for expired holds.
+	Interval time.Duration
+	// BatchSize caps how many holds are released per transaction.
+	BatchSize int
+}
+
+type Sweeper struct {
+	store Store
+	opts  Options
+}
+
+func NewSweeper(store Store, opts Options) *Sweeper {
+	if opts.Interval <= 0 {
+		opts.Interval = 15 * time.Second
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+
+	return &Sweeper{store: store, opts: opts}
+}
+
+// Run releases expired holds until ctx is cancelled.
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			released, err := s.RunOnce(ctx)
+			if err != nil {
+				log.Printf("Error releasing expired holds: %v", err)
+			}
+			// A full batch suggests there is a backlog, so keep going
+			// rather than waiting for the next tick.
+			if err != nil || released < s.opts.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce releases one batch of expired holds, returning how many were
+// released.
+func (s *Sweeper) RunOnce(ctx context.Context) (int, error) {
+	released, err := s.store.ReleaseExpiredHolds(ctx, s.opts.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release expired holds: %w", err)
+	}
+	return released, nil
+}
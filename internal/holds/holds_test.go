@@ -0,0 +1,57 @@
+package holds
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	expired int
+	batches []int
+	err     error
+}
+
+func (s *fakeStore) ReleaseExpiredHolds(ctx context.Context, limit int) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	n := min(limit, s.expired)
+	s.expired -= n
+	s.batches = append(s.batches, n)
+	return n, nil
+}
+
+func TestSweeper(t *testing.T) {
+	t.Run("releases at most a batch at a time", func(t *testing.T) {
+		store := &fakeStore{expired: 3}
+		sweeper := NewSweeper(store, Options{BatchSize: 2})
+
+		released, err := sweeper.RunOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, released)
+		assert.Equal(t, 1, store.expired)
+	})
+
+	t.Run("drains a backlog before waiting for the next tick", func(t *testing.T) {
+		store := &fakeStore{expired: 4}
+		sweeper := NewSweeper(store, Options{BatchSize: 2, Interval: time.Hour})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		sweeper.Run(ctx)
+
+		assert.Equal(t, []int{2, 2, 0}, store.batches)
+	})
+
+	t.Run("reports store errors", func(t *testing.T) {
+		sweeper := NewSweeper(&fakeStore{err: errors.New("connection reset")}, Options{})
+
+		_, err := sweeper.RunOnce(context.Background())
+		assert.ErrorContains(t, err, "connection reset")
+	})
+}
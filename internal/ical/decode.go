@@ -0,0 +1,264 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParsedEvent is a VEVENT read from an iCalendar stream. Err is set when the
+// event could not be understood; the other fields are filled in as far as
+// parsing got.
+type ParsedEvent struct {
+	Event
+	Recurring bool
+	Err       error
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Decode reads every VEVENT in r. Malformed events are returned with Err set
+// rather than failing the whole stream, so callers can report on each one.
+func Decode(r io.Reader) ([]ParsedEvent, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []ParsedEvent
+	var current []property
+	inEvent, sawCalendar := false, false
+	depth := 0
+
+	for _, line := range lines {
+		prop, err := parseLine(line)
+		if err != nil {
+			if inEvent {
+				current = append(current, property{name: "X-INVALID", value: err.Error()})
+			}
+			continue
+		}
+
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VCALENDAR"):
+			sawCalendar = true
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT") && !inEvent:
+			inEvent, current, depth = true, nil, 0
+		case prop.name == "BEGIN" && inEvent:
+			// Nested components such as VALARM carry their own DTSTART and
+			// friends, which must not override the event's.
+			depth++
+		case prop.name == "END" && inEvent && depth > 0:
+			depth--
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT") && inEvent:
+			events = append(events, buildEvent(current))
+			inEvent = false
+		case inEvent && depth == 0:
+			current = append(current, prop)
+		}
+	}
+
+	if !sawCalendar {
+		return nil, errors.New("not an iCalendar file: missing BEGIN:VCALENDAR")
+	}
+
+	return events, nil
+}
+
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, scanner.Err()
+}
+
+func parseLine(line string) (property, error) {
+	// The value starts at the first colon that is not inside a quoted
+	// parameter value.
+	inQuotes := false
+	split := -1
+	for i, r := range line {
+		if r == '"' {
+			inQuotes = !inQuotes
+		}
+		if r == ':' && !inQuotes {
+			split = i
+			break
+		}
+	}
+	if split < 0 {
+		return property{}, fmt.Errorf("malformed line %q", line)
+	}
+
+	head, value := line[:split], line[split+1:]
+	parts := strings.Split(head, ";")
+
+	prop := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string),
+		value:  value,
+	}
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		prop.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+
+	return prop, nil
+}
+
+func buildEvent(props []property) ParsedEvent {
+	var ev ParsedEvent
+	var duration time.Duration
+	var hasEnd, hasDuration bool
+
+	for _, p := range props {
+		var err error
+
+		switch p.name {
+		case "UID":
+			ev.UID = p.value
+		case "SUMMARY":
+			ev.Summary = unescapeText(p.value)
+		case "DESCRIPTION":
+			ev.Description = unescapeText(p.value)
+		case "STATUS":
+			ev.Status = strings.ToUpper(p.value)
+		case "SEQUENCE":
+			ev.Sequence, _ = strconv.Atoi(p.value)
+		case "ORGANIZER":
+			ev.Organizer = stripMailto(p.value)
+		case "ATTENDEE":
+			if ev.Attendee == "" {
+				ev.Attendee = stripMailto(p.value)
+			}
+		case "RRULE", "RDATE":
+			ev.Recurring = true
+		case "DTSTART":
+			ev.Start, err = parseTime(p)
+		case "DTEND":
+			ev.End, err = parseTime(p)
+			hasEnd = true
+		case "DURATION":
+			duration, err = parseDuration(p.value)
+			hasDuration = true
+		case "X-INVALID":
+			err = errors.New(p.value)
+		}
+
+		if err != nil && ev.Err == nil {
+			ev.Err = fmt.Errorf("%s: %w", p.name, err)
+		}
+	}
+
+	if ev.Err != nil {
+		return ev
+	}
+
+	switch {
+	case ev.UID == "":
+		ev.Err = errors.New("missing UID")
+	case ev.Start.IsZero():
+		ev.Err = errors.New("missing DTSTART")
+	case !hasEnd && hasDuration:
+		ev.End = ev.Start.Add(duration)
+	case !hasEnd:
+		ev.Err = errors.New("missing DTEND or DURATION")
+	}
+
+	if ev.Err == nil && !ev.End.After(ev.Start) {
+		ev.Err = errors.New("event ends before it starts")
+	}
+
+	return ev
+}
+
+func parseTime(p property) (time.Time, error) {
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(p.value) == len("20060102") {
+		return time.Parse("20060102", p.value)
+	}
+
+	if strings.HasSuffix(p.value, "Z") {
+		return time.Parse(dateTimeFormat, p.value)
+	}
+
+	loc := time.UTC
+	if tzid := p.params["TZID"]; tzid != "" {
+		var err error
+		loc, err = time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone %q", tzid)
+		}
+	}
+
+	return time.ParseInLocation("20060102T150405", p.value, loc)
+}
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+func parseDuration(value string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(value)
+	if m == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, _ := strconv.Atoi(m[i+2])
+		d += time.Duration(n) * unit
+	}
+
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+func unescapeText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func stripMailto(s string) string {
+	if len(s) >= 7 && strings.EqualFold(s[:7], "mailto:") {
+		return s[7:]
+	}
+	return s
+}
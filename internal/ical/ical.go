@@ -0,0 +1,147 @@
+// Package ical reads and writes the subset of RFC 5545 iCalendar used to
+// exchange appointments with calendar applications.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+
+	dateTimeFormat = "20060102T150405Z"
+	maxLineOctets  = 75
+)
+
+// Event is a single VEVENT. All times are written in UTC.
+type Event struct {
+	UID          string
+	Sequence     int
+	Summary      string
+	Description  string
+	Start        time.Time
+	End          time.Time
+	Created      time.Time
+	LastModified time.Time
+	Status       string
+	Organizer    string
+	Attendee     string
+}
+
+// Calendar is a VCALENDAR holding a list of events. Method is left empty for
+// CalDAV resources, which must not carry one.
+type Calendar struct {
+	ProdID string
+	Name   string
+	Method string
+	Events []Event
+}
+
+// Encode writes cal to w as an iCalendar stream with CRLF line endings and
+// long lines folded.
+func Encode(w io.Writer, cal Calendar, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	e := &encoder{w: bw}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", cal.ProdID)
+	e.line("CALSCALE", "GREGORIAN")
+	if cal.Method != "" {
+		e.line("METHOD", cal.Method)
+	}
+	if cal.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(cal.Name))
+	}
+
+	for _, ev := range cal.Events {
+		e.event(ev, now)
+	}
+
+	e.line("END", "VCALENDAR")
+
+	if e.err != nil {
+		return e.err
+	}
+	return bw.Flush()
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) event(ev Event, now time.Time) {
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", ev.UID)
+	e.line("DTSTAMP", FormatTime(now))
+	e.line("DTSTART", FormatTime(ev.Start))
+	e.line("DTEND", FormatTime(ev.End))
+	e.line("SEQUENCE", strconv.Itoa(ev.Sequence))
+	if !ev.Created.IsZero() {
+		e.line("CREATED", FormatTime(ev.Created))
+	}
+	if !ev.LastModified.IsZero() {
+		e.line("LAST-MODIFIED", FormatTime(ev.LastModified))
+	}
+	e.line("SUMMARY", escapeText(ev.Summary))
+	if ev.Description != "" {
+		e.line("DESCRIPTION", escapeText(ev.Description))
+	}
+	if ev.Organizer != "" {
+		e.line("ORGANIZER", "mailto:"+ev.Organizer)
+	}
+	if ev.Attendee != "" {
+		e.line("ATTENDEE", "mailto:"+ev.Attendee)
+	}
+	status := ev.Status
+	if status == "" {
+		status = StatusConfirmed
+	}
+	e.line("STATUS", status)
+	e.line("END", "VEVENT")
+}
+
+// line writes a content line, folding it so no physical line exceeds 75
+// octets without splitting a UTF-8 sequence.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+
+	content := name + ":" + value
+	var b strings.Builder
+	width := 0
+	for _, r := range content {
+		size := len(string(r))
+		if width+size > maxLineOctets {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+
+	_, e.err = e.w.WriteString(b.String())
+}
+
+// FormatTime renders t as a UTC DATE-TIME value.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
+
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`;`, `\;`,
+		`,`, `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
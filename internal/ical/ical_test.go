@@ -0,0 +1,132 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	lagos := time.FixedZone("WAT", 3600)
+
+	cal := Calendar{
+		ProdID: "-//test//EN",
+		Name:   "Ada's appointments",
+		Method: "PUBLISH",
+		Events: []Event{
+			{
+				UID:         "abc@example.com",
+				Sequence:    2,
+				Summary:     "Dentist; checkup, annual",
+				Description: strings.Repeat("long description ", 10),
+				Start:       time.Date(2026, 11, 2, 10, 0, 0, 0, lagos),
+				End:         time.Date(2026, 11, 2, 11, 0, 0, 0, lagos),
+				Status:      StatusCancelled,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, cal, now))
+	out := buf.String()
+
+	t.Run("writes times in UTC", func(t *testing.T) {
+		assert.Contains(t, out, "DTSTART:20261102T090000Z\r\n")
+		assert.Contains(t, out, "DTSTAMP:20261001T090000Z\r\n")
+	})
+
+	t.Run("escapes text values", func(t *testing.T) {
+		assert.Contains(t, out, `SUMMARY:Dentist\; checkup\, annual`)
+	})
+
+	t.Run("folds long lines", func(t *testing.T) {
+		for _, line := range strings.Split(out, "\r\n") {
+			assert.LessOrEqual(t, len(line), 75)
+		}
+		assert.Contains(t, out, "long descrip\r\n tion")
+	})
+
+	t.Run("writes the method only when set", func(t *testing.T) {
+		assert.Contains(t, out, "METHOD:PUBLISH\r\n")
+
+		var resource bytes.Buffer
+		require.NoError(t, Encode(&resource, Calendar{ProdID: "-//test//EN"}, now))
+		assert.NotContains(t, resource.String(), "METHOD")
+	})
+
+	t.Run("carries sequence and status", func(t *testing.T) {
+		assert.Contains(t, out, "SEQUENCE:2\r\n")
+		assert.Contains(t, out, "STATUS:CANCELLED\r\n")
+	})
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("round-trips encoded events", func(t *testing.T) {
+		start := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, Calendar{
+			ProdID: "-//test//EN",
+			Events: []Event{{
+				UID:         "abc",
+				Summary:     "Dentist; checkup",
+				Description: strings.Repeat("line one\nline two ", 5),
+				Start:       start,
+				End:         start.Add(time.Hour),
+			}},
+		}, start))
+
+		events, err := Decode(&buf)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		ev := events[0]
+		assert.NoError(t, ev.Err)
+		assert.Equal(t, "abc", ev.UID)
+		assert.Equal(t, "Dentist; checkup", ev.Summary)
+		assert.Equal(t, strings.Repeat("line one\nline two ", 5), ev.Description)
+		assert.True(t, ev.Start.Equal(start))
+		assert.True(t, ev.End.Equal(start.Add(time.Hour)))
+	})
+
+	t.Run("handles time zones, durations, alarms and bad events", func(t *testing.T) {
+		input := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"BEGIN:VEVENT",
+			"UID:tz",
+			"DTSTART;TZID=\"Africa/Lagos\":20261102T100000",
+			"DURATION:PT1H30M",
+			"BEGIN:VALARM",
+			"TRIGGER:-PT15M",
+			"DTSTART:20000101T000000Z",
+			"END:VALARM",
+			"RRULE:FREQ=WEEKLY",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:broken",
+			"DTSTART:20261102T100000Z",
+			"END:VEVENT",
+			"END:VCALENDAR",
+		}, "\r\n")
+
+		events, err := Decode(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+
+		assert.NoError(t, events[0].Err)
+		assert.True(t, events[0].Start.Equal(time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)))
+		assert.Equal(t, 90*time.Minute, events[0].End.Sub(events[0].Start))
+		assert.True(t, events[0].Recurring)
+
+		assert.EqualError(t, events[1].Err, "missing DTEND or DURATION")
+	})
+
+	t.Run("rejects input that is not a calendar", func(t *testing.T) {
+		_, err := Decode(strings.NewReader("hello"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1 @@
+DROP TABLE denylist_entries;
\ No newline at end of file
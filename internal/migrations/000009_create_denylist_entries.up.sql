@@ -0,0 +1,8 @@
+CREATE TABLE denylist_entries (
+    id UUID PRIMARY KEY,
+    kind TEXT NOT NULL CHECK (kind IN ('email', 'ip')),
+    value TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (kind, value)
+);
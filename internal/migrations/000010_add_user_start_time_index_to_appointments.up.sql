@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_appointments_user_start_time
+ON appointments (user_id, start_time)
+WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_appointments_search_vector;
+
+ALTER TABLE appointments DROP COLUMN IF EXISTS search_vector;
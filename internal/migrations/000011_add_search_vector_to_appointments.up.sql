@@ -0,0 +1,10 @@
+ALTER TABLE appointments
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(contact_name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B') ||
+    setweight(to_tsvector('english', translate(contact_email, '@.+_-', '     ')), 'C')
+) STORED;
+
+CREATE INDEX idx_appointments_search_vector
+ON appointments USING GIN (search_vector);
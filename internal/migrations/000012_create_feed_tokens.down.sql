@@ -0,0 +1 @@
+DROP TABLE feed_tokens;
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_appointments_user_ical_uid;
+
+ALTER TABLE appointments DROP COLUMN IF EXISTS ical_uid;
@@ -0,0 +1,5 @@
+ALTER TABLE appointments ADD COLUMN ical_uid TEXT;
+
+CREATE UNIQUE INDEX idx_appointments_user_ical_uid
+ON appointments (user_id, ical_uid)
+WHERE ical_uid IS NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE feed_tokens DROP COLUMN IF EXISTS scope;
+
+DROP INDEX IF EXISTS idx_appointments_user_resource_name;
+
+ALTER TABLE appointments DROP COLUMN IF EXISTS resource_name;
\ No newline at end of file
@@ -0,0 +1,8 @@
+ALTER TABLE appointments ADD COLUMN resource_name TEXT;
+
+CREATE UNIQUE INDEX idx_appointments_user_resource_name
+ON appointments (user_id, resource_name)
+WHERE resource_name IS NOT NULL;
+
+ALTER TABLE feed_tokens
+ADD COLUMN scope TEXT NOT NULL DEFAULT 'feed' CHECK (scope IN ('feed', 'caldav'));
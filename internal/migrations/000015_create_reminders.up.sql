@@ -0,0 +1,15 @@
+CREATE TABLE reminders (
+    id UUID PRIMARY KEY,
+    appointment_id UUID NOT NULL REFERENCES appointments(id),
+    offset_seconds INTEGER NOT NULL,
+    send_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sending', 'sent', 'cancelled', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (appointment_id, offset_seconds)
+);
+
+CREATE INDEX idx_reminders_due ON reminders (send_at) WHERE status IN ('pending', 'sending');
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS outbox_cursors;
+
+DROP TABLE IF EXISTS outbox_events;
\ No newline at end of file
@@ -0,0 +1,25 @@
+CREATE TABLE outbox_events (
+    id UUID PRIMARY KEY,
+    seq BIGSERIAL NOT NULL UNIQUE,
+    txid BIGINT NOT NULL DEFAULT pg_current_xact_id()::text::bigint,
+    aggregate_type TEXT NOT NULL,
+    aggregate_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_outbox_events_position ON outbox_events (txid, seq);
+CREATE INDEX idx_outbox_events_aggregate ON outbox_events (aggregate_id);
+
+CREATE TABLE outbox_cursors (
+    sink TEXT PRIMARY KEY,
+    position_txid BIGINT NOT NULL DEFAULT 0,
+    position_seq BIGINT NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    locked_by TEXT,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
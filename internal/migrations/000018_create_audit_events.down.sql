@@ -0,0 +1,9 @@
+DROP FUNCTION IF EXISTS audit_event_hash(audit_events);
+
+DROP TABLE IF EXISTS audit_events;
+
+DROP FUNCTION IF EXISTS audit_events_append_only();
+
+DROP FUNCTION IF EXISTS audit_events_chain();
+
+DROP FUNCTION IF EXISTS audit_snapshot_digest(JSONB);
\ No newline at end of file
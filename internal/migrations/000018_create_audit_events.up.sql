@@ -0,0 +1,75 @@
+CREATE TABLE audit_events (
+    seq BIGINT PRIMARY KEY,
+    id UUID NOT NULL UNIQUE,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    actor TEXT NOT NULL,
+    actor_ip TEXT,
+    procedure TEXT NOT NULL,
+    entity_type TEXT NOT NULL,
+    entity_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    before JSONB,
+    after JSONB,
+    before_digest TEXT,
+    after_digest TEXT,
+    prev_hash TEXT,
+    hash TEXT NOT NULL
+);
+
+CREATE INDEX idx_audit_events_entity ON audit_events (entity_id, seq);
+
+CREATE FUNCTION audit_snapshot_digest(snapshot JSONB) RETURNS TEXT AS $$
+    SELECT encode(sha256(convert_to(snapshot::text, 'UTF8')), 'hex')
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE FUNCTION audit_event_hash(e audit_events) RETURNS TEXT AS $$
+    SELECT encode(sha256(convert_to(jsonb_build_array(
+        e.seq,
+        e.prev_hash,
+        e.id,
+        (extract(epoch FROM e.occurred_at) * 1000000)::bigint,
+        e.actor,
+        e.actor_ip,
+        e.procedure,
+        e.entity_type,
+        e.entity_id,
+        e.action,
+        e.before_digest,
+        e.after_digest
+    )::text, 'UTF8')), 'hex')
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE FUNCTION audit_events_chain() RETURNS TRIGGER AS $$
+DECLARE
+    previous audit_events;
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('audit_events'));
+
+    SELECT * INTO previous FROM audit_events ORDER BY seq DESC LIMIT 1;
+
+    NEW.seq := COALESCE(previous.seq, 0) + 1;
+    NEW.prev_hash := previous.hash;
+    NEW.before_digest := audit_snapshot_digest(NEW.before);
+    NEW.after_digest := audit_snapshot_digest(NEW.after);
+    NEW.hash := audit_event_hash(NEW);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_chain
+BEFORE INSERT ON audit_events
+FOR EACH ROW EXECUTE FUNCTION audit_events_chain();
+
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+BEFORE UPDATE OR DELETE ON audit_events
+FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+CREATE TRIGGER audit_events_no_truncate
+BEFORE TRUNCATE ON audit_events
+FOR EACH STATEMENT EXECUTE FUNCTION audit_events_append_only();
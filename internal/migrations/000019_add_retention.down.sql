@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS archived_appointments;
+
+DROP INDEX IF EXISTS idx_appointments_deleted_at;
+
+ALTER TABLE appointments DROP COLUMN IF EXISTS legal_hold;
+
+ALTER TABLE users DROP COLUMN IF EXISTS legal_hold;
\ No newline at end of file
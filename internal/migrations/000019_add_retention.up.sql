@@ -0,0 +1,15 @@
+ALTER TABLE users ADD COLUMN legal_hold BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE appointments ADD COLUMN legal_hold BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_appointments_deleted_at ON appointments (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE archived_appointments (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    archived_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    appointment JSONB NOT NULL
+);
+
+CREATE INDEX idx_archived_appointments_user_id ON archived_appointments (user_id);
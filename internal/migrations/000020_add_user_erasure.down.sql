@@ -0,0 +1,13 @@
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE audit_events DROP COLUMN IF EXISTS redacted_at;
+
+ALTER TABLE users DROP COLUMN IF EXISTS erased_at;
+
+UPDATE users SET email = id::text || '@erased.invalid' WHERE email IS NULL;
+
+ALTER TABLE users ALTER COLUMN email SET NOT NULL;
\ No newline at end of file
@@ -0,0 +1,21 @@
+ALTER TABLE users ALTER COLUMN email DROP NOT NULL;
+
+ALTER TABLE users ADD COLUMN erased_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE audit_events ADD COLUMN redacted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND NEW.redacted_at IS NOT NULL
+        AND (NEW.seq, NEW.id, NEW.occurred_at, NEW.actor, NEW.actor_ip, NEW.procedure, NEW.entity_type,
+            NEW.entity_id, NEW.action, NEW.before_digest, NEW.after_digest, NEW.prev_hash, NEW.hash)
+        IS NOT DISTINCT FROM (OLD.seq, OLD.id, OLD.occurred_at, OLD.actor, OLD.actor_ip, OLD.procedure, OLD.entity_type,
+            OLD.entity_id, OLD.action, OLD.before_digest, OLD.after_digest, OLD.prev_hash, OLD.hash)
+    THEN
+        RETURN NEW;
+    END IF;
+
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
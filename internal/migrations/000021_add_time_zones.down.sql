@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS schedule;
+
+ALTER TABLE appointments DROP COLUMN IF EXISTS time_zone;
+
+ALTER TABLE users DROP COLUMN IF EXISTS time_zone;
+
+ALTER TABLE appointments
+ALTER COLUMN deleted_at TYPE TIMESTAMP USING deleted_at AT TIME ZONE 'UTC';
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE appointments
+ALTER COLUMN deleted_at TYPE TIMESTAMP WITH TIME ZONE USING deleted_at AT TIME ZONE 'UTC';
+
+ALTER TABLE users ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'UTC';
+
+ALTER TABLE appointments ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'UTC';
+
+CREATE TABLE schedule (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO schedule DEFAULT VALUES;
@@ -0,0 +1,10 @@
+ALTER TABLE schedule
+DROP CONSTRAINT IF EXISTS schedule_duration_range,
+DROP CONSTRAINT IF EXISTS schedule_advance_covers_notice,
+DROP COLUMN IF EXISTS min_notice_seconds,
+DROP COLUMN IF EXISTS max_advance_seconds,
+DROP COLUMN IF EXISTS min_duration_seconds,
+DROP COLUMN IF EXISTS max_duration_seconds,
+DROP COLUMN IF EXISTS slot_alignment_seconds,
+DROP COLUMN IF EXISTS max_per_day,
+DROP COLUMN IF EXISTS max_per_week;
\ No newline at end of file
@@ -0,0 +1,12 @@
+ALTER TABLE schedule
+ADD COLUMN min_notice_seconds INTEGER NOT NULL DEFAULT 0 CHECK (min_notice_seconds >= 0),
+ADD COLUMN max_advance_seconds INTEGER NOT NULL DEFAULT 0 CHECK (max_advance_seconds >= 0),
+ADD COLUMN min_duration_seconds INTEGER NOT NULL DEFAULT 0 CHECK (min_duration_seconds >= 0),
+ADD COLUMN max_duration_seconds INTEGER NOT NULL DEFAULT 0 CHECK (max_duration_seconds >= 0),
+ADD COLUMN slot_alignment_seconds INTEGER NOT NULL DEFAULT 0 CHECK (slot_alignment_seconds >= 0),
+ADD COLUMN max_per_day INTEGER NOT NULL DEFAULT 0 CHECK (max_per_day >= 0),
+ADD COLUMN max_per_week INTEGER NOT NULL DEFAULT 0 CHECK (max_per_week >= 0),
+ADD CONSTRAINT schedule_advance_covers_notice
+    CHECK (max_advance_seconds = 0 OR max_advance_seconds >= min_notice_seconds),
+ADD CONSTRAINT schedule_duration_range
+    CHECK (max_duration_seconds = 0 OR max_duration_seconds >= min_duration_seconds);
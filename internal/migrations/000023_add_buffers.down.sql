@@ -0,0 +1,19 @@
+ALTER TABLE appointments
+DROP CONSTRAINT IF EXISTS no_overlapping_active_appointments;
+
+ALTER TABLE appointments
+ADD CONSTRAINT no_overlapping_active_appointments
+EXCLUDE USING gist (
+    tstzrange(start_time, end_time) WITH &&
+)
+WHERE (deleted_at IS NULL);
+
+DROP FUNCTION IF EXISTS appointment_busy_range;
+
+ALTER TABLE appointments
+DROP COLUMN IF EXISTS buffer_before_seconds,
+DROP COLUMN IF EXISTS buffer_after_seconds;
+
+ALTER TABLE schedule
+DROP COLUMN IF EXISTS buffer_before_seconds,
+DROP COLUMN IF EXISTS buffer_after_seconds;
\ No newline at end of file
@@ -0,0 +1,29 @@
+ALTER TABLE schedule
+ADD COLUMN buffer_before_seconds INTEGER NOT NULL DEFAULT 0 CHECK (buffer_before_seconds >= 0),
+ADD COLUMN buffer_after_seconds INTEGER NOT NULL DEFAULT 0 CHECK (buffer_after_seconds >= 0);
+
+ALTER TABLE appointments
+ADD COLUMN buffer_before_seconds INTEGER NOT NULL DEFAULT 0 CHECK (buffer_before_seconds >= 0),
+ADD COLUMN buffer_after_seconds INTEGER NOT NULL DEFAULT 0 CHECK (buffer_after_seconds >= 0);
+
+CREATE FUNCTION appointment_busy_range(
+    start_time TIMESTAMP WITH TIME ZONE,
+    end_time TIMESTAMP WITH TIME ZONE,
+    buffer_before_seconds INTEGER,
+    buffer_after_seconds INTEGER
+) RETURNS tstzrange AS $$
+    SELECT tstzrange(
+        start_time - make_interval(secs => buffer_before_seconds),
+        end_time + make_interval(secs => buffer_after_seconds)
+    );
+$$ LANGUAGE SQL IMMUTABLE;
+
+ALTER TABLE appointments
+DROP CONSTRAINT IF EXISTS no_overlapping_active_appointments;
+
+ALTER TABLE appointments
+ADD CONSTRAINT no_overlapping_active_appointments
+EXCLUDE USING gist (
+    appointment_busy_range(start_time, end_time, buffer_before_seconds, buffer_after_seconds) WITH &&
+)
+WHERE (deleted_at IS NULL);
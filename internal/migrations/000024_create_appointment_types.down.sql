@@ -0,0 +1,3 @@
+ALTER TABLE appointments DROP COLUMN IF EXISTS appointment_type_id;
+
+DROP TABLE IF EXISTS appointment_types;
\ No newline at end of file
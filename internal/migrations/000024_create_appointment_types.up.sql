@@ -0,0 +1,19 @@
+CREATE TABLE appointment_types (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    duration_seconds INTEGER NOT NULL CHECK (duration_seconds > 0),
+    buffer_before_seconds INTEGER CHECK (buffer_before_seconds >= 0),
+    buffer_after_seconds INTEGER CHECK (buffer_after_seconds >= 0),
+    color TEXT NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_appointment_types_name ON appointment_types (lower(name));
+
+ALTER TABLE appointments
+ADD COLUMN appointment_type_id UUID REFERENCES appointment_types(id);
+
+CREATE INDEX idx_appointments_appointment_type_id ON appointments (appointment_type_id);
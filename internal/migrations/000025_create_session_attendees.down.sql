@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS session_attendees;
+
+ALTER TABLE appointments DROP COLUMN IF EXISTS capacity;
\ No newline at end of file
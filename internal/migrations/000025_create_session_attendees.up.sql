@@ -0,0 +1,11 @@
+ALTER TABLE appointments
+ADD COLUMN capacity INTEGER CHECK (capacity > 0);
+
+CREATE TABLE session_attendees (
+    appointment_id UUID NOT NULL REFERENCES appointments(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id),
+    joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (appointment_id, user_id)
+);
+
+CREATE INDEX idx_session_attendees_user_id ON session_attendees (user_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS appointment_participants;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE appointment_participants (
+    appointment_id UUID NOT NULL REFERENCES appointments(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id),
+    role TEXT NOT NULL CHECK (role IN ('organizer', 'required', 'optional')),
+    rsvp_status TEXT NOT NULL DEFAULT 'needs_action' CHECK (rsvp_status IN ('needs_action', 'accepted', 'declined', 'tentative')),
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (appointment_id, user_id)
+);
+
+CREATE INDEX idx_appointment_participants_user_id ON appointment_participants (user_id);
+
+CREATE UNIQUE INDEX idx_appointment_participants_organizer ON appointment_participants (appointment_id) WHERE role = 'organizer';
+
+INSERT INTO appointment_participants (appointment_id, user_id, role, rsvp_status, responded_at, created_at)
+SELECT id, user_id, 'organizer', 'accepted', created_at, created_at FROM appointments;
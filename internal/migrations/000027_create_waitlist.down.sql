@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS waitlist_offers;
+
+DROP TABLE IF EXISTS waitlist_entries;
+
+ALTER TABLE schedule DROP COLUMN IF EXISTS waitlist_offer_seconds;
\ No newline at end of file
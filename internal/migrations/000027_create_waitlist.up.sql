@@ -0,0 +1,38 @@
+ALTER TABLE schedule
+ADD COLUMN waitlist_offer_seconds INTEGER NOT NULL DEFAULT 900 CHECK (waitlist_offer_seconds > 0);
+
+CREATE TABLE waitlist_entries (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id),
+    window_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    window_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'waiting' CHECK (status IN ('waiting', 'booked', 'cancelled')),
+    appointment_id UUID REFERENCES appointments(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT waitlist_window_order CHECK (window_end > window_start)
+);
+
+CREATE INDEX idx_waitlist_entries_waiting ON waitlist_entries (created_at, id) WHERE status = 'waiting';
+
+CREATE INDEX idx_waitlist_entries_user_id ON waitlist_entries (user_id);
+
+CREATE TABLE waitlist_offers (
+    id UUID PRIMARY KEY,
+    entry_id UUID NOT NULL REFERENCES waitlist_entries(id) ON DELETE CASCADE,
+    start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'expired', 'withdrawn')),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    responded_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT no_overlapping_pending_offers
+    EXCLUDE USING gist (tstzrange(start_time, end_time) WITH &&)
+    WHERE (status = 'pending')
+);
+
+CREATE UNIQUE INDEX idx_waitlist_offers_one_pending ON waitlist_offers (entry_id) WHERE status = 'pending';
+
+CREATE INDEX idx_waitlist_offers_expires_at ON waitlist_offers (expires_at) WHERE status = 'pending';
@@ -0,0 +1,7 @@
+DELETE FROM appointments WHERE hold_expires_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_appointments_hold_expires_at;
+
+ALTER TABLE appointments DROP COLUMN IF EXISTS hold_expires_at;
+
+ALTER TABLE schedule DROP COLUMN IF EXISTS hold_seconds;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE schedule
+ADD COLUMN hold_seconds INTEGER NOT NULL DEFAULT 600 CHECK (hold_seconds > 0);
+
+ALTER TABLE appointments ADD COLUMN hold_expires_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_appointments_hold_expires_at ON appointments (hold_expires_at) WHERE hold_expires_at IS NOT NULL;
@@ -0,0 +1,13 @@
+CREATE TABLE appointment_reschedules (
+    id UUID PRIMARY KEY,
+    appointment_id UUID NOT NULL REFERENCES appointments(id) ON DELETE CASCADE,
+    previous_start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    previous_end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_appointment_reschedules_appointment_id ON appointment_reschedules (appointment_id, created_at);
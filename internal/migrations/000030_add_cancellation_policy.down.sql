@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_appointments_late_cancellations;
+
+ALTER TABLE appointments
+DROP COLUMN IF EXISTS cancelled_late,
+DROP COLUMN IF EXISTS cancelled_by,
+DROP COLUMN IF EXISTS cancellation_note,
+DROP COLUMN IF EXISTS cancellation_reason;
+
+ALTER TABLE schedule
+DROP COLUMN IF EXISTS late_cancellation_seconds,
+DROP COLUMN IF EXISTS cancellation_cutoff_seconds;
\ No newline at end of file
@@ -0,0 +1,11 @@
+ALTER TABLE schedule
+ADD COLUMN cancellation_cutoff_seconds INTEGER NOT NULL DEFAULT 0 CHECK (cancellation_cutoff_seconds >= 0),
+ADD COLUMN late_cancellation_seconds INTEGER NOT NULL DEFAULT 0 CHECK (late_cancellation_seconds >= 0);
+
+ALTER TABLE appointments
+ADD COLUMN cancellation_reason TEXT CHECK (cancellation_reason IN ('schedule_conflict', 'illness', 'no_longer_needed', 'provider_unavailable', 'other')),
+ADD COLUMN cancellation_note TEXT NOT NULL DEFAULT '',
+ADD COLUMN cancelled_by TEXT CHECK (cancelled_by IN ('client', 'provider')),
+ADD COLUMN cancelled_late BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_appointments_late_cancellations ON appointments (user_id) WHERE cancelled_late;
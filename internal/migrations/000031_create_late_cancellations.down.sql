@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_appointments_late_cancellations ON appointments (user_id) WHERE cancelled_late;
+
+DROP TABLE IF EXISTS late_cancellations;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE late_cancellations (
+    id UUID PRIMARY KEY,
+    appointment_id UUID REFERENCES appointments(id) ON DELETE SET NULL,
+    user_id UUID NOT NULL REFERENCES users(id),
+    cancelled_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_late_cancellations_user_id ON late_cancellations (user_id, cancelled_at);
+
+INSERT INTO late_cancellations (id, appointment_id, user_id, cancelled_at)
+SELECT gen_random_uuid(), id, user_id, deleted_at
+FROM appointments
+WHERE cancelled_late AND deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_appointments_late_cancellations;
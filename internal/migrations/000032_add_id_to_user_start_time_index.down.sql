@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_appointments_user_start_time_id;
+
+CREATE INDEX IF NOT EXISTS idx_appointments_user_start_time
+ON appointments (user_id, start_time)
+WHERE deleted_at IS NULL;
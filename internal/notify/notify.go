@@ -0,0 +1,157 @@
+// Package notify emails appointment contacts when their bookings are created,
+// changed or cancelled.
+package notify
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/textproto"
+	"sync"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+)
+
+type Kind string
+
+const (
+	KindConfirmation Kind = "confirmation"
+	KindUpdate       Kind = "update"
+	KindCancellation Kind = "cancellation"
+	KindReminder     Kind = "reminder"
+	// KindWaitlistOffer tells someone on the waitlist that a slot has been
+	// freed for them. The appointment it renders is the offered slot, with
+	// the offer's id.
+	KindWaitlistOffer Kind = "waitlist_offer"
+)
+
+var kinds = []Kind{KindConfirmation, KindUpdate, KindCancellation, KindReminder, KindWaitlistOffer}
+
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Sender delivers a rendered message.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+type Options struct {
+	// Attempts is how many times delivery is tried before giving up.
+	Attempts int
+	// Backoff is the delay before the first retry; it doubles after each
+	// failed attempt.
+	Backoff time.Duration
+	// Timeout bounds each delivery attempt.
+	Timeout time.Duration
+}
+
+// Notifier renders and delivers notifications in the background so RPCs
+// don't wait on the mail server.
+type Notifier struct {
+	sender    Sender
+	templates *Templates
+	opts      Options
+	wg        sync.WaitGroup
+}
+
+func New(sender Sender, templates *Templates, opts Options) *Notifier {
+	if opts.Attempts <= 0 {
+		opts.Attempts = 5
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = 2 * time.Second
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 30 * time.Second
+	}
+
+	return &Notifier{sender: sender, templates: templates, opts: opts}
+}
+
+// Notify queues a notification of kind to appt's contact. Failures are
+// logged; they never affect the caller.
+func (n *Notifier) Notify(kind Kind, appt *pb.Appointment) {
+	if appt.GetContactInformation().GetEmail() == "" {
+		return
+	}
+
+	msg, err := n.templates.Render(kind, appt)
+	if err != nil {
+		log.Printf("Error rendering %s notification for appointment %s: %v", kind, appt.Id, err)
+		return
+	}
+
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		if err := n.deliver(context.Background(), msg); err != nil {
+			log.Printf("Error sending %s notification for appointment %s: %v", kind, appt.Id, err)
+		}
+	}()
+}
+
+// Send renders and delivers a notification synchronously, for callers that
+// track delivery themselves.
+func (n *Notifier) Send(ctx context.Context, kind Kind, appt *pb.Appointment) error {
+	msg, err := n.templates.Render(kind, appt)
+	if err != nil {
+		return err
+	}
+
+	return n.deliver(ctx, msg)
+}
+
+// Wait blocks until every queued notification has been delivered or has
+// given up.
+func (n *Notifier) Wait() {
+	n.wg.Wait()
+}
+
+func (n *Notifier) deliver(ctx context.Context, msg *Message) error {
+	backoff := n.opts.Backoff
+
+	var err error
+	for attempt := 1; attempt <= n.opts.Attempts; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, n.opts.Timeout)
+		err = n.sender.Send(attemptCtx, msg)
+		cancel()
+
+		if err == nil || Permanent(err) {
+			return err
+		}
+		if attempt == n.opts.Attempts {
+			break
+		}
+
+		log.Printf("Notification to %s failed (attempt %d of %d), retrying in %s: %v", msg.To, attempt, n.opts.Attempts, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+
+	return err
+}
+
+// Permanent reports whether retrying err is pointless: the server rejected
+// the message outright (SMTP 5xx) rather than deferring it.
+func Permanent(err error) bool {
+	var protoErr *textproto.Error
+	return errors.As(err, &protoErr) && protoErr.Code >= 500
+}
+
+// LogSender writes messages to the log instead of sending them, for running
+// without a mail server.
+type LogSender struct{}
+
+func (LogSender) Send(ctx context.Context, msg *Message) error {
+	log.Printf("Notification to %s: %s\n%s", msg.To, msg.Subject, msg.Text)
+	return nil
+}
@@ -0,0 +1,244 @@
+package notify
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// smtpStandIn is a minimal in-process SMTP server that records what it
+// receives. The first failures MAIL commands are answered with failCode.
+type smtpStandIn struct {
+	ln       net.Listener
+	mu       sync.Mutex
+	failures int
+	failCode string
+	attempts int
+	messages []received
+}
+
+type received struct {
+	from, to string
+	data     []byte
+}
+
+func newSMTPStandIn(t *testing.T) *smtpStandIn {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &smtpStandIn{ln: ln}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *smtpStandIn) serve(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost ESMTP stand-in")
+
+	var msg received
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL":
+			s.mu.Lock()
+			s.attempts++
+			fail := s.failures > 0
+			if fail {
+				s.failures--
+			}
+			s.mu.Unlock()
+
+			if fail {
+				tp.PrintfLine("%s try again later", s.failCode)
+				continue
+			}
+			msg = received{from: strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")}
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			msg.to = strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>")
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			msg.data = data
+
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("250 OK")
+		}
+	}
+}
+
+func (s *smtpStandIn) sender() *SMTPSender {
+	return &SMTPSender{
+		Addr: s.ln.Addr().String(),
+		From: mail.Address{Name: "Appointments", Address: "bookings@example.com"},
+	}
+}
+
+func testAppointment() *pb.Appointment {
+	start := time.Date(2026, 11, 2, 9, 30, 0, 0, time.UTC)
+	return &pb.Appointment{
+		Id:          "appt-1",
+		Title:       "Dental <checkup>",
+		Description: "Bring your insurance card",
+		ContactInformation: &pb.ContactInformation{
+			Name:  "Ada",
+			Email: "ada@example.com",
+		},
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.Add(time.Hour)),
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	t.Run("renders the built-in templates", func(t *testing.T) {
+		templates, err := LoadTemplates("")
+		require.NoError(t, err)
+
+		for _, kind := range kinds {
+			msg, err := templates.Render(kind, testAppointment())
+			require.NoError(t, err, kind)
+
+			assert.Equal(t, "ada@example.com", msg.To)
+			assert.Contains(t, msg.Subject, "Dental <checkup>")
+			assert.Contains(t, msg.Subject, "2 Nov 2026")
+			assert.Contains(t, msg.Text, "Hi Ada,")
+			assert.Contains(t, msg.Text, "Mon, 2 Nov 2026 09:30 UTC")
+			assert.Contains(t, msg.HTML, "Dental &lt;checkup&gt;")
+		}
+	})
+
+	t.Run("renders times in the appointment's zone", func(t *testing.T) {
+		templates, err := LoadTemplates("")
+		require.NoError(t, err)
+
+		appt := testAppointment()
+		appt.TimeZone = "America/New_York"
+
+		msg, err := templates.Render(KindReminder, appt)
+		require.NoError(t, err)
+		assert.Contains(t, msg.Text, "Mon, 2 Nov 2026 04:30 EST")
+	})
+
+	t.Run("files in the template directory override the defaults", func(t *testing.T) {
+		dir := t.TempDir()
+		override := `{{define "subject"}}See you soon, {{.Name}}{{end}}Booked: {{.Title}}`
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "confirmation.txt.tmpl"), []byte(override), 0o644))
+
+		templates, err := LoadTemplates(dir)
+		require.NoError(t, err)
+
+		msg, err := templates.Render(KindConfirmation, testAppointment())
+		require.NoError(t, err)
+		assert.Equal(t, "See you soon, Ada", msg.Subject)
+		assert.Equal(t, "Booked: Dental <checkup>", msg.Text)
+		assert.Contains(t, msg.HTML, "Your appointment is confirmed.")
+	})
+
+	t.Run("rejects templates without a subject", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "update.txt.tmpl"), []byte("no subject"), 0o644))
+
+		_, err := LoadTemplates(dir)
+		assert.Error(t, err)
+	})
+}
+
+func TestSMTPDelivery(t *testing.T) {
+	templates, err := LoadTemplates("")
+	require.NoError(t, err)
+
+	t.Run("sends a multipart message through the SMTP server", func(t *testing.T) {
+		server := newSMTPStandIn(t)
+		notifier := New(server.sender(), templates, Options{Backoff: time.Millisecond})
+
+		notifier.Notify(KindConfirmation, testAppointment())
+		notifier.Wait()
+
+		require.Len(t, server.messages, 1)
+		got := server.messages[0]
+		assert.Equal(t, "bookings@example.com", got.from)
+		assert.Equal(t, "ada@example.com", got.to)
+
+		msg, err := mail.ReadMessage(strings.NewReader(string(got.data)))
+		require.NoError(t, err)
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Confirmed: Dental <checkup> on 2 Nov 2026", subject)
+		assert.Equal(t, `"Appointments" <bookings@example.com>`, msg.Header.Get("From"))
+		assert.Contains(t, msg.Header.Get("Content-Type"), "multipart/alternative")
+
+		body, err := io.ReadAll(msg.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "text/plain")
+		assert.Contains(t, string(body), "text/html")
+	})
+
+	t.Run("retries temporary failures", func(t *testing.T) {
+		server := newSMTPStandIn(t)
+		server.failures, server.failCode = 2, "451"
+		notifier := New(server.sender(), templates, Options{Attempts: 3, Backoff: time.Millisecond})
+
+		notifier.Notify(KindCancellation, testAppointment())
+		notifier.Wait()
+
+		assert.Equal(t, 3, server.attempts)
+		assert.Len(t, server.messages, 1)
+	})
+
+	t.Run("gives up on permanent failures", func(t *testing.T) {
+		server := newSMTPStandIn(t)
+		server.failures, server.failCode = 5, "550"
+		notifier := New(server.sender(), templates, Options{Attempts: 3, Backoff: time.Millisecond})
+
+		err := notifier.deliver(context.Background(), &Message{To: "ada@example.com"})
+		assert.Error(t, err)
+		assert.Equal(t, 1, server.attempts)
+		assert.Empty(t, server.messages)
+	})
+}
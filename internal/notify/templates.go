@@ -0,0 +1,137 @@
+package notify
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/timezone"
+	pb "github.com/folucode/appointment-scheduler/proto"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// Templates renders each kind of notification as a text and an HTML body.
+// The text template also defines a "subject" template for the subject line.
+type Templates struct {
+	text map[Kind]*texttemplate.Template
+	html map[Kind]*htmltemplate.Template
+}
+
+var templateFuncs = map[string]any{
+	"formatTime": func(t time.Time) string { return t.Format("Mon, 2 Jan 2006 15:04 MST") },
+	"formatDate": func(t time.Time) string { return t.Format("2 Jan 2006") },
+}
+
+// LoadTemplates parses the built-in templates, replacing any of them with a
+// file of the same name (e.g. "confirmation.html.tmpl") found in dir. An
+// empty dir uses the built-in templates only.
+func LoadTemplates(dir string) (*Templates, error) {
+	t := &Templates{
+		text: make(map[Kind]*texttemplate.Template),
+		html: make(map[Kind]*htmltemplate.Template),
+	}
+
+	for _, kind := range kinds {
+		src, err := readTemplate(dir, string(kind)+".txt.tmpl")
+		if err != nil {
+			return nil, err
+		}
+		text, err := texttemplate.New(string(kind)).Funcs(templateFuncs).Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s text template: %w", kind, err)
+		}
+		if text.Lookup("subject") == nil {
+			return nil, fmt.Errorf("%s text template does not define a subject", kind)
+		}
+		t.text[kind] = text
+
+		src, err = readTemplate(dir, string(kind)+".html.tmpl")
+		if err != nil {
+			return nil, err
+		}
+		html, err := htmltemplate.New(string(kind)).Funcs(templateFuncs).Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s html template: %w", kind, err)
+		}
+		t.html[kind] = html
+	}
+
+	return t, nil
+}
+
+func readTemplate(dir, name string) (string, error) {
+	if dir != "" {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err == nil {
+			return string(data), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+
+	data, err := defaultTemplates.ReadFile("templates/" + name)
+	return string(data), err
+}
+
+// templateData is what the templates see.
+type templateData struct {
+	ID          string
+	Name        string
+	Title       string
+	Description string
+	Start       time.Time
+	End         time.Time
+}
+
+// Render builds the message for kind about appt, addressed to its contact.
+func (t *Templates) Render(kind Kind, appt *pb.Appointment) (*Message, error) {
+	text, ok := t.text[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown notification kind %q", kind)
+	}
+
+	// Times read in the zone the appointment was booked in. Appointments
+	// from before zones were recorded carry none and stay in UTC.
+	loc, err := timezone.Load(appt.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	data := templateData{
+		ID:          appt.Id,
+		Name:        appt.ContactInformation.Name,
+		Title:       appt.Title,
+		Description: appt.Description,
+		Start:       appt.StartTime.AsTime().In(loc),
+		End:         appt.EndTime.AsTime().In(loc),
+	}
+
+	var subject, textBody, htmlBody bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	if err := text.Execute(&textBody, data); err != nil {
+		return nil, err
+	}
+	if err := t.html[kind].Execute(&htmlBody, data); err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		To:      appt.ContactInformation.Email,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    textBody.String(),
+		HTML:    htmlBody.String(),
+	}, nil
+}
@@ -0,0 +1,162 @@
+// Package outbox publishes the domain events recorded in the outbox table to
+// sinks such as email notifications and webhooks.
+//
+// Delivery is at least once: an event a sink accepted may be published to it
+// again if the relay stops before recording that, so sinks should tolerate
+// seeing an event id twice. Each sink receives events in the order their
+// changes were committed, and a sink that fails is retried with backoff
+// before it is given anything newer.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/google/uuid"
+)
+
+// Sink receives outbox events. Publish returns an error to have the event
+// retried later; a sink that cannot ever handle an event should log it and
+// return nil, or it will hold back every event after it.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, event *db.OutboxEvent) error
+}
+
+// Store is the part of the database the relay uses.
+type Store interface {
+	ClaimOutboxEvents(ctx context.Context, sink, owner string, limit int, lease time.Duration) (*db.OutboxBatch, error)
+	AckOutboxEvent(ctx context.Context, batch *db.OutboxBatch, event *db.OutboxEvent) error
+	NackOutboxEvent(ctx context.Context, batch *db.OutboxBatch, lastError string, retryAt time.Time) error
+}
+
+type Options struct {
+	// Interval is how often the relay polls for new events.
+	Interval time.Duration
+	// BatchSize caps how many events are published to a sink per poll.
+	BatchSize int
+	// Lease is how long a sink stays with the relay that last polled it, so
+	// another replica takes over a sink only after its relay stops. It must
+	// comfortably exceed the time it takes to publish a batch.
+	Lease time.Duration
+	// Backoff is the delay before a failed sink is retried; it doubles with
+	// every further failure up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+type Relay struct {
+	store Store
+	sinks []Sink
+	opts  Options
+	owner string
+	now   func() time.Time
+}
+
+func NewRelay(store Store, sinks []Sink, opts Options) *Relay {
+	if opts.Interval <= 0 {
+		opts.Interval = time.Second
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.Lease <= 0 {
+		opts.Lease = 5 * time.Minute
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = 5 * time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 10 * time.Minute
+	}
+
+	return &Relay{store: store, sinks: sinks, opts: opts, owner: uuid.NewString(), now: time.Now}
+}
+
+// Run publishes events until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			published, err := r.RunOnce(ctx)
+			if err != nil {
+				log.Printf("Error relaying outbox events: %v", err)
+			}
+			if err != nil || published == 0 {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce publishes one batch of events to each sink, returning how many
+// events were published in all.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	var published int
+	var errs []error
+
+	for _, sink := range r.sinks {
+		n, err := r.relay(ctx, sink)
+		published += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sink %s: %w", sink.Name(), err))
+		}
+	}
+
+	return published, errors.Join(errs...)
+}
+
+func (r *Relay) relay(ctx context.Context, sink Sink) (int, error) {
+	batch, err := r.store.ClaimOutboxEvents(ctx, sink.Name(), r.owner, r.opts.BatchSize, r.opts.Lease)
+	if err != nil || batch == nil {
+		return 0, err
+	}
+
+	for i, event := range batch.Events {
+		if err := sink.Publish(ctx, event); err != nil {
+			log.Printf("Error publishing %s event %s to %s (attempt %d): %v", event.Type, event.Id, sink.Name(), batch.Attempts+1, err)
+
+			retryAt := r.now().Add(r.backoff(batch.Attempts))
+			if err := r.store.NackOutboxEvent(ctx, batch, truncate(err.Error(), 1000), retryAt); err != nil {
+				return i, err
+			}
+			return i, nil
+		}
+
+		if err := r.store.AckOutboxEvent(ctx, batch, event); err != nil {
+			return i, err
+		}
+		batch.Attempts = 0
+	}
+
+	return len(batch.Events), nil
+}
+
+// backoff is the delay after a sink has already failed attempts times in a
+// row.
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.opts.Backoff
+	for i := 0; i < attempts && delay < r.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, r.opts.MaxBackoff)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
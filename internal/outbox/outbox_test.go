@@ -0,0 +1,126 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStore keeps one position per sink over a shared list of events.
+type fakeStore struct {
+	events    []*db.OutboxEvent
+	positions map[string]int
+	attempts  map[string]int
+	retryAt   map[string]time.Time
+}
+
+func newFakeStore(ids ...string) *fakeStore {
+	s := &fakeStore{positions: map[string]int{}, attempts: map[string]int{}, retryAt: map[string]time.Time{}}
+	for i, id := range ids {
+		s.events = append(s.events, &db.OutboxEvent{Id: id, Sequence: int64(i + 1), Type: "appointment.created"})
+	}
+	return s
+}
+
+func (s *fakeStore) ClaimOutboxEvents(ctx context.Context, sink, owner string, limit int, lease time.Duration) (*db.OutboxBatch, error) {
+	pending := s.events[s.positions[sink]:]
+	pending = pending[:min(limit, len(pending))]
+	return &db.OutboxBatch{Sink: sink, Owner: owner, Attempts: s.attempts[sink], Events: pending}, nil
+}
+
+func (s *fakeStore) AckOutboxEvent(ctx context.Context, batch *db.OutboxBatch, event *db.OutboxEvent) error {
+	s.positions[batch.Sink] = int(event.Sequence)
+	s.attempts[batch.Sink] = 0
+	return nil
+}
+
+func (s *fakeStore) NackOutboxEvent(ctx context.Context, batch *db.OutboxBatch, lastError string, retryAt time.Time) error {
+	s.attempts[batch.Sink]++
+	s.retryAt[batch.Sink] = retryAt
+	return nil
+}
+
+type fakeSink struct {
+	name      string
+	fail      map[string]bool
+	published []string
+}
+
+func (s *fakeSink) Name() string { return s.name }
+
+func (s *fakeSink) Publish(ctx context.Context, event *db.OutboxEvent) error {
+	if s.fail[event.Id] {
+		return errors.New("sink unavailable")
+	}
+	s.published = append(s.published, event.Id)
+	return nil
+}
+
+func TestRelay(t *testing.T) {
+	now := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("publishes events to every sink in order", func(t *testing.T) {
+		store := newFakeStore("a", "b", "c")
+		emails, hooks := &fakeSink{name: "emails"}, &fakeSink{name: "hooks"}
+		relay := NewRelay(store, []Sink{emails, hooks}, Options{})
+
+		published, err := relay.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 6, published)
+		assert.Equal(t, []string{"a", "b", "c"}, emails.published)
+		assert.Equal(t, []string{"a", "b", "c"}, hooks.published)
+	})
+
+	t.Run("a failing event holds back its sink only", func(t *testing.T) {
+		store := newFakeStore("a", "b", "c")
+		emails := &fakeSink{name: "emails", fail: map[string]bool{"b": true}}
+		hooks := &fakeSink{name: "hooks"}
+		relay := NewRelay(store, []Sink{emails, hooks}, Options{Backoff: time.Minute})
+		relay.now = func() time.Time { return now }
+
+		_, err := relay.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a"}, emails.published)
+		assert.Equal(t, []string{"a", "b", "c"}, hooks.published)
+		assert.Equal(t, 1, store.positions["emails"])
+		assert.Equal(t, now.Add(time.Minute), store.retryAt["emails"])
+
+		// The next failure in a row waits twice as long.
+		_, err = relay.RunOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(2*time.Minute), store.retryAt["emails"])
+
+		// Once the sink recovers it carries on from the failed event.
+		emails.fail = nil
+		_, err = relay.RunOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, emails.published)
+		assert.Equal(t, 0, store.attempts["emails"])
+	})
+
+	t.Run("publishes at most a batch per sink at a time", func(t *testing.T) {
+		store := newFakeStore("a", "b", "c")
+		sink := &fakeSink{name: "emails"}
+		relay := NewRelay(store, []Sink{sink}, Options{BatchSize: 2})
+
+		published, err := relay.RunOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, published)
+		assert.Equal(t, []string{"a", "b"}, sink.published)
+	})
+
+	t.Run("caps the backoff", func(t *testing.T) {
+		relay := NewRelay(newFakeStore(), nil, Options{Backoff: time.Minute, MaxBackoff: 10 * time.Minute})
+
+		assert.Equal(t, time.Minute, relay.backoff(0))
+		assert.Equal(t, 8*time.Minute, relay.backoff(3))
+		assert.Equal(t, 10*time.Minute, relay.backoff(30))
+	})
+}
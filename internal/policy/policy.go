@@ -0,0 +1,151 @@
+// Package policy checks bookings against the schedule's booking policy.
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/timezone"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Policy limits the appointments that can be booked. Zero values impose no
+// limit, except that appointments can never start in the past or end before
+// they start.
+type Policy struct {
+	MinNotice     time.Duration
+	MaxAdvance    time.Duration
+	MinDuration   time.Duration
+	MaxDuration   time.Duration
+	SlotAlignment time.Duration
+	MaxPerDay     int
+	MaxPerWeek    int
+}
+
+// FromProto converts p, which may be nil.
+func FromProto(p *pb.BookingPolicy) Policy {
+	return Policy{
+		MinNotice:     p.GetMinNotice().AsDuration(),
+		MaxAdvance:    p.GetMaxAdvance().AsDuration(),
+		MinDuration:   p.GetMinDuration().AsDuration(),
+		MaxDuration:   p.GetMaxDuration().AsDuration(),
+		SlotAlignment: p.GetSlotAlignment().AsDuration(),
+		MaxPerDay:     int(p.GetMaxPerDay()),
+		MaxPerWeek:    int(p.GetMaxPerWeek()),
+	}
+}
+
+func (p Policy) Proto() *pb.BookingPolicy {
+	return &pb.BookingPolicy{
+		MinNotice:     durationpb.New(p.MinNotice),
+		MaxAdvance:    durationpb.New(p.MaxAdvance),
+		MinDuration:   durationpb.New(p.MinDuration),
+		MaxDuration:   durationpb.New(p.MaxDuration),
+		SlotAlignment: durationpb.New(p.SlotAlignment),
+		MaxPerDay:     int32(p.MaxPerDay),
+		MaxPerWeek:    int32(p.MaxPerWeek),
+	}
+}
+
+// Validate checks each rule of p on its own. Whether the rules agree with
+// each other, such as max_duration being at least min_duration, is checked
+// by the database once partial updates have been applied.
+func (p Policy) Validate() error {
+	for _, d := range []time.Duration{p.MinNotice, p.MaxAdvance, p.MinDuration, p.MaxDuration, p.SlotAlignment} {
+		if d < 0 {
+			return errors.New("durations cannot be negative")
+		}
+		if d%time.Second != 0 {
+			return errors.New("durations must be whole seconds")
+		}
+	}
+	if p.MaxPerDay < 0 || p.MaxPerWeek < 0 {
+		return errors.New("quotas cannot be negative")
+	}
+	if p.SlotAlignment > 0 && (24*time.Hour)%p.SlotAlignment != 0 {
+		return errors.New("slot_alignment must divide a day evenly")
+	}
+	return nil
+}
+
+// Booking is an appointment being booked, in the zone it is booked in.
+type Booking struct {
+	Start    time.Time
+	End      time.Time
+	Location *time.Location
+	// BookedThatDay and BookedThatWeek count the user's other active
+	// appointments starting in the Day and Week of Start.
+	BookedThatDay  int
+	BookedThatWeek int
+}
+
+// Day returns the bounds of the day t falls on in loc.
+func Day(t time.Time, loc *time.Location) (time.Time, time.Time) {
+	d := timezone.DateOf(t, loc)
+	return d.Start(loc), d.AddDays(1).Start(loc)
+}
+
+// Week returns the bounds of the Monday-to-Sunday week t falls in in loc.
+func Week(t time.Time, loc *time.Location) (time.Time, time.Time) {
+	d := timezone.DateOf(t, loc)
+	monday := d.AddDays(-((int(d.Weekday()) + 6) % 7))
+	return monday.Start(loc), monday.AddDays(7).Start(loc)
+}
+
+// Check returns every rule of p that b breaks when booked at now.
+func (p Policy) Check(b Booking, now time.Time) []*pb.BookingPolicyViolation {
+	var violations []*pb.BookingPolicyViolation
+	violate := func(rule pb.BookingPolicyRule, format string, args ...any) {
+		violations = append(violations, &pb.BookingPolicyViolation{
+			Rule:        rule,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	switch {
+	case b.Start.Before(now):
+		violate(pb.BookingPolicyRule_BOOKING_POLICY_RULE_MIN_NOTICE, "appointment cannot start in the past")
+	case b.Start.Before(now.Add(p.MinNotice)):
+		violate(pb.BookingPolicyRule_BOOKING_POLICY_RULE_MIN_NOTICE, "appointment must be booked at least %s in advance", p.MinNotice)
+	}
+	if p.MaxAdvance > 0 && b.Start.After(now.Add(p.MaxAdvance)) {
+		violate(pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_ADVANCE, "appointment cannot be booked more than %s in advance", p.MaxAdvance)
+	}
+
+	duration := b.End.Sub(b.Start)
+	switch {
+	case duration <= 0:
+		violate(pb.BookingPolicyRule_BOOKING_POLICY_RULE_MIN_DURATION, "appointment must end after it starts")
+	case duration < p.MinDuration:
+		violate(pb.BookingPolicyRule_BOOKING_POLICY_RULE_MIN_DURATION, "appointment must last at least %s", p.MinDuration)
+	}
+	if p.MaxDuration > 0 && duration > p.MaxDuration {
+		violate(pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_DURATION, "appointment cannot last more than %s", p.MaxDuration)
+	}
+
+	if p.SlotAlignment > 0 && !(aligned(b.Start, b.Location, p.SlotAlignment) && aligned(b.End, b.Location, p.SlotAlignment)) {
+		violate(pb.BookingPolicyRule_BOOKING_POLICY_RULE_SLOT_ALIGNMENT, "appointment must start and end on a multiple of %s", p.SlotAlignment)
+	}
+
+	if p.MaxPerDay > 0 && b.BookedThatDay >= p.MaxPerDay {
+		violate(pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_PER_DAY, "no more than %d appointments can be booked per day", p.MaxPerDay)
+	}
+	if p.MaxPerWeek > 0 && b.BookedThatWeek >= p.MaxPerWeek {
+		violate(pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_PER_WEEK, "no more than %d appointments can be booked per week", p.MaxPerWeek)
+	}
+
+	return violations
+}
+
+// aligned reports whether the wall clock time of t in loc is a multiple of
+// step, so slots fall on the hour even in zones with odd offsets or on days
+// the clocks change.
+func aligned(t time.Time, loc *time.Location, step time.Duration) bool {
+	local := t.In(loc)
+	h, m, s := local.Clock()
+	sinceMidnight := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+		time.Duration(s)*time.Second + time.Duration(local.Nanosecond())
+	return sinceMidnight%step == 0
+}
@@ -0,0 +1,113 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/timezone"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rules(violations []*pb.BookingPolicyViolation) []pb.BookingPolicyRule {
+	var rules []pb.BookingPolicyRule
+	for _, v := range violations {
+		rules = append(rules, v.Rule)
+	}
+	return rules
+}
+
+func TestCheck(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 7, 0, 0, time.UTC)
+	booking := func(start time.Time, length time.Duration) Booking {
+		return Booking{Start: start, End: start.Add(length), Location: time.UTC}
+	}
+
+	t.Run("the zero policy only rejects the past and empty appointments", func(t *testing.T) {
+		var p Policy
+		assert.Empty(t, p.Check(booking(now.Add(time.Minute), 7*time.Minute), now))
+		assert.Empty(t, p.Check(booking(now.AddDate(5, 0, 0), 100*time.Hour), now))
+
+		assert.Equal(t, []pb.BookingPolicyRule{pb.BookingPolicyRule_BOOKING_POLICY_RULE_MIN_NOTICE},
+			rules(p.Check(booking(now.Add(-time.Hour), time.Hour), now)))
+		assert.Equal(t, []pb.BookingPolicyRule{pb.BookingPolicyRule_BOOKING_POLICY_RULE_MIN_DURATION},
+			rules(p.Check(booking(now.Add(time.Hour), 0), now)))
+	})
+
+	t.Run("reports every rule broken", func(t *testing.T) {
+		p := Policy{
+			MinNotice:     2 * time.Hour,
+			MaxAdvance:    30 * 24 * time.Hour,
+			MinDuration:   15 * time.Minute,
+			MaxDuration:   time.Hour,
+			SlotAlignment: 15 * time.Minute,
+			MaxPerDay:     2,
+			MaxPerWeek:    5,
+		}
+
+		b := booking(now.Add(time.Hour), 2*time.Hour)
+		b.BookedThatDay, b.BookedThatWeek = 2, 5
+		assert.Equal(t, []pb.BookingPolicyRule{
+			pb.BookingPolicyRule_BOOKING_POLICY_RULE_MIN_NOTICE,
+			pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_DURATION,
+			pb.BookingPolicyRule_BOOKING_POLICY_RULE_SLOT_ALIGNMENT,
+			pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_PER_DAY,
+			pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_PER_WEEK,
+		}, rules(p.Check(b, now)))
+
+		assert.Equal(t, []pb.BookingPolicyRule{
+			pb.BookingPolicyRule_BOOKING_POLICY_RULE_MAX_ADVANCE,
+			pb.BookingPolicyRule_BOOKING_POLICY_RULE_MIN_DURATION,
+			pb.BookingPolicyRule_BOOKING_POLICY_RULE_SLOT_ALIGNMENT,
+		}, rules(p.Check(booking(time.Date(2026, 12, 1, 9, 0, 0, 0, time.UTC), 5*time.Minute), now)))
+
+		b = booking(time.Date(2026, 10, 20, 9, 45, 0, 0, time.UTC), 30*time.Minute)
+		b.BookedThatDay, b.BookedThatWeek = 1, 4
+		assert.Empty(t, p.Check(b, now))
+	})
+
+	t.Run("aligns slots to the local wall clock", func(t *testing.T) {
+		kathmandu, err := timezone.Load("Asia/Kathmandu")
+		require.NoError(t, err)
+		p := Policy{SlotAlignment: time.Hour}
+
+		// 04:15 UTC is 10:00 in Kathmandu, five hours and forty-five minutes
+		// ahead.
+		start := time.Date(2026, 10, 20, 4, 15, 0, 0, time.UTC)
+		assert.Empty(t, p.Check(Booking{Start: start, End: start.Add(time.Hour), Location: kathmandu}, now))
+		assert.NotEmpty(t, p.Check(Booking{Start: start, End: start.Add(time.Hour), Location: time.UTC}, now))
+	})
+}
+
+func TestWindows(t *testing.T) {
+	newYork, err := timezone.Load("America/New_York")
+	require.NoError(t, err)
+
+	// Sunday evening in New York is already Monday in UTC.
+	sunday := time.Date(2026, 11, 2, 2, 0, 0, 0, time.UTC)
+
+	start, end := Day(sunday, newYork)
+	assert.Equal(t, time.Date(2026, 11, 1, 0, 0, 0, 0, newYork), start)
+	assert.Equal(t, 25*time.Hour, end.Sub(start))
+
+	start, end = Week(sunday, newYork)
+	assert.Equal(t, time.Date(2026, 10, 26, 0, 0, 0, 0, newYork), start)
+	assert.Equal(t, time.Date(2026, 11, 2, 0, 0, 0, 0, newYork), end)
+
+	start, _ = Week(sunday, time.UTC)
+	assert.Equal(t, time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC), start)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Policy{MinNotice: time.Hour, SlotAlignment: 15 * time.Minute, MaxPerDay: 3}.Validate())
+
+	for _, p := range []Policy{
+		{MinNotice: -time.Hour},
+		{MaxDuration: 1500 * time.Millisecond},
+		{SlotAlignment: 7 * time.Hour},
+		{MaxPerWeek: -1},
+	} {
+		assert.Error(t, p.Validate(), "%+v", p)
+	}
+}
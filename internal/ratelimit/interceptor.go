@@ -0,0 +1,199 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	pb "github.com/folucode/appointment-scheduler/proto"
+)
+
+// Denylist reports whether a client IP or contact email has been blocked.
+type Denylist interface {
+	IsDenylisted(ctx context.Context, ip, email string) (bool, error)
+}
+
+type Options struct {
+	Limits   map[string]Limit
+	Denylist Denylist
+
+	// TrustProxy takes the client IP from the last X-Forwarded-For entry,
+	// the one the proxy in front of the server appended. Only enable this
+	// when the server sits behind exactly one proxy that sets the header.
+	TrustProxy bool
+}
+
+// Interceptor rejects requests from denylisted clients and applies the
+// configured per-procedure limits separately to the client IP, the user id
+// and the contact email carried by the request. Only procedures with a limit
+// are checked, so other calls cost nothing. A request only counts against the
+// user and email it names once its IP is within the limit, so a single client
+// cannot use up someone else's budget faster than its own.
+type Interceptor struct {
+	limiter *Limiter
+	opts    Options
+}
+
+func NewInterceptor(limiter *Limiter, opts Options) *Interceptor {
+	return &Interceptor{limiter: limiter, opts: opts}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ip := ClientIP(req, i.opts.TrustProxy)
+		userID, email := requestKeys(req.Any())
+		if err := i.check(ctx, req.Spec().Procedure, ip, userID, email); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler checks a stream once, when it opens, before any
+// message has been read, so only the client IP is known.
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ip := RemoteIP(conn.RequestHeader(), conn.Peer().Addr, i.opts.TrustProxy)
+		if err := i.check(ctx, conn.Spec().Procedure, ip, "", ""); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// Handler applies the checks to plain HTTP requests, such as CalDAV, under
+// the limit configured for name.
+func (i *Interceptor) Handler(name string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := RemoteIP(r.Header, r.RemoteAddr, i.opts.TrustProxy)
+
+		var connectErr *connect.Error
+		if err := i.check(r.Context(), name, ip, "", ""); errors.As(err, &connectErr) {
+			for key, values := range connectErr.Meta() {
+				w.Header()[key] = values
+			}
+			status := http.StatusInternalServerError
+			switch connectErr.Code() {
+			case connect.CodePermissionDenied:
+				status = http.StatusForbidden
+			case connect.CodeResourceExhausted:
+				status = http.StatusTooManyRequests
+			}
+			http.Error(w, connectErr.Message(), status)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// check returns a *connect.Error if ip, or email when known, is denylisted,
+// or if ip, userID or email has used up the limit of procedure. Empty keys
+// are skipped.
+func (i *Interceptor) check(ctx context.Context, procedure, ip, userID, email string) error {
+	limit, ok := limitFor(i.opts.Limits, procedure)
+	if !ok {
+		return nil
+	}
+
+	if i.opts.Denylist != nil {
+		denied, err := i.opts.Denylist.IsDenylisted(ctx, ip, email)
+		if err != nil {
+			log.Printf("Error checking denylist: %v", err)
+			return connect.NewError(connect.CodeInternal, errors.New("failed to process request"))
+		}
+		if denied {
+			return connect.NewError(connect.CodePermissionDenied, errors.New("requests from this client are not allowed"))
+		}
+	}
+
+	keys := [][2]string{{"ip", ip}, {"user", userID}, {"email", email}}
+	for _, key := range keys {
+		if key[1] == "" {
+			continue
+		}
+
+		allowed, wait := i.limiter.Allow(procedure+"|"+key[0]+"|"+key[1], limit)
+		if !allowed {
+			log.Printf("Rate limit exceeded on %s for %s %s", procedure, key[0], key[1])
+			return exhausted(wait)
+		}
+	}
+
+	return nil
+}
+
+func limitFor(limits map[string]Limit, procedure string) (Limit, bool) {
+	if limit, ok := limits[procedure]; ok {
+		return limit, true
+	}
+	limit, ok := limits[path.Base(procedure)]
+	return limit, ok
+}
+
+func exhausted(wait time.Duration) *connect.Error {
+	seconds := int(math.Ceil(wait.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	err := connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("rate limit exceeded, retry in %ds", seconds))
+	err.Meta().Set("Retry-After", strconv.Itoa(seconds))
+	return err
+}
+
+// ClientIP returns the address of the client that sent req, taken from
+// X-Forwarded-For when trustProxy is set.
+func ClientIP(req connect.AnyRequest, trustProxy bool) string {
+	return RemoteIP(req.Header(), req.Peer().Addr, trustProxy)
+}
+
+// RemoteIP returns the client address of a request from remoteAddr, or from
+// the X-Forwarded-For header when trustProxy is set. Only the last entry is
+// used: the client can put anything in the ones before it.
+func RemoteIP(header http.Header, remoteAddr string, trustProxy bool) string {
+	if trustProxy {
+		if values := header.Values("X-Forwarded-For"); len(values) > 0 {
+			forwarded := values[len(values)-1]
+			if i := strings.LastIndex(forwarded, ","); i >= 0 {
+				forwarded = forwarded[i+1:]
+			}
+			if ip := strings.TrimSpace(forwarded); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+// requestKeys returns the user id and contact email a request carries. The
+// email is also checked against the denylist.
+func requestKeys(msg any) (userID, email string) {
+	if m, ok := msg.(interface{ GetUserId() string }); ok {
+		userID = m.GetUserId()
+	}
+	if m, ok := msg.(interface {
+		GetContactInformation() *pb.ContactInformation
+	}); ok {
+		email = strings.ToLower(strings.TrimSpace(m.GetContactInformation().GetEmail()))
+	}
+	return userID, email
+}
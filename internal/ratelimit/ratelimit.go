@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limit allows Requests calls every Per, refilled continuously.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// ParseLimits reads a comma separated list of procedure limits such as
+// "CreateAppointment=5/1m,GetUserAppointments=60/1m". Procedures may be given
+// by method name or by full procedure path.
+func ParseLimits(s string) (map[string]Limit, error) {
+	limits := make(map[string]Limit)
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		procedure, spec, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q: expected procedure=requests/period", part)
+		}
+
+		count, period, ok := strings.Cut(spec, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q: expected requests/period", part)
+		}
+
+		requests, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || requests <= 0 {
+			return nil, fmt.Errorf("invalid request count in rate limit %q", part)
+		}
+
+		per, err := time.ParseDuration(strings.TrimSpace(period))
+		if err != nil || per <= 0 {
+			return nil, fmt.Errorf("invalid period in rate limit %q", part)
+		}
+
+		limits[strings.TrimSpace(procedure)] = Limit{Requests: requests, Per: per}
+	}
+
+	return limits, nil
+}
+
+type bucket struct {
+	tokens   float64
+	updated  time.Time
+	capacity float64
+	rate     float64
+}
+
+// Limiter is an in-memory token bucket limiter keyed by arbitrary strings.
+type Limiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token from the bucket for key. When the bucket is empty it
+// returns false and how long the caller should wait before retrying.
+func (l *Limiter) Allow(key string, limit Limit) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	rate := float64(limit.Requests) / limit.Per.Seconds()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Requests), updated: now, capacity: float64(limit.Requests), rate: rate}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(b.capacity, b.tokens+now.Sub(b.updated).Seconds()*b.rate)
+	b.updated = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		return false, wait
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+// sweep drops buckets that have been idle long enough to be full again, so
+// one-off keys such as a scanner's IP don't accumulate forever.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.updated).Seconds()*b.rate >= b.capacity {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimits(t *testing.T) {
+	t.Run("parses method names and full procedures", func(t *testing.T) {
+		limits, err := ParseLimits("CreateAppointment=5/1m, /user.UserService/GetUser=100/1h")
+		require.NoError(t, err)
+
+		assert.Equal(t, Limit{Requests: 5, Per: time.Minute}, limits["CreateAppointment"])
+		assert.Equal(t, Limit{Requests: 100, Per: time.Hour}, limits["/user.UserService/GetUser"])
+	})
+
+	t.Run("rejects malformed limits", func(t *testing.T) {
+		for _, spec := range []string{"CreateAppointment", "CreateAppointment=5", "CreateAppointment=0/1m", "CreateAppointment=5/soon"} {
+			_, err := ParseLimits(spec)
+			assert.Error(t, err, spec)
+		}
+	})
+}
+
+func TestLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := NewLimiter()
+	limiter.now = func() time.Time { return now }
+
+	limit := Limit{Requests: 2, Per: time.Minute}
+
+	t.Run("allows a burst up to the limit then reports a retry delay", func(t *testing.T) {
+		allowed, _ := limiter.Allow("a", limit)
+		assert.True(t, allowed)
+		allowed, _ = limiter.Allow("a", limit)
+		assert.True(t, allowed)
+
+		allowed, wait := limiter.Allow("a", limit)
+		assert.False(t, allowed)
+		assert.Equal(t, 30*time.Second, wait)
+	})
+
+	t.Run("keys are limited independently", func(t *testing.T) {
+		allowed, _ := limiter.Allow("b", limit)
+		assert.True(t, allowed)
+	})
+
+	t.Run("tokens refill over time", func(t *testing.T) {
+		now = now.Add(30 * time.Second)
+		allowed, _ := limiter.Allow("a", limit)
+		assert.True(t, allowed)
+	})
+}
+
+type fakeDenylist struct {
+	denied map[string]bool
+	calls  int
+}
+
+func (d *fakeDenylist) IsDenylisted(ctx context.Context, ip, email string) (bool, error) {
+	d.calls++
+	return d.denied[ip] || d.denied[email], nil
+}
+
+func TestHandler(t *testing.T) {
+	denylist := &fakeDenylist{denied: map[string]bool{"203.0.113.7": true}}
+	interceptor := NewInterceptor(NewLimiter(), Options{
+		Limits:   map[string]Limit{"CalDAV": {Requests: 1, Per: time.Minute}},
+		Denylist: denylist,
+	})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) })
+
+	serve := func(name, addr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/caldav/", nil)
+		req.RemoteAddr = addr
+		rec := httptest.NewRecorder()
+		interceptor.Handler(name, ok).ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("limits requests by client IP", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, serve("CalDAV", "198.51.100.1:1234").Code)
+
+		rec := serve("CalDAV", "198.51.100.1:5678")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+
+		assert.Equal(t, http.StatusNoContent, serve("CalDAV", "198.51.100.2:1234").Code)
+	})
+
+	t.Run("rejects denylisted clients", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, serve("CalDAV", "203.0.113.7:1234").Code)
+	})
+
+	t.Run("does not check procedures without a limit", func(t *testing.T) {
+		calls := denylist.calls
+		assert.Equal(t, http.StatusNoContent, serve("Feed", "203.0.113.7:1234").Code)
+		assert.Equal(t, calls, denylist.calls)
+	})
+}
+
+func TestCheck(t *testing.T) {
+	interceptor := NewInterceptor(NewLimiter(), Options{
+		Limits: map[string]Limit{"CreateAppointment": {Requests: 1, Per: time.Minute}},
+	})
+	ctx := context.Background()
+	procedure := "/appointment.AppointmentService/CreateAppointment"
+
+	t.Run("limits a contact email across client IPs", func(t *testing.T) {
+		_, email := requestKeys(&pb.CreateAppointmentRequest{
+			ContactInformation: &pb.ContactInformation{Email: " Ada@Example.com "},
+		})
+		require.NoError(t, interceptor.check(ctx, procedure, "198.51.100.1", "", email))
+
+		err := interceptor.check(ctx, procedure, "198.51.100.2", "", "ada@example.com")
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	})
+
+	t.Run("limits a user across client IPs", func(t *testing.T) {
+		userID, _ := requestKeys(&pb.CreateAppointmentRequest{UserId: "user-1"})
+		require.NoError(t, interceptor.check(ctx, procedure, "198.51.100.3", userID, ""))
+
+		err := interceptor.check(ctx, procedure, "198.51.100.4", "user-1", "")
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	})
+
+	t.Run("does not charge the email once the IP is limited", func(t *testing.T) {
+		require.NoError(t, interceptor.check(ctx, procedure, "198.51.100.5", "", "grace@example.com"))
+
+		err := interceptor.check(ctx, procedure, "198.51.100.5", "", "linus@example.com")
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+		assert.NoError(t, interceptor.check(ctx, procedure, "198.51.100.6", "", "linus@example.com"))
+	})
+}
+
+func TestRemoteIP(t *testing.T) {
+	t.Run("uses the peer address unless the proxy is trusted", func(t *testing.T) {
+		header := http.Header{"X-Forwarded-For": {"192.0.2.1"}}
+		assert.Equal(t, "198.51.100.1", RemoteIP(header, "198.51.100.1:1234", false))
+		assert.Equal(t, "192.0.2.1", RemoteIP(header, "198.51.100.1:1234", true))
+	})
+
+	t.Run("takes the entry the proxy appended", func(t *testing.T) {
+		header := http.Header{"X-Forwarded-For": {"203.0.113.9, 10.0.0.1", "192.0.2.1, 192.0.2.2"}}
+		assert.Equal(t, "192.0.2.2", RemoteIP(header, "198.51.100.1:1234", true))
+	})
+
+	t.Run("falls back to the peer address without the header", func(t *testing.T) {
+		assert.Equal(t, "198.51.100.1", RemoteIP(http.Header{}, "198.51.100.1:1234", true))
+	})
+}
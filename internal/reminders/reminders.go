@@ -0,0 +1,151 @@
+// Package reminders sends the reminder emails scheduled in the reminders
+// table.
+package reminders
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/notify"
+	pb "github.com/folucode/appointment-scheduler/proto"
+)
+
+// Store is the part of the database the worker uses.
+type Store interface {
+	ClaimDueReminders(ctx context.Context, limit int, lease time.Duration) ([]*db.DueReminder, error)
+	MarkReminderSent(ctx context.Context, id string) error
+	RetryReminder(ctx context.Context, id, lastError string, retryAt time.Time, maxAttempts int) error
+}
+
+// Sender delivers a notification and reports whether it went out.
+type Sender interface {
+	Send(ctx context.Context, kind notify.Kind, appt *pb.Appointment) error
+}
+
+type Options struct {
+	// Interval is how often the worker polls for due reminders.
+	Interval time.Duration
+	// BatchSize caps how many reminders are claimed per poll.
+	BatchSize int
+	// Lease is how long a claimed reminder stays reserved for this worker.
+	// It must comfortably exceed the time it takes to send a batch.
+	Lease time.Duration
+	// RetryDelay is how long a failed reminder waits before it is retried.
+	RetryDelay time.Duration
+	// MaxAttempts is how many times a reminder is tried before it is marked
+	// failed.
+	MaxAttempts int
+}
+
+type Worker struct {
+	store  Store
+	sender Sender
+	opts   Options
+	now    func() time.Time
+}
+
+func NewWorker(store Store, sender Sender, opts Options) *Worker {
+	if opts.Interval <= 0 {
+		opts.Interval = 30 * time.Second
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 50
+	}
+	if opts.Lease <= 0 {
+		opts.Lease = 10 * time.Minute
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = 5 * time.Minute
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 5
+	}
+
+	return &Worker{store: store, sender: sender, opts: opts, now: time.Now}
+}
+
+// Run polls for due reminders until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			claimed, err := w.RunOnce(ctx)
+			if err != nil {
+				log.Printf("Error processing reminders: %v", err)
+			}
+			// A full batch suggests there is a backlog, so keep going
+			// rather than waiting for the next tick.
+			if err != nil || claimed < w.opts.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce claims and sends one batch of due reminders, returning how many
+// were claimed.
+func (w *Worker) RunOnce(ctx context.Context) (int, error) {
+	due, err := w.store.ClaimDueReminders(ctx, w.opts.BatchSize, w.opts.Lease)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim reminders: %w", err)
+	}
+
+	for _, r := range due {
+		if err := w.sender.Send(ctx, notify.KindReminder, r.Appointment); err != nil {
+			log.Printf("Error sending reminder %s (attempt %d of %d): %v", r.Id, r.Attempts, w.opts.MaxAttempts, err)
+
+			retryAt := w.now().Add(w.opts.RetryDelay)
+			if err := w.store.RetryReminder(ctx, r.Id, truncate(err.Error(), 1000), retryAt, w.opts.MaxAttempts); err != nil {
+				return len(due), fmt.Errorf("failed to release reminder %s: %w", r.Id, err)
+			}
+			continue
+		}
+
+		if err := w.store.MarkReminderSent(ctx, r.Id); err != nil {
+			return len(due), fmt.Errorf("failed to mark reminder %s sent: %w", r.Id, err)
+		}
+	}
+
+	return len(due), nil
+}
+
+// ParseOffsets parses a comma separated list of durations such as "24h,1h".
+func ParseOffsets(s string) ([]time.Duration, error) {
+	var offsets []time.Duration
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		d, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reminder offset %q: %w", part, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("invalid reminder offset %q: must be positive", part)
+		}
+		offsets = append(offsets, d)
+	}
+
+	return offsets, nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
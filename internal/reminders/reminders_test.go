@@ -0,0 +1,114 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/notify"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	due     []*db.DueReminder
+	sent    []string
+	retried map[string]time.Time
+}
+
+func (s *fakeStore) ClaimDueReminders(ctx context.Context, limit int, lease time.Duration) ([]*db.DueReminder, error) {
+	n := min(limit, len(s.due))
+	claimed := s.due[:n]
+	s.due = s.due[n:]
+	return claimed, nil
+}
+
+func (s *fakeStore) MarkReminderSent(ctx context.Context, id string) error {
+	s.sent = append(s.sent, id)
+	return nil
+}
+
+func (s *fakeStore) RetryReminder(ctx context.Context, id, lastError string, retryAt time.Time, maxAttempts int) error {
+	s.retried[id] = retryAt
+	return nil
+}
+
+type fakeSender struct {
+	fail  map[string]bool
+	kinds []notify.Kind
+}
+
+func (s *fakeSender) Send(ctx context.Context, kind notify.Kind, appt *pb.Appointment) error {
+	s.kinds = append(s.kinds, kind)
+	if s.fail[appt.Id] {
+		return errors.New("mail server unavailable")
+	}
+	return nil
+}
+
+func reminder(id string) *db.DueReminder {
+	return &db.DueReminder{
+		Id:          id,
+		Offset:      time.Hour,
+		Attempts:    1,
+		Appointment: &pb.Appointment{Id: "appt-" + id},
+	}
+}
+
+func TestWorker(t *testing.T) {
+	now := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("sends due reminders and marks them sent", func(t *testing.T) {
+		store := &fakeStore{due: []*db.DueReminder{reminder("a"), reminder("b")}, retried: map[string]time.Time{}}
+		sender := &fakeSender{}
+		worker := NewWorker(store, sender, Options{})
+
+		claimed, err := worker.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, claimed)
+		assert.Equal(t, []string{"a", "b"}, store.sent)
+		assert.Equal(t, []notify.Kind{notify.KindReminder, notify.KindReminder}, sender.kinds)
+	})
+
+	t.Run("releases failed reminders for a later retry", func(t *testing.T) {
+		store := &fakeStore{due: []*db.DueReminder{reminder("a"), reminder("b")}, retried: map[string]time.Time{}}
+		sender := &fakeSender{fail: map[string]bool{"appt-a": true}}
+		worker := NewWorker(store, sender, Options{RetryDelay: time.Minute})
+		worker.now = func() time.Time { return now }
+
+		_, err := worker.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"b"}, store.sent)
+		assert.Equal(t, map[string]time.Time{"a": now.Add(time.Minute)}, store.retried)
+	})
+
+	t.Run("claims at most a batch at a time", func(t *testing.T) {
+		store := &fakeStore{due: []*db.DueReminder{reminder("a"), reminder("b"), reminder("c")}, retried: map[string]time.Time{}}
+		worker := NewWorker(store, &fakeSender{}, Options{BatchSize: 2})
+
+		claimed, err := worker.RunOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, claimed)
+		assert.Len(t, store.due, 1)
+	})
+}
+
+func TestParseOffsets(t *testing.T) {
+	t.Run("parses a list of durations", func(t *testing.T) {
+		offsets, err := ParseOffsets("24h, 1h")
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{24 * time.Hour, time.Hour}, offsets)
+	})
+
+	t.Run("rejects malformed or non-positive offsets", func(t *testing.T) {
+		for _, spec := range []string{"tomorrow", "-1h", "0s"} {
+			_, err := ParseOffsets(spec)
+			assert.Error(t, err, spec)
+		}
+	})
+}
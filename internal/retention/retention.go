@@ -0,0 +1,158 @@
+// Package retention purges appointments that were deleted longer ago than the
+// retention policy allows.
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Store is the part of the database the purger uses.
+type Store interface {
+	CountPurgeableAppointments(ctx context.Context, cutoff time.Time) (int, int, error)
+	PurgeDeletedAppointments(ctx context.Context, cutoff time.Time, archive bool, limit int) (int, error)
+}
+
+// Policy says how long deleted appointments are kept and what happens to
+// them afterwards.
+type Policy struct {
+	// After is how long an appointment is kept once deleted. Zero keeps
+	// deleted appointments forever.
+	After time.Duration
+	// Archive moves purged appointments to the archive table rather than
+	// deleting them outright.
+	Archive bool
+}
+
+type Options struct {
+	// Interval is how often the purger runs.
+	Interval time.Duration
+	// BatchSize caps how many appointments are purged per transaction.
+	BatchSize int
+}
+
+// Result describes a purge.
+type Result struct {
+	Cutoff time.Time
+	// Purged is how many appointments were purged, or would have been on a
+	// dry run.
+	Purged int
+	// Held is how many appointments were old enough but kept for a legal
+	// hold.
+	Held int
+}
+
+type Purger struct {
+	store  Store
+	policy Policy
+	opts   Options
+	now    func() time.Time
+}
+
+func NewPurger(store Store, policy Policy, opts Options) *Purger {
+	if opts.Interval <= 0 {
+		opts.Interval = time.Hour
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+
+	return &Purger{store: store, policy: policy, opts: opts, now: time.Now}
+}
+
+// Policy returns the configured retention policy.
+func (p *Purger) Policy() Policy {
+	return p.policy
+}
+
+// Run applies the configured policy until ctx is cancelled. It returns
+// straight away if the policy keeps deleted appointments forever.
+func (p *Purger) Run(ctx context.Context) {
+	if p.policy.After <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := p.Purge(ctx, p.policy)
+		if err != nil {
+			log.Printf("Error purging deleted appointments: %v", err)
+		} else if result.Purged > 0 {
+			log.Printf("Purged %d appointments deleted before %s (%d kept for legal holds)", result.Purged, result.Cutoff.Format(time.RFC3339), result.Held)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge removes every appointment policy no longer keeps, in batches.
+func (p *Purger) Purge(ctx context.Context, policy Policy) (*Result, error) {
+	result := &Result{Cutoff: p.now().Add(-policy.After)}
+
+	for {
+		n, err := p.store.PurgeDeletedAppointments(ctx, result.Cutoff, policy.Archive, p.opts.BatchSize)
+		result.Purged += n
+		if err != nil {
+			return result, fmt.Errorf("failed to purge appointments: %w", err)
+		}
+		if n < p.opts.BatchSize {
+			break
+		}
+	}
+
+	_, held, err := p.store.CountPurgeableAppointments(ctx, result.Cutoff)
+	if err != nil {
+		return result, fmt.Errorf("failed to count held appointments: %w", err)
+	}
+	result.Held = held
+
+	return result, nil
+}
+
+// DryRun reports what Purge would do with policy, without changing anything.
+func (p *Purger) DryRun(ctx context.Context, policy Policy) (*Result, error) {
+	result := &Result{Cutoff: p.now().Add(-policy.After)}
+
+	purgeable, held, err := p.store.CountPurgeableAppointments(ctx, result.Cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count purgeable appointments: %w", err)
+	}
+	result.Purged = purgeable
+	result.Held = held
+
+	return result, nil
+}
+
+// ParsePolicy reads a retention period in days, empty or zero for none, and
+// a mode of "delete" or "archive", defaulting to archive.
+func ParsePolicy(days, mode string) (Policy, error) {
+	var policy Policy
+
+	if days = strings.TrimSpace(days); days != "" {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return Policy{}, fmt.Errorf("invalid retention period %q: must be a number of days", days)
+		}
+		policy.After = time.Duration(n) * 24 * time.Hour
+	}
+
+	switch strings.TrimSpace(mode) {
+	case "", "archive":
+		policy.Archive = true
+	case "delete":
+	default:
+		return Policy{}, fmt.Errorf("invalid retention mode %q: must be delete or archive", mode)
+	}
+
+	return policy, nil
+}
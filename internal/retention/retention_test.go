@@ -0,0 +1,125 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	purgeable int
+	held      int
+	archived  int
+	deleted   int
+	batches   []int
+	cutoffs   []time.Time
+	fail      bool
+}
+
+func (s *fakeStore) CountPurgeableAppointments(ctx context.Context, cutoff time.Time) (int, int, error) {
+	s.cutoffs = append(s.cutoffs, cutoff)
+	return s.purgeable, s.held, nil
+}
+
+func (s *fakeStore) PurgeDeletedAppointments(ctx context.Context, cutoff time.Time, archive bool, limit int) (int, error) {
+	s.cutoffs = append(s.cutoffs, cutoff)
+	if s.fail {
+		return 0, errors.New("connection reset")
+	}
+
+	n := min(limit, s.purgeable)
+	s.purgeable -= n
+	s.batches = append(s.batches, n)
+	if archive {
+		s.archived += n
+	} else {
+		s.deleted += n
+	}
+	return n, nil
+}
+
+func TestPurger(t *testing.T) {
+	now := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+	policy := Policy{After: 30 * 24 * time.Hour, Archive: true}
+
+	newPurger := func(store *fakeStore) *Purger {
+		purger := NewPurger(store, policy, Options{BatchSize: 10})
+		purger.now = func() time.Time { return now }
+		return purger
+	}
+
+	t.Run("purges in batches until nothing is left", func(t *testing.T) {
+		store := &fakeStore{purgeable: 25, held: 3}
+
+		result, err := newPurger(store).Purge(context.Background(), policy)
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{10, 10, 5}, store.batches)
+		assert.Equal(t, 25, store.archived)
+		assert.Equal(t, 25, result.Purged)
+		assert.Equal(t, 3, result.Held)
+		assert.Equal(t, now.AddDate(0, 0, -30), result.Cutoff)
+		for _, cutoff := range store.cutoffs {
+			assert.Equal(t, result.Cutoff, cutoff)
+		}
+	})
+
+	t.Run("deletes outright when not archiving", func(t *testing.T) {
+		store := &fakeStore{purgeable: 4}
+
+		_, err := newPurger(store).Purge(context.Background(), Policy{After: time.Hour})
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, store.deleted)
+		assert.Zero(t, store.archived)
+	})
+
+	t.Run("dry runs only count", func(t *testing.T) {
+		store := &fakeStore{purgeable: 25, held: 3}
+
+		result, err := newPurger(store).DryRun(context.Background(), policy)
+		require.NoError(t, err)
+
+		assert.Equal(t, 25, result.Purged)
+		assert.Equal(t, 3, result.Held)
+		assert.Empty(t, store.batches)
+		assert.Equal(t, 25, store.purgeable)
+	})
+
+	t.Run("reports failures", func(t *testing.T) {
+		store := &fakeStore{purgeable: 5, fail: true}
+
+		_, err := newPurger(store).Purge(context.Background(), policy)
+		assert.Error(t, err)
+	})
+
+	t.Run("does not run without a retention period", func(t *testing.T) {
+		store := &fakeStore{purgeable: 5}
+
+		NewPurger(store, Policy{}, Options{}).Run(context.Background())
+		assert.Empty(t, store.cutoffs)
+	})
+}
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := ParsePolicy("90", "delete")
+	require.NoError(t, err)
+	assert.Equal(t, Policy{After: 90 * 24 * time.Hour}, policy)
+
+	policy, err = ParsePolicy("", "")
+	require.NoError(t, err)
+	assert.Equal(t, Policy{Archive: true}, policy)
+
+	_, err = ParsePolicy("-1", "")
+	assert.Error(t, err)
+
+	_, err = ParsePolicy("30d", "")
+	assert.Error(t, err)
+
+	_, err = ParsePolicy("30", "shred")
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+// Package timezone handles the IANA time zones of users and the schedule, and
+// the calendar days they define.
+package timezone
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	// Embed the zone database so zones resolve even where the system has
+	// none installed, as in the alpine image.
+	_ "time/tzdata"
+)
+
+// Default is the zone used when none has been configured.
+const Default = "UTC"
+
+var ErrInvalid = errors.New("invalid time zone")
+
+// Load returns the location for an IANA time zone name such as
+// "Africa/Lagos". Unlike time.LoadLocation it rejects "" and "Local", which
+// would silently mean whatever zone the server runs in.
+func Load(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("%w %q", ErrInvalid, name)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q", ErrInvalid, name)
+	}
+
+	return loc, nil
+}
+
+// Date is a calendar day, independent of any zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the calendar day t falls on in loc.
+func DateOf(t time.Time, loc *time.Location) Date {
+	y, m, d := t.In(loc).Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// Before reports whether d is an earlier day than other.
+func (d Date) Before(other Date) bool {
+	if d.Year != other.Year {
+		return d.Year < other.Year
+	}
+	if d.Month != other.Month {
+		return d.Month < other.Month
+	}
+	return d.Day < other.Day
+}
+
+// AddDays returns the day n days after d.
+func (d Date) AddDays(n int) Date {
+	y, m, day := time.Date(d.Year, d.Month, d.Day+n, 12, 0, 0, 0, time.UTC).Date()
+	return Date{Year: y, Month: m, Day: day}
+}
+
+// Start returns the first instant of d in loc. That is midnight, except in
+// zones where a DST change skips midnight, where the day begins when the
+// clocks go forward.
+func (d Date) Start(loc *time.Location) time.Time {
+	t := time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+	if DateOf(t, loc) == d {
+		return t
+	}
+
+	// time.Date resolved the missing midnight with the offset from before
+	// the change, landing on the previous day. The day starts as much later
+	// as the clocks jumped.
+	_, before := t.Zone()
+	_, after := time.Date(d.Year, d.Month, d.Day, 12, 0, 0, 0, loc).Zone()
+	return t.Add(time.Duration(after-before) * time.Second)
+}
+
+// Weekday returns the day of the week d falls on.
+func (d Date) Weekday() time.Weekday {
+	return time.Date(d.Year, d.Month, d.Day, 12, 0, 0, 0, time.UTC).Weekday()
+}
+
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
@@ -0,0 +1,62 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	loc, err := Load("Africa/Lagos")
+	require.NoError(t, err)
+	assert.Equal(t, "Africa/Lagos", loc.String())
+
+	for _, name := range []string{"", "Local", "Mars/Olympus_Mons", "+01:00"} {
+		_, err := Load(name)
+		assert.ErrorIs(t, err, ErrInvalid, name)
+	}
+}
+
+func TestDate(t *testing.T) {
+	newYork, err := Load("America/New_York")
+	require.NoError(t, err)
+	lagos, err := Load("Africa/Lagos")
+	require.NoError(t, err)
+
+	t.Run("depends on the zone", func(t *testing.T) {
+		instant := time.Date(2026, 10, 19, 3, 30, 0, 0, time.UTC)
+
+		assert.Equal(t, Date{2026, 10, 18}, DateOf(instant, newYork))
+		assert.Equal(t, Date{2026, 10, 19}, DateOf(instant, lagos))
+		assert.True(t, DateOf(instant, newYork).Before(DateOf(instant, lagos)))
+		assert.False(t, DateOf(instant, lagos).Before(DateOf(instant, lagos)))
+	})
+
+	t.Run("days are not always 24 hours long", func(t *testing.T) {
+		springForward := Date{2026, 3, 8}
+		start, end := springForward.Start(newYork), springForward.AddDays(1).Start(newYork)
+		assert.Equal(t, 23*time.Hour, end.Sub(start))
+
+		fallBack := Date{2026, 11, 1}
+		start, end = fallBack.Start(newYork), fallBack.AddDays(1).Start(newYork)
+		assert.Equal(t, 25*time.Hour, end.Sub(start))
+	})
+
+	t.Run("starts when the clocks go forward if midnight is skipped", func(t *testing.T) {
+		havana, err := Load("America/Havana")
+		require.NoError(t, err)
+
+		start := Date{2026, 3, 8}.Start(havana)
+		assert.Equal(t, time.Date(2026, 3, 8, 5, 0, 0, 0, time.UTC), start.UTC())
+		assert.Equal(t, Date{2026, 3, 8}, DateOf(start, havana))
+		assert.Equal(t, Date{2026, 3, 7}, DateOf(start.Add(-time.Nanosecond), havana))
+	})
+
+	t.Run("adds days across months and years", func(t *testing.T) {
+		assert.Equal(t, Date{2027, 1, 1}, Date{2026, 12, 31}.AddDays(1))
+		assert.Equal(t, Date{2026, 2, 28}, Date{2026, 3, 1}.AddDays(-1))
+		assert.Equal(t, "2026-03-01", Date{2026, 3, 1}.String())
+	})
+}
@@ -0,0 +1,73 @@
+// Package waitlist expires waitlist offers left unanswered, passing each slot
+// on to the next person waiting.
+package waitlist
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Store is the part of the database the sweeper uses.
+type Store interface {
+	ExpireWaitlistOffers(ctx context.Context, limit int) (int, error)
+}
+
+type Options struct {
+	// Interval is how often the sweeper looks for lapsed offers.
+	Interval time.Duration
+	// BatchSize caps how many offers are expired per transaction.
+	BatchSize int
+}
+
+type Sweeper struct {
+	store Store
+	opts  Options
+}
+
+func NewSweeper(store Store, opts Options) *Sweeper {
+	if opts.Interval <= 0 {
+		opts.Interval = 30 * time.Second
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 50
+	}
+
+	return &Sweeper{store: store, opts: opts}
+}
+
+// Run expires lapsed offers until ctx is cancelled.
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			expired, err := s.RunOnce(ctx)
+			if err != nil {
+				log.Printf("Error expiring waitlist offers: %v", err)
+			}
+			// A full batch suggests there is a backlog, so keep going
+			// rather than waiting for the next tick.
+			if err != nil || expired < s.opts.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce expires one batch of lapsed offers, returning how many expired.
+func (s *Sweeper) RunOnce(ctx context.Context) (int, error) {
+	expired, err := s.store.ExpireWaitlistOffers(ctx, s.opts.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire waitlist offers: %w", err)
+	}
+	return expired, nil
+}
@@ -0,0 +1,241 @@
+// Package webhook delivers appointment events to subscribed HTTP endpoints.
+//
+// Each delivery is a POST of a JSON event:
+//
+//	{"id": "...", "type": "appointment.created", "created_at": "...", "data": {"appointment": {...}}}
+//
+// signed with the webhook's secret. Receivers verify it by computing
+// HMAC-SHA256 over "{X-Webhook-Timestamp}.{body}" and comparing the hex digest
+// with the X-Webhook-Signature header, which has the form "sha256=<hex>".
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	EventAppointmentCreated     = db.EventAppointmentCreated
+	EventAppointmentUpdated     = db.EventAppointmentUpdated
+	EventAppointmentCancelled   = db.EventAppointmentCancelled
+	EventAppointmentRestored    = db.EventAppointmentRestored
+	EventAppointmentRescheduled = db.EventAppointmentRescheduled
+)
+
+// EventTypes lists the events a webhook can subscribe to.
+var EventTypes = []string{EventAppointmentCreated, EventAppointmentUpdated, EventAppointmentCancelled, EventAppointmentRestored,
+	EventAppointmentRescheduled}
+
+const (
+	headerEvent     = "X-Webhook-Event"
+	headerDelivery  = "X-Webhook-Delivery"
+	headerTimestamp = "X-Webhook-Timestamp"
+	headerSignature = "X-Webhook-Signature"
+)
+
+type event struct {
+	Id        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Payload builds the JSON body of an appointment event.
+func Payload(eventId, eventType string, appt *pb.Appointment, createdAt time.Time) ([]byte, error) {
+	apptJSON, err := protojson.Marshal(appt)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(map[string]json.RawMessage{"appointment": apptJSON})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(event{Id: eventId, Type: eventType, CreatedAt: createdAt.UTC(), Data: data})
+}
+
+// Sign returns the X-Webhook-Signature value for body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature produced by Sign.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Store is the part of the database the dispatcher uses.
+type Store interface {
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*db.PendingWebhookDelivery, error)
+	RecordWebhookDelivery(ctx context.Context, id string, result db.WebhookDeliveryResult, disableAfter int) error
+}
+
+type Options struct {
+	// Interval is how often the dispatcher polls for due deliveries.
+	Interval time.Duration
+	// BatchSize caps how many deliveries are claimed per poll.
+	BatchSize int
+	// Lease is how long a claimed delivery stays reserved; it must exceed
+	// Timeout.
+	Lease time.Duration
+	// Timeout bounds each HTTP request.
+	Timeout time.Duration
+	// MaxAttempts is how many times a delivery is tried before it fails.
+	MaxAttempts int
+	// Backoff is the delay before the first retry; it doubles with every
+	// attempt up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// DisableAfter is the number of consecutive failed attempts after which
+	// a webhook is disabled.
+	DisableAfter int
+}
+
+type Dispatcher struct {
+	store  Store
+	client *http.Client
+	opts   Options
+	now    func() time.Time
+}
+
+func NewDispatcher(store Store, client *http.Client, opts Options) *Dispatcher {
+	if opts.Interval <= 0 {
+		opts.Interval = 5 * time.Second
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 20
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.Lease <= 0 {
+		opts.Lease = time.Duration(opts.BatchSize+1) * opts.Timeout
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 8
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = 30 * time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 6 * time.Hour
+	}
+	if opts.DisableAfter <= 0 {
+		opts.DisableAfter = 20
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Dispatcher{store: store, client: client, opts: opts, now: time.Now}
+}
+
+// Run polls for due deliveries until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			claimed, err := d.RunOnce(ctx)
+			if err != nil {
+				log.Printf("Error dispatching webhooks: %v", err)
+			}
+			if err != nil || claimed < d.opts.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce claims and sends one batch of due deliveries, returning how many
+// were claimed.
+func (d *Dispatcher) RunOnce(ctx context.Context) (int, error) {
+	due, err := d.store.ClaimWebhookDeliveries(ctx, d.opts.BatchSize, d.opts.Lease)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	for _, delivery := range due {
+		result := d.send(ctx, delivery)
+		if err := d.store.RecordWebhookDelivery(ctx, delivery.Id, result, d.opts.DisableAfter); err != nil {
+			return len(due), fmt.Errorf("failed to record webhook delivery %s: %w", delivery.Id, err)
+		}
+	}
+
+	return len(due), nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery *db.PendingWebhookDelivery) db.WebhookDeliveryResult {
+	ctx, cancel := context.WithTimeout(ctx, d.opts.Timeout)
+	defer cancel()
+
+	var result db.WebhookDeliveryResult
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "appointment-scheduler-webhooks/1")
+	req.Header.Set(headerEvent, delivery.EventType)
+	req.Header.Set(headerDelivery, delivery.Id)
+	req.Header.Set(headerTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(headerSignature, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+
+		result.ResponseCode = resp.StatusCode
+		result.Succeeded = resp.StatusCode >= 200 && resp.StatusCode < 300
+		if !result.Succeeded {
+			result.Error = "unexpected status " + resp.Status
+		}
+	}
+
+	if !result.Succeeded && delivery.Attempts < d.opts.MaxAttempts {
+		result.RetryAt = d.now().Add(d.backoff(delivery.Attempts))
+	}
+
+	return result
+}
+
+// backoff is the delay after the given number of failed attempts.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.opts.Backoff
+	for i := 1; i < attempts && delay < d.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.opts.MaxBackoff)
+}
@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	due     []*db.PendingWebhookDelivery
+	results map[string]db.WebhookDeliveryResult
+}
+
+func (s *fakeStore) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*db.PendingWebhookDelivery, error) {
+	n := min(limit, len(s.due))
+	claimed := s.due[:n]
+	s.due = s.due[n:]
+	return claimed, nil
+}
+
+func (s *fakeStore) RecordWebhookDelivery(ctx context.Context, id string, result db.WebhookDeliveryResult, disableAfter int) error {
+	s.results[id] = result
+	return nil
+}
+
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver starts a local endpoint that answers every request with status and
+// records what it was sent.
+func receiver(t *testing.T, status int) (*httptest.Server, *[]received) {
+	var requests []received
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, received{header: r.Header.Clone(), body: body})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func delivery(id, url string, attempts int) *db.PendingWebhookDelivery {
+	return &db.PendingWebhookDelivery{
+		Id:        id,
+		WebhookId: "hook",
+		URL:       url,
+		Secret:    "s3cret",
+		EventId:   "event-" + id,
+		EventType: EventAppointmentCreated,
+		Payload:   []byte(`{"id":"event-` + id + `"}`),
+		Attempts:  attempts,
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	now := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("posts signed payloads and records success", func(t *testing.T) {
+		srv, requests := receiver(t, http.StatusNoContent)
+		store := &fakeStore{due: []*db.PendingWebhookDelivery{delivery("a", srv.URL, 1)}, results: map[string]db.WebhookDeliveryResult{}}
+		dispatcher := NewDispatcher(store, srv.Client(), Options{})
+		dispatcher.now = func() time.Time { return now }
+
+		claimed, err := dispatcher.RunOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, claimed)
+
+		require.Len(t, *requests, 1)
+		req := (*requests)[0]
+		assert.Equal(t, `{"id":"event-a"}`, string(req.body))
+		assert.Equal(t, EventAppointmentCreated, req.header.Get(headerEvent))
+		assert.Equal(t, "a", req.header.Get(headerDelivery))
+
+		timestamp, err := strconv.ParseInt(req.header.Get(headerTimestamp), 10, 64)
+		require.NoError(t, err)
+		assert.Equal(t, now.Unix(), timestamp)
+		assert.True(t, Verify("s3cret", timestamp, req.body, req.header.Get(headerSignature)))
+		assert.False(t, Verify("wrong", timestamp, req.body, req.header.Get(headerSignature)))
+
+		assert.Equal(t, db.WebhookDeliveryResult{Succeeded: true, ResponseCode: http.StatusNoContent}, store.results["a"])
+	})
+
+	t.Run("retries failed deliveries with exponential backoff", func(t *testing.T) {
+		srv, _ := receiver(t, http.StatusInternalServerError)
+		store := &fakeStore{
+			due:     []*db.PendingWebhookDelivery{delivery("a", srv.URL, 1), delivery("b", srv.URL, 3)},
+			results: map[string]db.WebhookDeliveryResult{},
+		}
+		dispatcher := NewDispatcher(store, srv.Client(), Options{Backoff: time.Minute})
+		dispatcher.now = func() time.Time { return now }
+
+		_, err := dispatcher.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.False(t, store.results["a"].Succeeded)
+		assert.Equal(t, http.StatusInternalServerError, store.results["a"].ResponseCode)
+		assert.Equal(t, now.Add(time.Minute), store.results["a"].RetryAt)
+		assert.Equal(t, now.Add(4*time.Minute), store.results["b"].RetryAt)
+	})
+
+	t.Run("gives up after the last attempt", func(t *testing.T) {
+		srv, _ := receiver(t, http.StatusBadGateway)
+		store := &fakeStore{due: []*db.PendingWebhookDelivery{delivery("a", srv.URL, 3)}, results: map[string]db.WebhookDeliveryResult{}}
+		dispatcher := NewDispatcher(store, srv.Client(), Options{MaxAttempts: 3})
+
+		_, err := dispatcher.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.False(t, store.results["a"].Succeeded)
+		assert.True(t, store.results["a"].RetryAt.IsZero())
+	})
+
+	t.Run("records unreachable endpoints as failures", func(t *testing.T) {
+		srv, _ := receiver(t, http.StatusOK)
+		srv.Close()
+		store := &fakeStore{due: []*db.PendingWebhookDelivery{delivery("a", srv.URL, 1)}, results: map[string]db.WebhookDeliveryResult{}}
+		dispatcher := NewDispatcher(store, srv.Client(), Options{})
+
+		_, err := dispatcher.RunOnce(context.Background())
+		require.NoError(t, err)
+
+		assert.Zero(t, store.results["a"].ResponseCode)
+		assert.NotEmpty(t, store.results["a"].Error)
+		assert.False(t, store.results["a"].RetryAt.IsZero())
+	})
+
+	t.Run("caps the backoff", func(t *testing.T) {
+		dispatcher := NewDispatcher(&fakeStore{}, nil, Options{Backoff: time.Minute, MaxBackoff: 10 * time.Minute})
+
+		assert.Equal(t, time.Minute, dispatcher.backoff(1))
+		assert.Equal(t, 8*time.Minute, dispatcher.backoff(4))
+		assert.Equal(t, 10*time.Minute, dispatcher.backoff(20))
+	})
+}
+
+func TestPayload(t *testing.T) {
+	appt := &pb.Appointment{Id: "appt-1", Title: "Check-up"}
+	createdAt := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+
+	body, err := Payload("event-1", EventAppointmentCancelled, appt, createdAt)
+	require.NoError(t, err)
+
+	var event struct {
+		Id        string    `json:"id"`
+		Type      string    `json:"type"`
+		CreatedAt time.Time `json:"created_at"`
+		Data      struct {
+			Appointment struct {
+				Id    string `json:"id"`
+				Title string `json:"title"`
+			} `json:"appointment"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(body, &event))
+
+	assert.Equal(t, "event-1", event.Id)
+	assert.Equal(t, EventAppointmentCancelled, event.Type)
+	assert.True(t, createdAt.Equal(event.CreatedAt))
+	assert.Equal(t, "appt-1", event.Data.Appointment.Id)
+	assert.Equal(t, "Check-up", event.Data.Appointment.Title)
+}
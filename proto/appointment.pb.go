@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -23,6 +24,555 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AppointmentScope int32
+
+const (
+	AppointmentScope_APPOINTMENT_SCOPE_UNSPECIFIED AppointmentScope = 0
+	AppointmentScope_APPOINTMENT_SCOPE_ALL         AppointmentScope = 1
+	AppointmentScope_APPOINTMENT_SCOPE_UPCOMING    AppointmentScope = 2
+	AppointmentScope_APPOINTMENT_SCOPE_PAST        AppointmentScope = 3
+)
+
+// Enum value maps for AppointmentScope.
+var (
+	AppointmentScope_name = map[int32]string{
+		0: "APPOINTMENT_SCOPE_UNSPECIFIED",
+		1: "APPOINTMENT_SCOPE_ALL",
+		2: "APPOINTMENT_SCOPE_UPCOMING",
+		3: "APPOINTMENT_SCOPE_PAST",
+	}
+	AppointmentScope_value = map[string]int32{
+		"APPOINTMENT_SCOPE_UNSPECIFIED": 0,
+		"APPOINTMENT_SCOPE_ALL":         1,
+		"APPOINTMENT_SCOPE_UPCOMING":    2,
+		"APPOINTMENT_SCOPE_PAST":        3,
+	}
+)
+
+func (x AppointmentScope) Enum() *AppointmentScope {
+	p := new(AppointmentScope)
+	*p = x
+	return p
+}
+
+func (x AppointmentScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppointmentScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[0].Descriptor()
+}
+
+func (AppointmentScope) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[0]
+}
+
+func (x AppointmentScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppointmentScope.Descriptor instead.
+func (AppointmentScope) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{0}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0
+	SortDirection_SORT_DIRECTION_ASCENDING   SortDirection = 1
+	SortDirection_SORT_DIRECTION_DESCENDING  SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASCENDING",
+		2: "SORT_DIRECTION_DESCENDING",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASCENDING":   1,
+		"SORT_DIRECTION_DESCENDING":  2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[1].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[1]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{1}
+}
+
+type ImportEventStatus int32
+
+const (
+	ImportEventStatus_IMPORT_EVENT_STATUS_UNSPECIFIED ImportEventStatus = 0
+	ImportEventStatus_IMPORT_EVENT_STATUS_IMPORTED    ImportEventStatus = 1
+	ImportEventStatus_IMPORT_EVENT_STATUS_DUPLICATE   ImportEventStatus = 2
+	ImportEventStatus_IMPORT_EVENT_STATUS_CONFLICT    ImportEventStatus = 3
+	ImportEventStatus_IMPORT_EVENT_STATUS_INVALID     ImportEventStatus = 4
+)
+
+// Enum value maps for ImportEventStatus.
+var (
+	ImportEventStatus_name = map[int32]string{
+		0: "IMPORT_EVENT_STATUS_UNSPECIFIED",
+		1: "IMPORT_EVENT_STATUS_IMPORTED",
+		2: "IMPORT_EVENT_STATUS_DUPLICATE",
+		3: "IMPORT_EVENT_STATUS_CONFLICT",
+		4: "IMPORT_EVENT_STATUS_INVALID",
+	}
+	ImportEventStatus_value = map[string]int32{
+		"IMPORT_EVENT_STATUS_UNSPECIFIED": 0,
+		"IMPORT_EVENT_STATUS_IMPORTED":    1,
+		"IMPORT_EVENT_STATUS_DUPLICATE":   2,
+		"IMPORT_EVENT_STATUS_CONFLICT":    3,
+		"IMPORT_EVENT_STATUS_INVALID":     4,
+	}
+)
+
+func (x ImportEventStatus) Enum() *ImportEventStatus {
+	p := new(ImportEventStatus)
+	*p = x
+	return p
+}
+
+func (x ImportEventStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportEventStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportEventStatus) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[2]
+}
+
+func (x ImportEventStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportEventStatus.Descriptor instead.
+func (ImportEventStatus) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{2}
+}
+
+type BookingPolicyRule int32
+
+const (
+	BookingPolicyRule_BOOKING_POLICY_RULE_UNSPECIFIED    BookingPolicyRule = 0
+	BookingPolicyRule_BOOKING_POLICY_RULE_MIN_NOTICE     BookingPolicyRule = 1
+	BookingPolicyRule_BOOKING_POLICY_RULE_MAX_ADVANCE    BookingPolicyRule = 2
+	BookingPolicyRule_BOOKING_POLICY_RULE_MIN_DURATION   BookingPolicyRule = 3
+	BookingPolicyRule_BOOKING_POLICY_RULE_MAX_DURATION   BookingPolicyRule = 4
+	BookingPolicyRule_BOOKING_POLICY_RULE_SLOT_ALIGNMENT BookingPolicyRule = 5
+	BookingPolicyRule_BOOKING_POLICY_RULE_MAX_PER_DAY    BookingPolicyRule = 6
+	BookingPolicyRule_BOOKING_POLICY_RULE_MAX_PER_WEEK   BookingPolicyRule = 7
+)
+
+// Enum value maps for BookingPolicyRule.
+var (
+	BookingPolicyRule_name = map[int32]string{
+		0: "BOOKING_POLICY_RULE_UNSPECIFIED",
+		1: "BOOKING_POLICY_RULE_MIN_NOTICE",
+		2: "BOOKING_POLICY_RULE_MAX_ADVANCE",
+		3: "BOOKING_POLICY_RULE_MIN_DURATION",
+		4: "BOOKING_POLICY_RULE_MAX_DURATION",
+		5: "BOOKING_POLICY_RULE_SLOT_ALIGNMENT",
+		6: "BOOKING_POLICY_RULE_MAX_PER_DAY",
+		7: "BOOKING_POLICY_RULE_MAX_PER_WEEK",
+	}
+	BookingPolicyRule_value = map[string]int32{
+		"BOOKING_POLICY_RULE_UNSPECIFIED":    0,
+		"BOOKING_POLICY_RULE_MIN_NOTICE":     1,
+		"BOOKING_POLICY_RULE_MAX_ADVANCE":    2,
+		"BOOKING_POLICY_RULE_MIN_DURATION":   3,
+		"BOOKING_POLICY_RULE_MAX_DURATION":   4,
+		"BOOKING_POLICY_RULE_SLOT_ALIGNMENT": 5,
+		"BOOKING_POLICY_RULE_MAX_PER_DAY":    6,
+		"BOOKING_POLICY_RULE_MAX_PER_WEEK":   7,
+	}
+)
+
+func (x BookingPolicyRule) Enum() *BookingPolicyRule {
+	p := new(BookingPolicyRule)
+	*p = x
+	return p
+}
+
+func (x BookingPolicyRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingPolicyRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[3].Descriptor()
+}
+
+func (BookingPolicyRule) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[3]
+}
+
+func (x BookingPolicyRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingPolicyRule.Descriptor instead.
+func (BookingPolicyRule) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{3}
+}
+
+// Required participants, and the organizer, count as busy for the length of
+// the appointment unless they decline. Optional participants never do.
+type ParticipantRole int32
+
+const (
+	ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED ParticipantRole = 0
+	ParticipantRole_PARTICIPANT_ROLE_ORGANIZER   ParticipantRole = 1
+	ParticipantRole_PARTICIPANT_ROLE_REQUIRED    ParticipantRole = 2
+	ParticipantRole_PARTICIPANT_ROLE_OPTIONAL    ParticipantRole = 3
+)
+
+// Enum value maps for ParticipantRole.
+var (
+	ParticipantRole_name = map[int32]string{
+		0: "PARTICIPANT_ROLE_UNSPECIFIED",
+		1: "PARTICIPANT_ROLE_ORGANIZER",
+		2: "PARTICIPANT_ROLE_REQUIRED",
+		3: "PARTICIPANT_ROLE_OPTIONAL",
+	}
+	ParticipantRole_value = map[string]int32{
+		"PARTICIPANT_ROLE_UNSPECIFIED": 0,
+		"PARTICIPANT_ROLE_ORGANIZER":   1,
+		"PARTICIPANT_ROLE_REQUIRED":    2,
+		"PARTICIPANT_ROLE_OPTIONAL":    3,
+	}
+)
+
+func (x ParticipantRole) Enum() *ParticipantRole {
+	p := new(ParticipantRole)
+	*p = x
+	return p
+}
+
+func (x ParticipantRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParticipantRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[4].Descriptor()
+}
+
+func (ParticipantRole) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[4]
+}
+
+func (x ParticipantRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParticipantRole.Descriptor instead.
+func (ParticipantRole) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{4}
+}
+
+type RsvpStatus int32
+
+const (
+	RsvpStatus_RSVP_STATUS_UNSPECIFIED  RsvpStatus = 0
+	RsvpStatus_RSVP_STATUS_NEEDS_ACTION RsvpStatus = 1
+	RsvpStatus_RSVP_STATUS_ACCEPTED     RsvpStatus = 2
+	RsvpStatus_RSVP_STATUS_DECLINED     RsvpStatus = 3
+	RsvpStatus_RSVP_STATUS_TENTATIVE    RsvpStatus = 4
+)
+
+// Enum value maps for RsvpStatus.
+var (
+	RsvpStatus_name = map[int32]string{
+		0: "RSVP_STATUS_UNSPECIFIED",
+		1: "RSVP_STATUS_NEEDS_ACTION",
+		2: "RSVP_STATUS_ACCEPTED",
+		3: "RSVP_STATUS_DECLINED",
+		4: "RSVP_STATUS_TENTATIVE",
+	}
+	RsvpStatus_value = map[string]int32{
+		"RSVP_STATUS_UNSPECIFIED":  0,
+		"RSVP_STATUS_NEEDS_ACTION": 1,
+		"RSVP_STATUS_ACCEPTED":     2,
+		"RSVP_STATUS_DECLINED":     3,
+		"RSVP_STATUS_TENTATIVE":    4,
+	}
+)
+
+func (x RsvpStatus) Enum() *RsvpStatus {
+	p := new(RsvpStatus)
+	*p = x
+	return p
+}
+
+func (x RsvpStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RsvpStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[5].Descriptor()
+}
+
+func (RsvpStatus) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[5]
+}
+
+func (x RsvpStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RsvpStatus.Descriptor instead.
+func (RsvpStatus) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{5}
+}
+
+type WaitlistStatus int32
+
+const (
+	WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED WaitlistStatus = 0
+	WaitlistStatus_WAITLIST_STATUS_WAITING     WaitlistStatus = 1
+	WaitlistStatus_WAITLIST_STATUS_BOOKED      WaitlistStatus = 2
+	WaitlistStatus_WAITLIST_STATUS_CANCELLED   WaitlistStatus = 3
+)
+
+// Enum value maps for WaitlistStatus.
+var (
+	WaitlistStatus_name = map[int32]string{
+		0: "WAITLIST_STATUS_UNSPECIFIED",
+		1: "WAITLIST_STATUS_WAITING",
+		2: "WAITLIST_STATUS_BOOKED",
+		3: "WAITLIST_STATUS_CANCELLED",
+	}
+	WaitlistStatus_value = map[string]int32{
+		"WAITLIST_STATUS_UNSPECIFIED": 0,
+		"WAITLIST_STATUS_WAITING":     1,
+		"WAITLIST_STATUS_BOOKED":      2,
+		"WAITLIST_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x WaitlistStatus) Enum() *WaitlistStatus {
+	p := new(WaitlistStatus)
+	*p = x
+	return p
+}
+
+func (x WaitlistStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitlistStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[6].Descriptor()
+}
+
+func (WaitlistStatus) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[6]
+}
+
+func (x WaitlistStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitlistStatus.Descriptor instead.
+func (WaitlistStatus) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{6}
+}
+
+type WaitlistOfferStatus int32
+
+const (
+	WaitlistOfferStatus_WAITLIST_OFFER_STATUS_UNSPECIFIED WaitlistOfferStatus = 0
+	WaitlistOfferStatus_WAITLIST_OFFER_STATUS_PENDING     WaitlistOfferStatus = 1
+	WaitlistOfferStatus_WAITLIST_OFFER_STATUS_ACCEPTED    WaitlistOfferStatus = 2
+	WaitlistOfferStatus_WAITLIST_OFFER_STATUS_DECLINED    WaitlistOfferStatus = 3
+	WaitlistOfferStatus_WAITLIST_OFFER_STATUS_EXPIRED     WaitlistOfferStatus = 4
+	// The entry left the waitlist while the offer was open.
+	WaitlistOfferStatus_WAITLIST_OFFER_STATUS_WITHDRAWN WaitlistOfferStatus = 5
+)
+
+// Enum value maps for WaitlistOfferStatus.
+var (
+	WaitlistOfferStatus_name = map[int32]string{
+		0: "WAITLIST_OFFER_STATUS_UNSPECIFIED",
+		1: "WAITLIST_OFFER_STATUS_PENDING",
+		2: "WAITLIST_OFFER_STATUS_ACCEPTED",
+		3: "WAITLIST_OFFER_STATUS_DECLINED",
+		4: "WAITLIST_OFFER_STATUS_EXPIRED",
+		5: "WAITLIST_OFFER_STATUS_WITHDRAWN",
+	}
+	WaitlistOfferStatus_value = map[string]int32{
+		"WAITLIST_OFFER_STATUS_UNSPECIFIED": 0,
+		"WAITLIST_OFFER_STATUS_PENDING":     1,
+		"WAITLIST_OFFER_STATUS_ACCEPTED":    2,
+		"WAITLIST_OFFER_STATUS_DECLINED":    3,
+		"WAITLIST_OFFER_STATUS_EXPIRED":     4,
+		"WAITLIST_OFFER_STATUS_WITHDRAWN":   5,
+	}
+)
+
+func (x WaitlistOfferStatus) Enum() *WaitlistOfferStatus {
+	p := new(WaitlistOfferStatus)
+	*p = x
+	return p
+}
+
+func (x WaitlistOfferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitlistOfferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[7].Descriptor()
+}
+
+func (WaitlistOfferStatus) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[7]
+}
+
+func (x WaitlistOfferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitlistOfferStatus.Descriptor instead.
+func (WaitlistOfferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{7}
+}
+
+type CancellationReason int32
+
+const (
+	CancellationReason_CANCELLATION_REASON_UNSPECIFIED          CancellationReason = 0
+	CancellationReason_CANCELLATION_REASON_SCHEDULE_CONFLICT    CancellationReason = 1
+	CancellationReason_CANCELLATION_REASON_ILLNESS              CancellationReason = 2
+	CancellationReason_CANCELLATION_REASON_NO_LONGER_NEEDED     CancellationReason = 3
+	CancellationReason_CANCELLATION_REASON_PROVIDER_UNAVAILABLE CancellationReason = 4
+	CancellationReason_CANCELLATION_REASON_OTHER                CancellationReason = 5
+)
+
+// Enum value maps for CancellationReason.
+var (
+	CancellationReason_name = map[int32]string{
+		0: "CANCELLATION_REASON_UNSPECIFIED",
+		1: "CANCELLATION_REASON_SCHEDULE_CONFLICT",
+		2: "CANCELLATION_REASON_ILLNESS",
+		3: "CANCELLATION_REASON_NO_LONGER_NEEDED",
+		4: "CANCELLATION_REASON_PROVIDER_UNAVAILABLE",
+		5: "CANCELLATION_REASON_OTHER",
+	}
+	CancellationReason_value = map[string]int32{
+		"CANCELLATION_REASON_UNSPECIFIED":          0,
+		"CANCELLATION_REASON_SCHEDULE_CONFLICT":    1,
+		"CANCELLATION_REASON_ILLNESS":              2,
+		"CANCELLATION_REASON_NO_LONGER_NEEDED":     3,
+		"CANCELLATION_REASON_PROVIDER_UNAVAILABLE": 4,
+		"CANCELLATION_REASON_OTHER":                5,
+	}
+)
+
+func (x CancellationReason) Enum() *CancellationReason {
+	p := new(CancellationReason)
+	*p = x
+	return p
+}
+
+func (x CancellationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancellationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[8].Descriptor()
+}
+
+func (CancellationReason) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[8]
+}
+
+func (x CancellationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancellationReason.Descriptor instead.
+func (CancellationReason) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{8}
+}
+
+type CancelledBy int32
+
+const (
+	CancelledBy_CANCELLED_BY_UNSPECIFIED CancelledBy = 0
+	// The person who booked the appointment.
+	CancelledBy_CANCELLED_BY_CLIENT CancelledBy = 1
+	// Whoever runs the schedule, through the admin API.
+	CancelledBy_CANCELLED_BY_PROVIDER CancelledBy = 2
+)
+
+// Enum value maps for CancelledBy.
+var (
+	CancelledBy_name = map[int32]string{
+		0: "CANCELLED_BY_UNSPECIFIED",
+		1: "CANCELLED_BY_CLIENT",
+		2: "CANCELLED_BY_PROVIDER",
+	}
+	CancelledBy_value = map[string]int32{
+		"CANCELLED_BY_UNSPECIFIED": 0,
+		"CANCELLED_BY_CLIENT":      1,
+		"CANCELLED_BY_PROVIDER":    2,
+	}
+)
+
+func (x CancelledBy) Enum() *CancelledBy {
+	p := new(CancelledBy)
+	*p = x
+	return p
+}
+
+func (x CancelledBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancelledBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_appointment_proto_enumTypes[9].Descriptor()
+}
+
+func (CancelledBy) Type() protoreflect.EnumType {
+	return &file_appointment_proto_enumTypes[9]
+}
+
+func (x CancelledBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancelledBy.Descriptor instead.
+func (CancelledBy) EnumDescriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{9}
+}
+
 type Appointment struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -34,8 +584,27 @@ type Appointment struct {
 	Title              string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
 	Date               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
 	DeletedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The IANA time zone the appointment was booked in, for rendering its
+	// times locally.
+	TimeZone string `protobuf:"bytes,12,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Time kept free before start_time and after end_time. No other
+	// appointment, nor its buffers, may overlap them.
+	BufferBefore *durationpb.Duration `protobuf:"bytes,13,opt,name=buffer_before,json=bufferBefore,proto3" json:"buffer_before,omitempty"`
+	BufferAfter  *durationpb.Duration `protobuf:"bytes,14,opt,name=buffer_after,json=bufferAfter,proto3" json:"buffer_after,omitempty"`
+	// The type the appointment was booked as, if any.
+	AppointmentTypeId string `protobuf:"bytes,15,opt,name=appointment_type_id,json=appointmentTypeId,proto3" json:"appointment_type_id,omitempty"`
+	// Seats in a group session, which others join rather than book. Zero
+	// for a private appointment.
+	Capacity int32 `protobuf:"varint,16,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// The organizer who booked it, then required and optional participants
+	// by name.
+	Participants []*Participant `protobuf:"bytes,17,rep,name=participants,proto3" json:"participants,omitempty"`
+	// Why and by whom the appointment was cancelled, once deleted_at is set.
+	Cancellation  *Cancellation `protobuf:"bytes,18,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Appointment) Reset() {
@@ -131,72 +700,2995 @@ func (x *Appointment) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type GetAppointmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Appointment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetAppointmentRequest) Reset() {
-	*x = GetAppointmentRequest{}
-	mi := &file_appointment_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Appointment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *GetAppointmentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Appointment) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
 }
 
-func (*GetAppointmentRequest) ProtoMessage() {}
+func (x *Appointment) GetBufferBefore() *durationpb.Duration {
+	if x != nil {
+		return x.BufferBefore
+	}
+	return nil
+}
 
-func (x *GetAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_appointment_proto_msgTypes[1]
+func (x *Appointment) GetBufferAfter() *durationpb.Duration {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BufferAfter
+	}
+	return nil
+}
+
+func (x *Appointment) GetAppointmentTypeId() string {
+	if x != nil {
+		return x.AppointmentTypeId
+	}
+	return ""
+}
+
+func (x *Appointment) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Appointment) GetParticipants() []*Participant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *Appointment) GetCancellation() *Cancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+type GetAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentRequest) Reset() {
+	*x = GetAppointmentRequest{}
+	mi := &file_appointment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentRequest) ProtoMessage() {}
+
+func (x *GetAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetUserAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Scope         AppointmentScope       `protobuf:"varint,6,opt,name=scope,proto3,enum=appointment.AppointmentScope" json:"scope,omitempty"`
+	SortDirection SortDirection          `protobuf:"varint,7,opt,name=sort_direction,json=sortDirection,proto3,enum=appointment.SortDirection" json:"sort_direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAppointmentRequest) Reset() {
+	*x = GetUserAppointmentRequest{}
+	mi := &file_appointment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAppointmentRequest) ProtoMessage() {}
+
+func (x *GetUserAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*GetUserAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUserAppointmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserAppointmentRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserAppointmentRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetUserAppointmentRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetUserAppointmentRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetUserAppointmentRequest) GetScope() AppointmentScope {
+	if x != nil {
+		return x.Scope
+	}
+	return AppointmentScope_APPOINTMENT_SCOPE_UNSPECIFIED
+}
+
+func (x *GetUserAppointmentRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type GetUserAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAppointmentResponse) Reset() {
+	*x = GetUserAppointmentResponse{}
+	mi := &file_appointment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAppointmentResponse) ProtoMessage() {}
+
+func (x *GetUserAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*GetUserAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserAppointmentResponse) GetAppointments() []*Appointment {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+func (x *GetUserAppointmentResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateAppointmentRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactInformation *ContactInformation    `protobuf:"bytes,2,opt,name=contact_information,json=contactInformation,proto3" json:"contact_information,omitempty"`
+	StartTime          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Description        string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Title              string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Date               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`
+	// IANA time zone of the person booking, such as "Africa/Lagos". Defaults
+	// to their saved zone, or else the schedule's. The booking policy judges
+	// days, such as for quotas and slot alignment, in this zone.
+	TimeZone string `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Books an appointment of this type. end_time must then be left unset:
+	// it follows from the type's duration, and title defaults to its name.
+	AppointmentTypeId string `protobuf:"bytes,9,opt,name=appointment_type_id,json=appointmentTypeId,proto3" json:"appointment_type_id,omitempty"`
+	// Makes the appointment a group session with this many seats, hosted by
+	// the person booking.
+	Capacity int32 `protobuf:"varint,10,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// People to invite besides the person booking. The booking fails if a
+	// required invitee already has an appointment at the time.
+	Invitees      []*Invitee `protobuf:"bytes,11,rep,name=invitees,proto3" json:"invitees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppointmentRequest) Reset() {
+	*x = CreateAppointmentRequest{}
+	mi := &file_appointment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppointmentRequest) ProtoMessage() {}
+
+func (x *CreateAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAppointmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetContactInformation() *ContactInformation {
+	if x != nil {
+		return x.ContactInformation
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetAppointmentTypeId() string {
+	if x != nil {
+		return x.AppointmentTypeId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateAppointmentRequest) GetInvitees() []*Invitee {
+	if x != nil {
+		return x.Invitees
+	}
+	return nil
+}
+
+type UpdateAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAppointmentRequest) Reset() {
+	*x = UpdateAppointmentRequest{}
+	mi := &file_appointment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppointmentRequest) ProtoMessage() {}
+
+func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAppointmentRequest) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+func (x *UpdateAppointmentRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Cancels an appointment on behalf of the client who booked it. This fails
+// with FAILED_PRECONDITION within the schedule's cancellation cutoff of the
+// start time; a provider can still cancel then through the admin API.
+type DeleteAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        CancellationReason     `protobuf:"varint,2,opt,name=reason,proto3,enum=appointment.CancellationReason" json:"reason,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppointmentRequest) Reset() {
+	*x = DeleteAppointmentRequest{}
+	mi := &file_appointment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppointmentRequest) ProtoMessage() {}
+
+func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteAppointmentRequest) GetReason() CancellationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CancellationReason_CANCELLATION_REASON_UNSPECIFIED
+}
+
+func (x *DeleteAppointmentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type DeleteAppointmentResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// The cancelled appointment, with its cancellation.
+	Appointment   *Appointment `protobuf:"bytes,2,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppointmentResponse) Reset() {
+	*x = DeleteAppointmentResponse{}
+	mi := &file_appointment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppointmentResponse) ProtoMessage() {}
+
+func (x *DeleteAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAppointmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteAppointmentResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+type ListDeletedAppointmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedAppointmentsRequest) Reset() {
+	*x = ListDeletedAppointmentsRequest{}
+	mi := &file_appointment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedAppointmentsRequest) ProtoMessage() {}
+
+func (x *ListDeletedAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListDeletedAppointmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDeletedAppointmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedAppointmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Most recently deleted first.
+type ListDeletedAppointmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedAppointmentsResponse) Reset() {
+	*x = ListDeletedAppointmentsResponse{}
+	mi := &file_appointment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedAppointmentsResponse) ProtoMessage() {}
+
+func (x *ListDeletedAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListDeletedAppointmentsResponse) GetAppointments() []*Appointment {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+func (x *ListDeletedAppointmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Restoring fails with ALREADY_EXISTS if the slot has been booked since; the
+// error carries the blocking Appointment as a detail.
+type RestoreAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAppointmentRequest) Reset() {
+	*x = RestoreAppointmentRequest{}
+	mi := &file_appointment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAppointmentRequest) ProtoMessage() {}
+
+func (x *RestoreAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SearchAppointmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAppointmentsRequest) Reset() {
+	*x = SearchAppointmentsRequest{}
+	mi := &file_appointment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAppointmentsRequest) ProtoMessage() {}
+
+func (x *SearchAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchAppointmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchAppointmentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchAppointmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchAppointmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchAppointmentsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*AppointmentSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAppointmentsResponse) Reset() {
+	*x = SearchAppointmentsResponse{}
+	mi := &file_appointment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAppointmentsResponse) ProtoMessage() {}
+
+func (x *SearchAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchAppointmentsResponse) GetResults() []*AppointmentSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchAppointmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Snippets are HTML-escaped with matches wrapped in <mark> tags.
+type AppointmentSearchResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Appointment        *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	Rank               float32                `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	TitleSnippet       string                 `protobuf:"bytes,3,opt,name=title_snippet,json=titleSnippet,proto3" json:"title_snippet,omitempty"`
+	DescriptionSnippet string                 `protobuf:"bytes,4,opt,name=description_snippet,json=descriptionSnippet,proto3" json:"description_snippet,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AppointmentSearchResult) Reset() {
+	*x = AppointmentSearchResult{}
+	mi := &file_appointment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentSearchResult) ProtoMessage() {}
+
+func (x *AppointmentSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentSearchResult.ProtoReflect.Descriptor instead.
+func (*AppointmentSearchResult) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AppointmentSearchResult) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+func (x *AppointmentSearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *AppointmentSearchResult) GetTitleSnippet() string {
+	if x != nil {
+		return x.TitleSnippet
+	}
+	return ""
+}
+
+func (x *AppointmentSearchResult) GetDescriptionSnippet() string {
+	if x != nil {
+		return x.DescriptionSnippet
+	}
+	return ""
+}
+
+// The first message names the user and whether this is a dry run; every
+// message may carry the next chunk of the .ics file.
+type ImportCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCalendarRequest) Reset() {
+	*x = ImportCalendarRequest{}
+	mi := &file_appointment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCalendarRequest) ProtoMessage() {}
+
+func (x *ImportCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ImportCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportCalendarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportCalendarRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportCalendarRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ImportCalendarResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Results        []*ImportEventResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	ImportedCount  int32                  `protobuf:"varint,2,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	DuplicateCount int32                  `protobuf:"varint,3,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	RejectedCount  int32                  `protobuf:"varint,4,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	DryRun         bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportCalendarResponse) Reset() {
+	*x = ImportCalendarResponse{}
+	mi := &file_appointment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCalendarResponse) ProtoMessage() {}
+
+func (x *ImportCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ImportCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportCalendarResponse) GetResults() []*ImportEventResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportCalendarResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportCalendarResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *ImportCalendarResponse) GetRejectedCount() int32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *ImportCalendarResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportEventResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Status        ImportEventStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=appointment.ImportEventStatus" json:"status,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,4,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEventResult) Reset() {
+	*x = ImportEventResult{}
+	mi := &file_appointment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEventResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEventResult) ProtoMessage() {}
+
+func (x *ImportEventResult) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEventResult.ProtoReflect.Descriptor instead.
+func (*ImportEventResult) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportEventResult) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ImportEventResult) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ImportEventResult) GetStatus() ImportEventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportEventStatus_IMPORT_EVENT_STATUS_UNSPECIFIED
+}
+
+func (x *ImportEventResult) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *ImportEventResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ContactInformation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactInformation) Reset() {
+	*x = ContactInformation{}
+	mi := &file_appointment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactInformation) ProtoMessage() {}
+
+func (x *ContactInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactInformation.ProtoReflect.Descriptor instead.
+func (*ContactInformation) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ContactInformation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContactInformation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// BookingPolicyViolations is attached to the FailedPrecondition error of a
+// booking that breaks the schedule's booking policy, listing every rule it
+// breaks.
+type BookingPolicyViolations struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Violations    []*BookingPolicyViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingPolicyViolations) Reset() {
+	*x = BookingPolicyViolations{}
+	mi := &file_appointment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingPolicyViolations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingPolicyViolations) ProtoMessage() {}
+
+func (x *BookingPolicyViolations) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingPolicyViolations.ProtoReflect.Descriptor instead.
+func (*BookingPolicyViolations) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BookingPolicyViolations) GetViolations() []*BookingPolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type BookingPolicyViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          BookingPolicyRule      `protobuf:"varint,1,opt,name=rule,proto3,enum=appointment.BookingPolicyRule" json:"rule,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingPolicyViolation) Reset() {
+	*x = BookingPolicyViolation{}
+	mi := &file_appointment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingPolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingPolicyViolation) ProtoMessage() {}
+
+func (x *BookingPolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingPolicyViolation.ProtoReflect.Descriptor instead.
+func (*BookingPolicyViolation) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BookingPolicyViolation) GetRule() BookingPolicyRule {
+	if x != nil {
+		return x.Rule
+	}
+	return BookingPolicyRule_BOOKING_POLICY_RULE_UNSPECIFIED
+}
+
+func (x *BookingPolicyViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// AppointmentType is an entry in the catalog of services that can be
+// booked, such as a 30 minute consultation.
+type AppointmentType struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Duration    *durationpb.Duration   `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Time kept free around appointments of this type. Unset buffers fall
+	// back to the schedule's.
+	BufferBefore *durationpb.Duration `protobuf:"bytes,5,opt,name=buffer_before,json=bufferBefore,proto3" json:"buffer_before,omitempty"`
+	BufferAfter  *durationpb.Duration `protobuf:"bytes,6,opt,name=buffer_after,json=bufferAfter,proto3" json:"buffer_after,omitempty"`
+	// Display color such as "#3366ff".
+	Color string `protobuf:"bytes,7,opt,name=color,proto3" json:"color,omitempty"`
+	// Inactive types cannot be booked but stay on existing appointments.
+	Active        bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppointmentType) Reset() {
+	*x = AppointmentType{}
+	mi := &file_appointment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentType) ProtoMessage() {}
+
+func (x *AppointmentType) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentType.ProtoReflect.Descriptor instead.
+func (*AppointmentType) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AppointmentType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppointmentType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppointmentType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AppointmentType) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *AppointmentType) GetBufferBefore() *durationpb.Duration {
+	if x != nil {
+		return x.BufferBefore
+	}
+	return nil
+}
+
+func (x *AppointmentType) GetBufferAfter() *durationpb.Duration {
+	if x != nil {
+		return x.BufferAfter
+	}
+	return nil
+}
+
+func (x *AppointmentType) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *AppointmentType) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *AppointmentType) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AppointmentType) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListAppointmentTypesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAppointmentTypesRequest) Reset() {
+	*x = ListAppointmentTypesRequest{}
+	mi := &file_appointment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentTypesRequest) ProtoMessage() {}
+
+func (x *ListAppointmentTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListAppointmentTypesRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListAppointmentTypesRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListAppointmentTypesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentTypes []*AppointmentType     `protobuf:"bytes,1,rep,name=appointment_types,json=appointmentTypes,proto3" json:"appointment_types,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListAppointmentTypesResponse) Reset() {
+	*x = ListAppointmentTypesResponse{}
+	mi := &file_appointment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentTypesResponse) ProtoMessage() {}
+
+func (x *ListAppointmentTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListAppointmentTypesResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAppointmentTypesResponse) GetAppointmentTypes() []*AppointmentType {
+	if x != nil {
+		return x.AppointmentTypes
+	}
+	return nil
+}
+
+type GetAppointmentTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentTypeRequest) Reset() {
+	*x = GetAppointmentTypeRequest{}
+	mi := &file_appointment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentTypeRequest) ProtoMessage() {}
+
+func (x *GetAppointmentTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetAppointmentTypeRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAppointmentTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GroupSession struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Appointment    *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	AttendeeCount  int32                  `protobuf:"varint,2,opt,name=attendee_count,json=attendeeCount,proto3" json:"attendee_count,omitempty"`
+	SeatsRemaining int32                  `protobuf:"varint,3,opt,name=seats_remaining,json=seatsRemaining,proto3" json:"seats_remaining,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GroupSession) Reset() {
+	*x = GroupSession{}
+	mi := &file_appointment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupSession) ProtoMessage() {}
+
+func (x *GroupSession) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupSession.ProtoReflect.Descriptor instead.
+func (*GroupSession) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GroupSession) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+func (x *GroupSession) GetAttendeeCount() int32 {
+	if x != nil {
+		return x.AttendeeCount
+	}
+	return 0
+}
+
+func (x *GroupSession) GetSeatsRemaining() int32 {
+	if x != nil {
+		return x.SeatsRemaining
+	}
+	return 0
+}
+
+type SessionAttendee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAttendee) Reset() {
+	*x = SessionAttendee{}
+	mi := &file_appointment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAttendee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAttendee) ProtoMessage() {}
+
+func (x *SessionAttendee) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAttendee.ProtoReflect.Descriptor instead.
+func (*SessionAttendee) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SessionAttendee) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionAttendee) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SessionAttendee) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SessionAttendee) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// Lists active group sessions starting within [from, to), soonest first.
+type ListGroupSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Leaves out sessions with no seats remaining.
+	AvailableOnly bool   `protobuf:"varint,3,opt,name=available_only,json=availableOnly,proto3" json:"available_only,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupSessionsRequest) Reset() {
+	*x = ListGroupSessionsRequest{}
+	mi := &file_appointment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupSessionsRequest) ProtoMessage() {}
+
+func (x *ListGroupSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListGroupSessionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListGroupSessionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListGroupSessionsRequest) GetAvailableOnly() bool {
+	if x != nil {
+		return x.AvailableOnly
+	}
+	return false
+}
+
+func (x *ListGroupSessionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGroupSessionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListGroupSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*GroupSession        `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupSessionsResponse) Reset() {
+	*x = ListGroupSessionsResponse{}
+	mi := &file_appointment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupSessionsResponse) ProtoMessage() {}
+
+func (x *ListGroupSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListGroupSessionsResponse) GetSessions() []*GroupSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListGroupSessionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type JoinGroupSessionRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId      string                 `protobuf:"bytes,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	ContactInformation *ContactInformation    `protobuf:"bytes,2,opt,name=contact_information,json=contactInformation,proto3" json:"contact_information,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *JoinGroupSessionRequest) Reset() {
+	*x = JoinGroupSessionRequest{}
+	mi := &file_appointment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupSessionRequest) ProtoMessage() {}
+
+func (x *JoinGroupSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupSessionRequest.ProtoReflect.Descriptor instead.
+func (*JoinGroupSessionRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *JoinGroupSessionRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *JoinGroupSessionRequest) GetContactInformation() *ContactInformation {
+	if x != nil {
+		return x.ContactInformation
+	}
+	return nil
+}
+
+type JoinGroupSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *GroupSession          `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Attendee      *SessionAttendee       `protobuf:"bytes,2,opt,name=attendee,proto3" json:"attendee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupSessionResponse) Reset() {
+	*x = JoinGroupSessionResponse{}
+	mi := &file_appointment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupSessionResponse) ProtoMessage() {}
+
+func (x *JoinGroupSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupSessionResponse.ProtoReflect.Descriptor instead.
+func (*JoinGroupSessionResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *JoinGroupSessionResponse) GetSession() *GroupSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *JoinGroupSessionResponse) GetAttendee() *SessionAttendee {
+	if x != nil {
+		return x.Attendee
+	}
+	return nil
+}
+
+type LeaveGroupSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId string                 `protobuf:"bytes,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGroupSessionRequest) Reset() {
+	*x = LeaveGroupSessionRequest{}
+	mi := &file_appointment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGroupSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupSessionRequest) ProtoMessage() {}
+
+func (x *LeaveGroupSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupSessionRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGroupSessionRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LeaveGroupSessionRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *LeaveGroupSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetGroupSessionRosterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId string                 `protobuf:"bytes,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupSessionRosterRequest) Reset() {
+	*x = GetGroupSessionRosterRequest{}
+	mi := &file_appointment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupSessionRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupSessionRosterRequest) ProtoMessage() {}
+
+func (x *GetGroupSessionRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupSessionRosterRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupSessionRosterRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetGroupSessionRosterRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+type GetGroupSessionRosterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *GroupSession          `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Attendees     []*SessionAttendee     `protobuf:"bytes,2,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupSessionRosterResponse) Reset() {
+	*x = GetGroupSessionRosterResponse{}
+	mi := &file_appointment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupSessionRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupSessionRosterResponse) ProtoMessage() {}
+
+func (x *GetGroupSessionRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupSessionRosterResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupSessionRosterResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetGroupSessionRosterResponse) GetSession() *GroupSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *GetGroupSessionRosterResponse) GetAttendees() []*SessionAttendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+type Invitee struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ContactInformation *ContactInformation    `protobuf:"bytes,1,opt,name=contact_information,json=contactInformation,proto3" json:"contact_information,omitempty"`
+	// REQUIRED or OPTIONAL; defaults to REQUIRED.
+	Role          ParticipantRole `protobuf:"varint,2,opt,name=role,proto3,enum=appointment.ParticipantRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invitee) Reset() {
+	*x = Invitee{}
+	mi := &file_appointment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitee) ProtoMessage() {}
+
+func (x *Invitee) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitee.ProtoReflect.Descriptor instead.
+func (*Invitee) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Invitee) GetContactInformation() *ContactInformation {
+	if x != nil {
+		return x.ContactInformation
+	}
+	return nil
+}
+
+func (x *Invitee) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED
+}
+
+type Participant struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email      string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role       ParticipantRole        `protobuf:"varint,4,opt,name=role,proto3,enum=appointment.ParticipantRole" json:"role,omitempty"`
+	RsvpStatus RsvpStatus             `protobuf:"varint,5,opt,name=rsvp_status,json=rsvpStatus,proto3,enum=appointment.RsvpStatus" json:"rsvp_status,omitempty"`
+	// When the participant last answered the invitation.
+	RespondedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Participant) Reset() {
+	*x = Participant{}
+	mi := &file_appointment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Participant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Participant) ProtoMessage() {}
+
+func (x *Participant) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Participant.ProtoReflect.Descriptor instead.
+func (*Participant) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Participant) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Participant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Participant) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Participant) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED
+}
+
+func (x *Participant) GetRsvpStatus() RsvpStatus {
+	if x != nil {
+		return x.RsvpStatus
+	}
+	return RsvpStatus_RSVP_STATUS_UNSPECIFIED
+}
+
+func (x *Participant) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+// Answers an invitation on behalf of user_id. The organizer cannot answer
+// their own appointment. Accepting fails with ALREADY_EXISTS if a required
+// participant has since been booked elsewhere at the time.
+type UpdateRsvpStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId string                 `protobuf:"bytes,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RsvpStatus    RsvpStatus             `protobuf:"varint,3,opt,name=rsvp_status,json=rsvpStatus,proto3,enum=appointment.RsvpStatus" json:"rsvp_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRsvpStatusRequest) Reset() {
+	*x = UpdateRsvpStatusRequest{}
+	mi := &file_appointment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRsvpStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRsvpStatusRequest) ProtoMessage() {}
+
+func (x *UpdateRsvpStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRsvpStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRsvpStatusRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateRsvpStatusRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *UpdateRsvpStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateRsvpStatusRequest) GetRsvpStatus() RsvpStatus {
+	if x != nil {
+		return x.RsvpStatus
+	}
+	return RsvpStatus_RSVP_STATUS_UNSPECIFIED
+}
+
+// WaitlistEntry asks for any slot within [window_start, window_end) that is
+// freed when an appointment is cancelled or moved. Freed slots are offered
+// to entries in the order they joined.
+type WaitlistEntry struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactInformation *ContactInformation    `protobuf:"bytes,3,opt,name=contact_information,json=contactInformation,proto3" json:"contact_information,omitempty"`
+	WindowStart        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Status             WaitlistStatus         `protobuf:"varint,6,opt,name=status,proto3,enum=appointment.WaitlistStatus" json:"status,omitempty"`
+	// The offer waiting for an answer, if any.
+	Offer *WaitlistOffer `protobuf:"bytes,7,opt,name=offer,proto3" json:"offer,omitempty"`
+	// The appointment booked by accepting an offer.
+	AppointmentId string `protobuf:"bytes,8,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	// The zone of the person waiting, for showing offers in local time.
+	TimeZone  string                 `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// What to book when an offer is accepted.
+	Title         string `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_appointment_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WaitlistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetContactInformation() *ContactInformation {
+	if x != nil {
+		return x.ContactInformation
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetStatus() WaitlistStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED
+}
+
+func (x *WaitlistEntry) GetOffer() *WaitlistOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// WaitlistOffer holds a freed slot for one waitlist entry until expires_at.
+// Nobody else can book the slot meanwhile. Declined or expired, it is offered
+// to the next entry.
+type WaitlistOffer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntryId       string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Status        WaitlistOfferStatus    `protobuf:"varint,5,opt,name=status,proto3,enum=appointment.WaitlistOfferStatus" json:"status,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RespondedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistOffer) Reset() {
+	*x = WaitlistOffer{}
+	mi := &file_appointment_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistOffer) ProtoMessage() {}
+
+func (x *WaitlistOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistOffer.ProtoReflect.Descriptor instead.
+func (*WaitlistOffer) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WaitlistOffer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistOffer) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *WaitlistOffer) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *WaitlistOffer) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *WaitlistOffer) GetStatus() WaitlistOfferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WaitlistOfferStatus_WAITLIST_OFFER_STATUS_UNSPECIFIED
+}
+
+func (x *WaitlistOffer) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *WaitlistOffer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WaitlistOffer) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+type JoinWaitlistRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ContactInformation *ContactInformation    `protobuf:"bytes,1,opt,name=contact_information,json=contactInformation,proto3" json:"contact_information,omitempty"`
+	WindowStart        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Title              string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_appointment_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *JoinWaitlistRequest) GetContactInformation() *ContactInformation {
+	if x != nil {
+		return x.ContactInformation
+	}
+	return nil
+}
+
+func (x *JoinWaitlistRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *JoinWaitlistRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *JoinWaitlistRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetWaitlistEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitlistEntryRequest) Reset() {
+	*x = GetWaitlistEntryRequest{}
+	mi := &file_appointment_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitlistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistEntryRequest) ProtoMessage() {}
+
+func (x *GetWaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetWaitlistEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Leaving withdraws any open offer, which then goes to the next entry.
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_appointment_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LeaveWaitlistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Books the offered slot under the booking policy, like CreateAppointment.
+type AcceptWaitlistOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptWaitlistOfferRequest) Reset() {
+	*x = AcceptWaitlistOfferRequest{}
+	mi := &file_appointment_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptWaitlistOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptWaitlistOfferRequest) ProtoMessage() {}
+
+func (x *AcceptWaitlistOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptWaitlistOfferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptWaitlistOfferRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AcceptWaitlistOfferRequest) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+type AcceptWaitlistOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Appointment   *Appointment           `protobuf:"bytes,2,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptWaitlistOfferResponse) Reset() {
+	*x = AcceptWaitlistOfferResponse{}
+	mi := &file_appointment_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptWaitlistOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptWaitlistOfferResponse) ProtoMessage() {}
+
+func (x *AcceptWaitlistOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptWaitlistOfferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptWaitlistOfferResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AcceptWaitlistOfferResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *AcceptWaitlistOfferResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+// The entry stays on the waitlist for other slots.
+type DeclineWaitlistOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineWaitlistOfferRequest) Reset() {
+	*x = DeclineWaitlistOfferRequest{}
+	mi := &file_appointment_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineWaitlistOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineWaitlistOfferRequest) ProtoMessage() {}
+
+func (x *DeclineWaitlistOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineWaitlistOfferRequest.ProtoReflect.Descriptor instead.
+func (*DeclineWaitlistOfferRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeclineWaitlistOfferRequest) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+// SlotHold keeps a slot free for someone while they fill in the booking
+// form. Until it expires it blocks the slot like an appointment.
+type SlotHold struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactInformation *ContactInformation    `protobuf:"bytes,3,opt,name=contact_information,json=contactInformation,proto3" json:"contact_information,omitempty"`
+	StartTime          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ExpiresAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SlotHold) Reset() {
+	*x = SlotHold{}
+	mi := &file_appointment_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotHold) ProtoMessage() {}
+
+func (x *SlotHold) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotHold.ProtoReflect.Descriptor instead.
+func (*SlotHold) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SlotHold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SlotHold) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SlotHold) GetContactInformation() *ContactInformation {
+	if x != nil {
+		return x.ContactInformation
+	}
+	return nil
+}
+
+func (x *SlotHold) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SlotHold) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SlotHold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SlotHold) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type HoldSlotRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ContactInformation *ContactInformation    `protobuf:"bytes,1,opt,name=contact_information,json=contactInformation,proto3" json:"contact_information,omitempty"`
+	StartTime          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Defaults to the zone saved for the user, else the schedule's.
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Releases this earlier hold of the same user, when picking another
+	// slot. Other holds are left alone until they expire.
+	ReplacesHoldId string `protobuf:"bytes,5,opt,name=replaces_hold_id,json=replacesHoldId,proto3" json:"replaces_hold_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HoldSlotRequest) Reset() {
+	*x = HoldSlotRequest{}
+	mi := &file_appointment_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSlotRequest) ProtoMessage() {}
+
+func (x *HoldSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSlotRequest.ProtoReflect.Descriptor instead.
+func (*HoldSlotRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HoldSlotRequest) GetContactInformation() *ContactInformation {
+	if x != nil {
+		return x.ContactInformation
+	}
+	return nil
+}
+
+func (x *HoldSlotRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *HoldSlotRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*GetAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_appointment_proto_rawDescGZIP(), []int{1}
+func (x *HoldSlotRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
 }
 
-func (x *GetAppointmentRequest) GetId() string {
+func (x *HoldSlotRequest) GetReplacesHoldId() string {
 	if x != nil {
-		return x.Id
+		return x.ReplacesHoldId
 	}
 	return ""
 }
 
-type GetUserAppointmentRequest struct {
+// Books the held slot under the booking policy, like CreateAppointment. The
+// appointment keeps the id of the hold. Only the user the slot is held for
+// can confirm it; for anyone else the hold is not found.
+type ConfirmHoldRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Invitees      []*Invitee             `protobuf:"bytes,4,rep,name=invitees,proto3" json:"invitees,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserAppointmentRequest) Reset() {
-	*x = GetUserAppointmentRequest{}
-	mi := &file_appointment_proto_msgTypes[2]
+func (x *ConfirmHoldRequest) Reset() {
+	*x = ConfirmHoldRequest{}
+	mi := &file_appointment_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserAppointmentRequest) String() string {
+func (x *ConfirmHoldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserAppointmentRequest) ProtoMessage() {}
+func (*ConfirmHoldRequest) ProtoMessage() {}
 
-func (x *GetUserAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_appointment_proto_msgTypes[2]
+func (x *ConfirmHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -207,40 +3699,73 @@ func (x *GetUserAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*GetUserAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_appointment_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ConfirmHoldRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmHoldRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetUserAppointmentRequest) GetUserId() string {
+func (x *ConfirmHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetInvitees() []*Invitee {
+	if x != nil {
+		return x.Invitees
+	}
+	return nil
+}
+
+func (x *ConfirmHoldRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetUserAppointmentResponse struct {
+// Moves an active appointment to a new time under the booking policy,
+// keeping its id. If the new time is taken nothing changes.
+type RescheduleAppointmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserAppointmentResponse) Reset() {
-	*x = GetUserAppointmentResponse{}
-	mi := &file_appointment_proto_msgTypes[3]
+func (x *RescheduleAppointmentRequest) Reset() {
+	*x = RescheduleAppointmentRequest{}
+	mi := &file_appointment_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserAppointmentResponse) String() string {
+func (x *RescheduleAppointmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserAppointmentResponse) ProtoMessage() {}
+func (*RescheduleAppointmentRequest) ProtoMessage() {}
 
-func (x *GetUserAppointmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_appointment_proto_msgTypes[3]
+func (x *RescheduleAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,46 +3776,71 @@ func (x *GetUserAppointmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserAppointmentResponse.ProtoReflect.Descriptor instead.
-func (*GetUserAppointmentResponse) Descriptor() ([]byte, []int) {
-	return file_appointment_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use RescheduleAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetUserAppointmentResponse) GetAppointments() []*Appointment {
+func (x *RescheduleAppointmentRequest) GetId() string {
 	if x != nil {
-		return x.Appointments
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RescheduleAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
 	}
 	return nil
 }
 
-type CreateAppointmentRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContactInformation *ContactInformation    `protobuf:"bytes,2,opt,name=contact_information,json=contactInformation,proto3" json:"contact_information,omitempty"`
-	StartTime          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Description        string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Title              string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
-	Date               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *RescheduleAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-func (x *CreateAppointmentRequest) Reset() {
-	*x = CreateAppointmentRequest{}
-	mi := &file_appointment_proto_msgTypes[4]
+func (x *RescheduleAppointmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Reschedule records an appointment being moved.
+type Reschedule struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppointmentId     string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	PreviousStartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=previous_start_time,json=previousStartTime,proto3" json:"previous_start_time,omitempty"`
+	PreviousEndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=previous_end_time,json=previousEndTime,proto3" json:"previous_end_time,omitempty"`
+	StartTime         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Who moved it, as in the audit log: "admin", "anonymous" and so on.
+	Actor         string                 `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reschedule) Reset() {
+	*x = Reschedule{}
+	mi := &file_appointment_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAppointmentRequest) String() string {
+func (x *Reschedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAppointmentRequest) ProtoMessage() {}
+func (*Reschedule) ProtoMessage() {}
 
-func (x *CreateAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_appointment_proto_msgTypes[4]
+func (x *Reschedule) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,83 +3851,96 @@ func (x *CreateAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_appointment_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Reschedule.ProtoReflect.Descriptor instead.
+func (*Reschedule) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CreateAppointmentRequest) GetUserId() string {
+func (x *Reschedule) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateAppointmentRequest) GetContactInformation() *ContactInformation {
+func (x *Reschedule) GetAppointmentId() string {
 	if x != nil {
-		return x.ContactInformation
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *Reschedule) GetPreviousStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousStartTime
 	}
 	return nil
 }
 
-func (x *CreateAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
+func (x *Reschedule) GetPreviousEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousEndTime
+	}
+	return nil
+}
+
+func (x *Reschedule) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.StartTime
 	}
 	return nil
 }
 
-func (x *CreateAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *Reschedule) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EndTime
 	}
 	return nil
 }
 
-func (x *CreateAppointmentRequest) GetDescription() string {
+func (x *Reschedule) GetActor() string {
 	if x != nil {
-		return x.Description
+		return x.Actor
 	}
 	return ""
 }
 
-func (x *CreateAppointmentRequest) GetTitle() string {
+func (x *Reschedule) GetReason() string {
 	if x != nil {
-		return x.Title
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CreateAppointmentRequest) GetDate() *timestamppb.Timestamp {
+func (x *Reschedule) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Date
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type UpdateAppointmentRequest struct {
+type ListReschedulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAppointmentRequest) Reset() {
-	*x = UpdateAppointmentRequest{}
-	mi := &file_appointment_proto_msgTypes[5]
+func (x *ListReschedulesRequest) Reset() {
+	*x = ListReschedulesRequest{}
+	mi := &file_appointment_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAppointmentRequest) String() string {
+func (x *ListReschedulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppointmentRequest) ProtoMessage() {}
+func (*ListReschedulesRequest) ProtoMessage() {}
 
-func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_appointment_proto_msgTypes[5]
+func (x *ListReschedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,47 +3951,41 @@ func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_appointment_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *UpdateAppointmentRequest) GetAppointment() *Appointment {
-	if x != nil {
-		return x.Appointment
-	}
-	return nil
+// Deprecated: Use ListReschedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListReschedulesRequest) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UpdateAppointmentRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *ListReschedulesRequest) GetAppointmentId() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.AppointmentId
 	}
-	return nil
+	return ""
 }
 
-type DeleteAppointmentRequest struct {
+// Oldest first.
+type ListReschedulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reschedules   []*Reschedule          `protobuf:"bytes,1,rep,name=reschedules,proto3" json:"reschedules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAppointmentRequest) Reset() {
-	*x = DeleteAppointmentRequest{}
-	mi := &file_appointment_proto_msgTypes[6]
+func (x *ListReschedulesResponse) Reset() {
+	*x = ListReschedulesResponse{}
+	mi := &file_appointment_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAppointmentRequest) String() string {
+func (x *ListReschedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAppointmentRequest) ProtoMessage() {}
+func (*ListReschedulesResponse) ProtoMessage() {}
 
-func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_appointment_proto_msgTypes[6]
+func (x *ListReschedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,40 +3996,46 @@ func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_appointment_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListReschedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListReschedulesResponse) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteAppointmentRequest) GetId() string {
+func (x *ListReschedulesResponse) GetReschedules() []*Reschedule {
 	if x != nil {
-		return x.Id
+		return x.Reschedules
 	}
-	return ""
+	return nil
 }
 
-type DeleteAppointmentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type Cancellation struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Reason CancellationReason     `protobuf:"varint,1,opt,name=reason,proto3,enum=appointment.CancellationReason" json:"reason,omitempty"`
+	// Free text from whoever cancelled.
+	Note        string      `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	CancelledBy CancelledBy `protobuf:"varint,3,opt,name=cancelled_by,json=cancelledBy,proto3,enum=appointment.CancelledBy" json:"cancelled_by,omitempty"`
+	// Whether a client cancelled within the schedule's late cancellation
+	// window of the start time. A provider cancelling is never late.
+	Late          bool `protobuf:"varint,4,opt,name=late,proto3" json:"late,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAppointmentResponse) Reset() {
-	*x = DeleteAppointmentResponse{}
-	mi := &file_appointment_proto_msgTypes[7]
+func (x *Cancellation) Reset() {
+	*x = Cancellation{}
+	mi := &file_appointment_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAppointmentResponse) String() string {
+func (x *Cancellation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAppointmentResponse) ProtoMessage() {}
+func (*Cancellation) ProtoMessage() {}
 
-func (x *DeleteAppointmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_appointment_proto_msgTypes[7]
+func (x *Cancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_appointment_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,75 +4046,44 @@ func (x *DeleteAppointmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAppointmentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAppointmentResponse) Descriptor() ([]byte, []int) {
-	return file_appointment_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Cancellation.ProtoReflect.Descriptor instead.
+func (*Cancellation) Descriptor() ([]byte, []int) {
+	return file_appointment_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DeleteAppointmentResponse) GetSuccess() bool {
+func (x *Cancellation) GetReason() CancellationReason {
 	if x != nil {
-		return x.Success
+		return x.Reason
 	}
-	return false
-}
-
-type ContactInformation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return CancellationReason_CANCELLATION_REASON_UNSPECIFIED
 }
 
-func (x *ContactInformation) Reset() {
-	*x = ContactInformation{}
-	mi := &file_appointment_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ContactInformation) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ContactInformation) ProtoMessage() {}
-
-func (x *ContactInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_appointment_proto_msgTypes[8]
+func (x *Cancellation) GetNote() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Note
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ContactInformation.ProtoReflect.Descriptor instead.
-func (*ContactInformation) Descriptor() ([]byte, []int) {
-	return file_appointment_proto_rawDescGZIP(), []int{8}
+	return ""
 }
 
-func (x *ContactInformation) GetName() string {
+func (x *Cancellation) GetCancelledBy() CancelledBy {
 	if x != nil {
-		return x.Name
+		return x.CancelledBy
 	}
-	return ""
+	return CancelledBy_CANCELLED_BY_UNSPECIFIED
 }
 
-func (x *ContactInformation) GetEmail() string {
+func (x *Cancellation) GetLate() bool {
 	if x != nil {
-		return x.Email
+		return x.Late
 	}
-	return ""
+	return false
 }
 
 var File_appointment_proto protoreflect.FileDescriptor
 
 const file_appointment_proto_rawDesc = "" +
 	"\n" +
-	"\x11appointment.proto\x12\vappointment\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\"\x9d\x03\n" +
+	"\x11appointment.proto\x12\vappointment\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1egoogle/protobuf/duration.proto\"\xf7\x06\n" +
 	"\vAppointment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12 \n" +
@@ -563,13 +4095,33 @@ const file_appointment_proto_rawDesc = "" +
 	"\x05title\x18\a \x01(\tR\x05title\x12.\n" +
 	"\x04date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x129\n" +
 	"\n" +
-	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"'\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\ttime_zone\x18\f \x01(\tR\btimeZone\x12>\n" +
+	"\rbuffer_before\x18\r \x01(\v2\x19.google.protobuf.DurationR\fbufferBefore\x12<\n" +
+	"\fbuffer_after\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\vbufferAfter\x12.\n" +
+	"\x13appointment_type_id\x18\x0f \x01(\tR\x11appointmentTypeId\x12\x1a\n" +
+	"\bcapacity\x18\x10 \x01(\x05R\bcapacity\x12<\n" +
+	"\fparticipants\x18\x11 \x03(\v2\x18.appointment.ParticipantR\fparticipants\x12=\n" +
+	"\fcancellation\x18\x12 \x01(\v2\x19.appointment.CancellationR\fcancellation\"'\n" +
 	"\x15GetAppointmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc4\x02\n" +
 	"\x19GetUserAppointmentRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"Z\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x123\n" +
+	"\x05scope\x18\x06 \x01(\x0e2\x1d.appointment.AppointmentScopeR\x05scope\x12A\n" +
+	"\x0esort_direction\x18\a \x01(\x0e2\x1a.appointment.SortDirectionR\rsortDirection\"\x82\x01\n" +
 	"\x1aGetUserAppointmentResponse\x12<\n" +
-	"\fappointments\x18\x01 \x03(\v2\x18.appointment.AppointmentR\fappointments\"\xdf\x02\n" +
+	"\fappointments\x18\x01 \x03(\v2\x18.appointment.AppointmentR\fappointments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfa\x03\n" +
 	"\x18CreateAppointmentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12P\n" +
 	"\x13contact_information\x18\x02 \x01(\v2\x1f.appointment.ContactInformationR\x12contactInformation\x129\n" +
@@ -578,24 +4130,327 @@ const file_appointment_proto_rawDesc = "" +
 	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05title\x18\x06 \x01(\tR\x05title\x12.\n" +
-	"\x04date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\x93\x01\n" +
+	"\x04date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\b \x01(\tR\btimeZone\x12.\n" +
+	"\x13appointment_type_id\x18\t \x01(\tR\x11appointmentTypeId\x12\x1a\n" +
+	"\bcapacity\x18\n" +
+	" \x01(\x05R\bcapacity\x120\n" +
+	"\binvitees\x18\v \x03(\v2\x14.appointment.InviteeR\binvitees\"\x93\x01\n" +
 	"\x18UpdateAppointmentRequest\x12:\n" +
 	"\vappointment\x18\x01 \x01(\v2\x18.appointment.AppointmentR\vappointment\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"*\n" +
+	"updateMask\"w\n" +
 	"\x18DeleteAppointmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1f.appointment.CancellationReasonR\x06reason\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"q\n" +
 	"\x19DeleteAppointmentResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\">\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12:\n" +
+	"\vappointment\x18\x02 \x01(\v2\x18.appointment.AppointmentR\vappointment\"u\n" +
+	"\x1eListDeletedAppointmentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x87\x01\n" +
+	"\x1fListDeletedAppointmentsResponse\x12<\n" +
+	"\fappointments\x18\x01 \x03(\v2\x18.appointment.AppointmentR\fappointments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"+\n" +
+	"\x19RestoreAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x86\x01\n" +
+	"\x19SearchAppointmentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x84\x01\n" +
+	"\x1aSearchAppointmentsResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.appointment.AppointmentSearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbf\x01\n" +
+	"\x17AppointmentSearchResult\x12:\n" +
+	"\vappointment\x18\x01 \x01(\v2\x18.appointment.AppointmentR\vappointment\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12#\n" +
+	"\rtitle_snippet\x18\x03 \x01(\tR\ftitleSnippet\x12/\n" +
+	"\x13description_snippet\x18\x04 \x01(\tR\x12descriptionSnippet\"_\n" +
+	"\x15ImportCalendarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"\xe2\x01\n" +
+	"\x16ImportCalendarResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.appointment.ImportEventResultR\aresults\x12%\n" +
+	"\x0eimported_count\x18\x02 \x01(\x05R\rimportedCount\x12'\n" +
+	"\x0fduplicate_count\x18\x03 \x01(\x05R\x0eduplicateCount\x12%\n" +
+	"\x0erejected_count\x18\x04 \x01(\x05R\rrejectedCount\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"\xb8\x01\n" +
+	"\x11ImportEventResult\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x126\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1e.appointment.ImportEventStatusR\x06status\x12%\n" +
+	"\x0eappointment_id\x18\x04 \x01(\tR\rappointmentId\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\">\n" +
 	"\x12ContactInformation\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email2\xdc\x03\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"^\n" +
+	"\x17BookingPolicyViolations\x12C\n" +
+	"\n" +
+	"violations\x18\x01 \x03(\v2#.appointment.BookingPolicyViolationR\n" +
+	"violations\"n\n" +
+	"\x16BookingPolicyViolation\x122\n" +
+	"\x04rule\x18\x01 \x01(\x0e2\x1e.appointment.BookingPolicyRuleR\x04rule\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xb0\x03\n" +
+	"\x0fAppointmentType\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12>\n" +
+	"\rbuffer_before\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fbufferBefore\x12<\n" +
+	"\fbuffer_after\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vbufferAfter\x12\x14\n" +
+	"\x05color\x18\a \x01(\tR\x05color\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"H\n" +
+	"\x1bListAppointmentTypesRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"i\n" +
+	"\x1cListAppointmentTypesResponse\x12I\n" +
+	"\x11appointment_types\x18\x01 \x03(\v2\x1c.appointment.AppointmentTypeR\x10appointmentTypes\"+\n" +
+	"\x19GetAppointmentTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9a\x01\n" +
+	"\fGroupSession\x12:\n" +
+	"\vappointment\x18\x01 \x01(\v2\x18.appointment.AppointmentR\vappointment\x12%\n" +
+	"\x0eattendee_count\x18\x02 \x01(\x05R\rattendeeCount\x12'\n" +
+	"\x0fseats_remaining\x18\x03 \x01(\x05R\x0eseatsRemaining\"\x8d\x01\n" +
+	"\x0fSessionAttendee\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x127\n" +
+	"\tjoined_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\xd9\x01\n" +
+	"\x18ListGroupSessionsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12%\n" +
+	"\x0eavailable_only\x18\x03 \x01(\bR\ravailableOnly\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"z\n" +
+	"\x19ListGroupSessionsResponse\x125\n" +
+	"\bsessions\x18\x01 \x03(\v2\x19.appointment.GroupSessionR\bsessions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x92\x01\n" +
+	"\x17JoinGroupSessionRequest\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\tR\rappointmentId\x12P\n" +
+	"\x13contact_information\x18\x02 \x01(\v2\x1f.appointment.ContactInformationR\x12contactInformation\"\x89\x01\n" +
+	"\x18JoinGroupSessionResponse\x123\n" +
+	"\asession\x18\x01 \x01(\v2\x19.appointment.GroupSessionR\asession\x128\n" +
+	"\battendee\x18\x02 \x01(\v2\x1c.appointment.SessionAttendeeR\battendee\"Z\n" +
+	"\x18LeaveGroupSessionRequest\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\tR\rappointmentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"E\n" +
+	"\x1cGetGroupSessionRosterRequest\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\tR\rappointmentId\"\x90\x01\n" +
+	"\x1dGetGroupSessionRosterResponse\x123\n" +
+	"\asession\x18\x01 \x01(\v2\x19.appointment.GroupSessionR\asession\x12:\n" +
+	"\tattendees\x18\x02 \x03(\v2\x1c.appointment.SessionAttendeeR\tattendees\"\x8d\x01\n" +
+	"\aInvitee\x12P\n" +
+	"\x13contact_information\x18\x01 \x01(\v2\x1f.appointment.ContactInformationR\x12contactInformation\x120\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1c.appointment.ParticipantRoleR\x04role\"\xfb\x01\n" +
+	"\vParticipant\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x120\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x1c.appointment.ParticipantRoleR\x04role\x128\n" +
+	"\vrsvp_status\x18\x05 \x01(\x0e2\x17.appointment.RsvpStatusR\n" +
+	"rsvpStatus\x12=\n" +
+	"\fresponded_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAt\"\x93\x01\n" +
+	"\x17UpdateRsvpStatusRequest\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\tR\rappointmentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x128\n" +
+	"\vrsvp_status\x18\x03 \x01(\x0e2\x17.appointment.RsvpStatusR\n" +
+	"rsvpStatus\"\xdd\x04\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12P\n" +
+	"\x13contact_information\x18\x03 \x01(\v2\x1f.appointment.ContactInformationR\x12contactInformation\x12=\n" +
+	"\fwindow_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x123\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1b.appointment.WaitlistStatusR\x06status\x120\n" +
+	"\x05offer\x18\a \x01(\v2\x1a.appointment.WaitlistOfferR\x05offer\x12%\n" +
+	"\x0eappointment_id\x18\b \x01(\tR\rappointmentId\x12\x1b\n" +
+	"\ttime_zone\x18\t \x01(\tR\btimeZone\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
+	"\x05title\x18\f \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\r \x01(\tR\vdescription\"\x9b\x03\n" +
+	"\rWaitlistOffer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\tR\aentryId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x128\n" +
+	"\x06status\x18\x05 \x01(\x0e2 .appointment.WaitlistOfferStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fresponded_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAt\"\x99\x02\n" +
+	"\x13JoinWaitlistRequest\x12P\n" +
+	"\x13contact_information\x18\x01 \x01(\v2\x1f.appointment.ContactInformationR\x12contactInformation\x12=\n" +
+	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\")\n" +
+	"\x17GetWaitlistEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"&\n" +
+	"\x14LeaveWaitlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x1aAcceptWaitlistOfferRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\"\x8b\x01\n" +
+	"\x1bAcceptWaitlistOfferResponse\x120\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1a.appointment.WaitlistEntryR\x05entry\x12:\n" +
+	"\vappointment\x18\x02 \x01(\v2\x18.appointment.AppointmentR\vappointment\"8\n" +
+	"\x1bDeclineWaitlistOfferRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\"\xed\x02\n" +
+	"\bSlotHold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12P\n" +
+	"\x13contact_information\x18\x03 \x01(\v2\x1f.appointment.ContactInformationR\x12contactInformation\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9c\x02\n" +
+	"\x0fHoldSlotRequest\x12P\n" +
+	"\x13contact_information\x18\x01 \x01(\v2\x1f.appointment.ContactInformationR\x12contactInformation\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x12(\n" +
+	"\x10replaces_hold_id\x18\x05 \x01(\tR\x0ereplacesHoldId\"\xb0\x01\n" +
+	"\x12ConfirmHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x120\n" +
+	"\binvitees\x18\x04 \x03(\v2\x14.appointment.InviteeR\binvitees\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\"\xb8\x01\n" +
+	"\x1cRescheduleAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xb2\x03\n" +
+	"\n" +
+	"Reschedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x12J\n" +
+	"\x13previous_start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11previousStartTime\x12F\n" +
+	"\x11previous_end_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fpreviousEndTime\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"?\n" +
+	"\x16ListReschedulesRequest\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\tR\rappointmentId\"T\n" +
+	"\x17ListReschedulesResponse\x129\n" +
+	"\vreschedules\x18\x01 \x03(\v2\x17.appointment.RescheduleR\vreschedules\"\xac\x01\n" +
+	"\fCancellation\x127\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x1f.appointment.CancellationReasonR\x06reason\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12;\n" +
+	"\fcancelled_by\x18\x03 \x01(\x0e2\x18.appointment.CancelledByR\vcancelledBy\x12\x12\n" +
+	"\x04late\x18\x04 \x01(\bR\x04late*\x8c\x01\n" +
+	"\x10AppointmentScope\x12!\n" +
+	"\x1dAPPOINTMENT_SCOPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15APPOINTMENT_SCOPE_ALL\x10\x01\x12\x1e\n" +
+	"\x1aAPPOINTMENT_SCOPE_UPCOMING\x10\x02\x12\x1a\n" +
+	"\x16APPOINTMENT_SCOPE_PAST\x10\x03*l\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SORT_DIRECTION_ASCENDING\x10\x01\x12\x1d\n" +
+	"\x19SORT_DIRECTION_DESCENDING\x10\x02*\xc0\x01\n" +
+	"\x11ImportEventStatus\x12#\n" +
+	"\x1fIMPORT_EVENT_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cIMPORT_EVENT_STATUS_IMPORTED\x10\x01\x12!\n" +
+	"\x1dIMPORT_EVENT_STATUS_DUPLICATE\x10\x02\x12 \n" +
+	"\x1cIMPORT_EVENT_STATUS_CONFLICT\x10\x03\x12\x1f\n" +
+	"\x1bIMPORT_EVENT_STATUS_INVALID\x10\x04*\xc0\x02\n" +
+	"\x11BookingPolicyRule\x12#\n" +
+	"\x1fBOOKING_POLICY_RULE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eBOOKING_POLICY_RULE_MIN_NOTICE\x10\x01\x12#\n" +
+	"\x1fBOOKING_POLICY_RULE_MAX_ADVANCE\x10\x02\x12$\n" +
+	" BOOKING_POLICY_RULE_MIN_DURATION\x10\x03\x12$\n" +
+	" BOOKING_POLICY_RULE_MAX_DURATION\x10\x04\x12&\n" +
+	"\"BOOKING_POLICY_RULE_SLOT_ALIGNMENT\x10\x05\x12#\n" +
+	"\x1fBOOKING_POLICY_RULE_MAX_PER_DAY\x10\x06\x12$\n" +
+	" BOOKING_POLICY_RULE_MAX_PER_WEEK\x10\a*\x91\x01\n" +
+	"\x0fParticipantRole\x12 \n" +
+	"\x1cPARTICIPANT_ROLE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPARTICIPANT_ROLE_ORGANIZER\x10\x01\x12\x1d\n" +
+	"\x19PARTICIPANT_ROLE_REQUIRED\x10\x02\x12\x1d\n" +
+	"\x19PARTICIPANT_ROLE_OPTIONAL\x10\x03*\x96\x01\n" +
+	"\n" +
+	"RsvpStatus\x12\x1b\n" +
+	"\x17RSVP_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RSVP_STATUS_NEEDS_ACTION\x10\x01\x12\x18\n" +
+	"\x14RSVP_STATUS_ACCEPTED\x10\x02\x12\x18\n" +
+	"\x14RSVP_STATUS_DECLINED\x10\x03\x12\x19\n" +
+	"\x15RSVP_STATUS_TENTATIVE\x10\x04*\x89\x01\n" +
+	"\x0eWaitlistStatus\x12\x1f\n" +
+	"\x1bWAITLIST_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17WAITLIST_STATUS_WAITING\x10\x01\x12\x1a\n" +
+	"\x16WAITLIST_STATUS_BOOKED\x10\x02\x12\x1d\n" +
+	"\x19WAITLIST_STATUS_CANCELLED\x10\x03*\xef\x01\n" +
+	"\x13WaitlistOfferStatus\x12%\n" +
+	"!WAITLIST_OFFER_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dWAITLIST_OFFER_STATUS_PENDING\x10\x01\x12\"\n" +
+	"\x1eWAITLIST_OFFER_STATUS_ACCEPTED\x10\x02\x12\"\n" +
+	"\x1eWAITLIST_OFFER_STATUS_DECLINED\x10\x03\x12!\n" +
+	"\x1dWAITLIST_OFFER_STATUS_EXPIRED\x10\x04\x12#\n" +
+	"\x1fWAITLIST_OFFER_STATUS_WITHDRAWN\x10\x05*\xfc\x01\n" +
+	"\x12CancellationReason\x12#\n" +
+	"\x1fCANCELLATION_REASON_UNSPECIFIED\x10\x00\x12)\n" +
+	"%CANCELLATION_REASON_SCHEDULE_CONFLICT\x10\x01\x12\x1f\n" +
+	"\x1bCANCELLATION_REASON_ILLNESS\x10\x02\x12(\n" +
+	"$CANCELLATION_REASON_NO_LONGER_NEEDED\x10\x03\x12,\n" +
+	"(CANCELLATION_REASON_PROVIDER_UNAVAILABLE\x10\x04\x12\x1d\n" +
+	"\x19CANCELLATION_REASON_OTHER\x10\x05*_\n" +
+	"\vCancelledBy\x12\x1c\n" +
+	"\x18CANCELLED_BY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CANCELLED_BY_CLIENT\x10\x01\x12\x19\n" +
+	"\x15CANCELLED_BY_PROVIDER\x10\x022\x9a\x12\n" +
 	"\x12AppointmentService\x12N\n" +
 	"\x0eGetAppointment\x12\".appointment.GetAppointmentRequest\x1a\x18.appointment.Appointment\x12f\n" +
 	"\x13GetUserAppointments\x12&.appointment.GetUserAppointmentRequest\x1a'.appointment.GetUserAppointmentResponse\x12T\n" +
 	"\x11CreateAppointment\x12%.appointment.CreateAppointmentRequest\x1a\x18.appointment.Appointment\x12T\n" +
 	"\x11UpdateAppointment\x12%.appointment.UpdateAppointmentRequest\x1a\x18.appointment.Appointment\x12b\n" +
-	"\x11DeleteAppointment\x12%.appointment.DeleteAppointmentRequest\x1a&.appointment.DeleteAppointmentResponseB1Z/github.com/folucode/appointment-scheduler/protob\x06proto3"
+	"\x11DeleteAppointment\x12%.appointment.DeleteAppointmentRequest\x1a&.appointment.DeleteAppointmentResponse\x12e\n" +
+	"\x12SearchAppointments\x12&.appointment.SearchAppointmentsRequest\x1a'.appointment.SearchAppointmentsResponse\x12[\n" +
+	"\x0eImportCalendar\x12\".appointment.ImportCalendarRequest\x1a#.appointment.ImportCalendarResponse(\x01\x12t\n" +
+	"\x17ListDeletedAppointments\x12+.appointment.ListDeletedAppointmentsRequest\x1a,.appointment.ListDeletedAppointmentsResponse\x12V\n" +
+	"\x12RestoreAppointment\x12&.appointment.RestoreAppointmentRequest\x1a\x18.appointment.Appointment\x12k\n" +
+	"\x14ListAppointmentTypes\x12(.appointment.ListAppointmentTypesRequest\x1a).appointment.ListAppointmentTypesResponse\x12Z\n" +
+	"\x12GetAppointmentType\x12&.appointment.GetAppointmentTypeRequest\x1a\x1c.appointment.AppointmentType\x12b\n" +
+	"\x11ListGroupSessions\x12%.appointment.ListGroupSessionsRequest\x1a&.appointment.ListGroupSessionsResponse\x12_\n" +
+	"\x10JoinGroupSession\x12$.appointment.JoinGroupSessionRequest\x1a%.appointment.JoinGroupSessionResponse\x12U\n" +
+	"\x11LeaveGroupSession\x12%.appointment.LeaveGroupSessionRequest\x1a\x19.appointment.GroupSession\x12n\n" +
+	"\x15GetGroupSessionRoster\x12).appointment.GetGroupSessionRosterRequest\x1a*.appointment.GetGroupSessionRosterResponse\x12R\n" +
+	"\x10UpdateRsvpStatus\x12$.appointment.UpdateRsvpStatusRequest\x1a\x18.appointment.Participant\x12L\n" +
+	"\fJoinWaitlist\x12 .appointment.JoinWaitlistRequest\x1a\x1a.appointment.WaitlistEntry\x12T\n" +
+	"\x10GetWaitlistEntry\x12$.appointment.GetWaitlistEntryRequest\x1a\x1a.appointment.WaitlistEntry\x12N\n" +
+	"\rLeaveWaitlist\x12!.appointment.LeaveWaitlistRequest\x1a\x1a.appointment.WaitlistEntry\x12h\n" +
+	"\x13AcceptWaitlistOffer\x12'.appointment.AcceptWaitlistOfferRequest\x1a(.appointment.AcceptWaitlistOfferResponse\x12\\\n" +
+	"\x14DeclineWaitlistOffer\x12(.appointment.DeclineWaitlistOfferRequest\x1a\x1a.appointment.WaitlistEntry\x12?\n" +
+	"\bHoldSlot\x12\x1c.appointment.HoldSlotRequest\x1a\x15.appointment.SlotHold\x12H\n" +
+	"\vConfirmHold\x12\x1f.appointment.ConfirmHoldRequest\x1a\x18.appointment.Appointment\x12\\\n" +
+	"\x15RescheduleAppointment\x12).appointment.RescheduleAppointmentRequest\x1a\x18.appointment.Appointment\x12\\\n" +
+	"\x0fListReschedules\x12#.appointment.ListReschedulesRequest\x1a$.appointment.ListReschedulesResponseB1Z/github.com/folucode/appointment-scheduler/protob\x06proto3"
 
 var (
 	file_appointment_proto_rawDescOnce sync.Once
@@ -609,48 +4464,222 @@ func file_appointment_proto_rawDescGZIP() []byte {
 	return file_appointment_proto_rawDescData
 }
 
-var file_appointment_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_appointment_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_appointment_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_appointment_proto_goTypes = []any{
-	(*Appointment)(nil),                // 0: appointment.Appointment
-	(*GetAppointmentRequest)(nil),      // 1: appointment.GetAppointmentRequest
-	(*GetUserAppointmentRequest)(nil),  // 2: appointment.GetUserAppointmentRequest
-	(*GetUserAppointmentResponse)(nil), // 3: appointment.GetUserAppointmentResponse
-	(*CreateAppointmentRequest)(nil),   // 4: appointment.CreateAppointmentRequest
-	(*UpdateAppointmentRequest)(nil),   // 5: appointment.UpdateAppointmentRequest
-	(*DeleteAppointmentRequest)(nil),   // 6: appointment.DeleteAppointmentRequest
-	(*DeleteAppointmentResponse)(nil),  // 7: appointment.DeleteAppointmentResponse
-	(*ContactInformation)(nil),         // 8: appointment.ContactInformation
-	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),      // 10: google.protobuf.FieldMask
+	(AppointmentScope)(0),                   // 0: appointment.AppointmentScope
+	(SortDirection)(0),                      // 1: appointment.SortDirection
+	(ImportEventStatus)(0),                  // 2: appointment.ImportEventStatus
+	(BookingPolicyRule)(0),                  // 3: appointment.BookingPolicyRule
+	(ParticipantRole)(0),                    // 4: appointment.ParticipantRole
+	(RsvpStatus)(0),                         // 5: appointment.RsvpStatus
+	(WaitlistStatus)(0),                     // 6: appointment.WaitlistStatus
+	(WaitlistOfferStatus)(0),                // 7: appointment.WaitlistOfferStatus
+	(CancellationReason)(0),                 // 8: appointment.CancellationReason
+	(CancelledBy)(0),                        // 9: appointment.CancelledBy
+	(*Appointment)(nil),                     // 10: appointment.Appointment
+	(*GetAppointmentRequest)(nil),           // 11: appointment.GetAppointmentRequest
+	(*GetUserAppointmentRequest)(nil),       // 12: appointment.GetUserAppointmentRequest
+	(*GetUserAppointmentResponse)(nil),      // 13: appointment.GetUserAppointmentResponse
+	(*CreateAppointmentRequest)(nil),        // 14: appointment.CreateAppointmentRequest
+	(*UpdateAppointmentRequest)(nil),        // 15: appointment.UpdateAppointmentRequest
+	(*DeleteAppointmentRequest)(nil),        // 16: appointment.DeleteAppointmentRequest
+	(*DeleteAppointmentResponse)(nil),       // 17: appointment.DeleteAppointmentResponse
+	(*ListDeletedAppointmentsRequest)(nil),  // 18: appointment.ListDeletedAppointmentsRequest
+	(*ListDeletedAppointmentsResponse)(nil), // 19: appointment.ListDeletedAppointmentsResponse
+	(*RestoreAppointmentRequest)(nil),       // 20: appointment.RestoreAppointmentRequest
+	(*SearchAppointmentsRequest)(nil),       // 21: appointment.SearchAppointmentsRequest
+	(*SearchAppointmentsResponse)(nil),      // 22: appointment.SearchAppointmentsResponse
+	(*AppointmentSearchResult)(nil),         // 23: appointment.AppointmentSearchResult
+	(*ImportCalendarRequest)(nil),           // 24: appointment.ImportCalendarRequest
+	(*ImportCalendarResponse)(nil),          // 25: appointment.ImportCalendarResponse
+	(*ImportEventResult)(nil),               // 26: appointment.ImportEventResult
+	(*ContactInformation)(nil),              // 27: appointment.ContactInformation
+	(*BookingPolicyViolations)(nil),         // 28: appointment.BookingPolicyViolations
+	(*BookingPolicyViolation)(nil),          // 29: appointment.BookingPolicyViolation
+	(*AppointmentType)(nil),                 // 30: appointment.AppointmentType
+	(*ListAppointmentTypesRequest)(nil),     // 31: appointment.ListAppointmentTypesRequest
+	(*ListAppointmentTypesResponse)(nil),    // 32: appointment.ListAppointmentTypesResponse
+	(*GetAppointmentTypeRequest)(nil),       // 33: appointment.GetAppointmentTypeRequest
+	(*GroupSession)(nil),                    // 34: appointment.GroupSession
+	(*SessionAttendee)(nil),                 // 35: appointment.SessionAttendee
+	(*ListGroupSessionsRequest)(nil),        // 36: appointment.ListGroupSessionsRequest
+	(*ListGroupSessionsResponse)(nil),       // 37: appointment.ListGroupSessionsResponse
+	(*JoinGroupSessionRequest)(nil),         // 38: appointment.JoinGroupSessionRequest
+	(*JoinGroupSessionResponse)(nil),        // 39: appointment.JoinGroupSessionResponse
+	(*LeaveGroupSessionRequest)(nil),        // 40: appointment.LeaveGroupSessionRequest
+	(*GetGroupSessionRosterRequest)(nil),    // 41: appointment.GetGroupSessionRosterRequest
+	(*GetGroupSessionRosterResponse)(nil),   // 42: appointment.GetGroupSessionRosterResponse
+	(*Invitee)(nil),                         // 43: appointment.Invitee
+	(*Participant)(nil),                     // 44: appointment.Participant
+	(*UpdateRsvpStatusRequest)(nil),         // 45: appointment.UpdateRsvpStatusRequest
+	(*WaitlistEntry)(nil),                   // 46: appointment.WaitlistEntry
+	(*WaitlistOffer)(nil),                   // 47: appointment.WaitlistOffer
+	(*JoinWaitlistRequest)(nil),             // 48: appointment.JoinWaitlistRequest
+	(*GetWaitlistEntryRequest)(nil),         // 49: appointment.GetWaitlistEntryRequest
+	(*LeaveWaitlistRequest)(nil),            // 50: appointment.LeaveWaitlistRequest
+	(*AcceptWaitlistOfferRequest)(nil),      // 51: appointment.AcceptWaitlistOfferRequest
+	(*AcceptWaitlistOfferResponse)(nil),     // 52: appointment.AcceptWaitlistOfferResponse
+	(*DeclineWaitlistOfferRequest)(nil),     // 53: appointment.DeclineWaitlistOfferRequest
+	(*SlotHold)(nil),                        // 54: appointment.SlotHold
+	(*HoldSlotRequest)(nil),                 // 55: appointment.HoldSlotRequest
+	(*ConfirmHoldRequest)(nil),              // 56: appointment.ConfirmHoldRequest
+	(*RescheduleAppointmentRequest)(nil),    // 57: appointment.RescheduleAppointmentRequest
+	(*Reschedule)(nil),                      // 58: appointment.Reschedule
+	(*ListReschedulesRequest)(nil),          // 59: appointment.ListReschedulesRequest
+	(*ListReschedulesResponse)(nil),         // 60: appointment.ListReschedulesResponse
+	(*Cancellation)(nil),                    // 61: appointment.Cancellation
+	(*timestamppb.Timestamp)(nil),           // 62: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 63: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),           // 64: google.protobuf.FieldMask
 }
 var file_appointment_proto_depIdxs = []int32{
-	8,  // 0: appointment.Appointment.contact_information:type_name -> appointment.ContactInformation
-	9,  // 1: appointment.Appointment.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 2: appointment.Appointment.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 3: appointment.Appointment.date:type_name -> google.protobuf.Timestamp
-	9,  // 4: appointment.Appointment.deleted_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: appointment.GetUserAppointmentResponse.appointments:type_name -> appointment.Appointment
-	8,  // 6: appointment.CreateAppointmentRequest.contact_information:type_name -> appointment.ContactInformation
-	9,  // 7: appointment.CreateAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 8: appointment.CreateAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 9: appointment.CreateAppointmentRequest.date:type_name -> google.protobuf.Timestamp
-	0,  // 10: appointment.UpdateAppointmentRequest.appointment:type_name -> appointment.Appointment
-	10, // 11: appointment.UpdateAppointmentRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 12: appointment.AppointmentService.GetAppointment:input_type -> appointment.GetAppointmentRequest
-	2,  // 13: appointment.AppointmentService.GetUserAppointments:input_type -> appointment.GetUserAppointmentRequest
-	4,  // 14: appointment.AppointmentService.CreateAppointment:input_type -> appointment.CreateAppointmentRequest
-	5,  // 15: appointment.AppointmentService.UpdateAppointment:input_type -> appointment.UpdateAppointmentRequest
-	6,  // 16: appointment.AppointmentService.DeleteAppointment:input_type -> appointment.DeleteAppointmentRequest
-	0,  // 17: appointment.AppointmentService.GetAppointment:output_type -> appointment.Appointment
-	3,  // 18: appointment.AppointmentService.GetUserAppointments:output_type -> appointment.GetUserAppointmentResponse
-	0,  // 19: appointment.AppointmentService.CreateAppointment:output_type -> appointment.Appointment
-	0,  // 20: appointment.AppointmentService.UpdateAppointment:output_type -> appointment.Appointment
-	7,  // 21: appointment.AppointmentService.DeleteAppointment:output_type -> appointment.DeleteAppointmentResponse
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	27,  // 0: appointment.Appointment.contact_information:type_name -> appointment.ContactInformation
+	62,  // 1: appointment.Appointment.start_time:type_name -> google.protobuf.Timestamp
+	62,  // 2: appointment.Appointment.end_time:type_name -> google.protobuf.Timestamp
+	62,  // 3: appointment.Appointment.date:type_name -> google.protobuf.Timestamp
+	62,  // 4: appointment.Appointment.deleted_at:type_name -> google.protobuf.Timestamp
+	62,  // 5: appointment.Appointment.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 6: appointment.Appointment.updated_at:type_name -> google.protobuf.Timestamp
+	63,  // 7: appointment.Appointment.buffer_before:type_name -> google.protobuf.Duration
+	63,  // 8: appointment.Appointment.buffer_after:type_name -> google.protobuf.Duration
+	44,  // 9: appointment.Appointment.participants:type_name -> appointment.Participant
+	61,  // 10: appointment.Appointment.cancellation:type_name -> appointment.Cancellation
+	62,  // 11: appointment.GetUserAppointmentRequest.from:type_name -> google.protobuf.Timestamp
+	62,  // 12: appointment.GetUserAppointmentRequest.to:type_name -> google.protobuf.Timestamp
+	0,   // 13: appointment.GetUserAppointmentRequest.scope:type_name -> appointment.AppointmentScope
+	1,   // 14: appointment.GetUserAppointmentRequest.sort_direction:type_name -> appointment.SortDirection
+	10,  // 15: appointment.GetUserAppointmentResponse.appointments:type_name -> appointment.Appointment
+	27,  // 16: appointment.CreateAppointmentRequest.contact_information:type_name -> appointment.ContactInformation
+	62,  // 17: appointment.CreateAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
+	62,  // 18: appointment.CreateAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
+	62,  // 19: appointment.CreateAppointmentRequest.date:type_name -> google.protobuf.Timestamp
+	43,  // 20: appointment.CreateAppointmentRequest.invitees:type_name -> appointment.Invitee
+	10,  // 21: appointment.UpdateAppointmentRequest.appointment:type_name -> appointment.Appointment
+	64,  // 22: appointment.UpdateAppointmentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	8,   // 23: appointment.DeleteAppointmentRequest.reason:type_name -> appointment.CancellationReason
+	10,  // 24: appointment.DeleteAppointmentResponse.appointment:type_name -> appointment.Appointment
+	10,  // 25: appointment.ListDeletedAppointmentsResponse.appointments:type_name -> appointment.Appointment
+	23,  // 26: appointment.SearchAppointmentsResponse.results:type_name -> appointment.AppointmentSearchResult
+	10,  // 27: appointment.AppointmentSearchResult.appointment:type_name -> appointment.Appointment
+	26,  // 28: appointment.ImportCalendarResponse.results:type_name -> appointment.ImportEventResult
+	2,   // 29: appointment.ImportEventResult.status:type_name -> appointment.ImportEventStatus
+	29,  // 30: appointment.BookingPolicyViolations.violations:type_name -> appointment.BookingPolicyViolation
+	3,   // 31: appointment.BookingPolicyViolation.rule:type_name -> appointment.BookingPolicyRule
+	63,  // 32: appointment.AppointmentType.duration:type_name -> google.protobuf.Duration
+	63,  // 33: appointment.AppointmentType.buffer_before:type_name -> google.protobuf.Duration
+	63,  // 34: appointment.AppointmentType.buffer_after:type_name -> google.protobuf.Duration
+	62,  // 35: appointment.AppointmentType.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 36: appointment.AppointmentType.updated_at:type_name -> google.protobuf.Timestamp
+	30,  // 37: appointment.ListAppointmentTypesResponse.appointment_types:type_name -> appointment.AppointmentType
+	10,  // 38: appointment.GroupSession.appointment:type_name -> appointment.Appointment
+	62,  // 39: appointment.SessionAttendee.joined_at:type_name -> google.protobuf.Timestamp
+	62,  // 40: appointment.ListGroupSessionsRequest.from:type_name -> google.protobuf.Timestamp
+	62,  // 41: appointment.ListGroupSessionsRequest.to:type_name -> google.protobuf.Timestamp
+	34,  // 42: appointment.ListGroupSessionsResponse.sessions:type_name -> appointment.GroupSession
+	27,  // 43: appointment.JoinGroupSessionRequest.contact_information:type_name -> appointment.ContactInformation
+	34,  // 44: appointment.JoinGroupSessionResponse.session:type_name -> appointment.GroupSession
+	35,  // 45: appointment.JoinGroupSessionResponse.attendee:type_name -> appointment.SessionAttendee
+	34,  // 46: appointment.GetGroupSessionRosterResponse.session:type_name -> appointment.GroupSession
+	35,  // 47: appointment.GetGroupSessionRosterResponse.attendees:type_name -> appointment.SessionAttendee
+	27,  // 48: appointment.Invitee.contact_information:type_name -> appointment.ContactInformation
+	4,   // 49: appointment.Invitee.role:type_name -> appointment.ParticipantRole
+	4,   // 50: appointment.Participant.role:type_name -> appointment.ParticipantRole
+	5,   // 51: appointment.Participant.rsvp_status:type_name -> appointment.RsvpStatus
+	62,  // 52: appointment.Participant.responded_at:type_name -> google.protobuf.Timestamp
+	5,   // 53: appointment.UpdateRsvpStatusRequest.rsvp_status:type_name -> appointment.RsvpStatus
+	27,  // 54: appointment.WaitlistEntry.contact_information:type_name -> appointment.ContactInformation
+	62,  // 55: appointment.WaitlistEntry.window_start:type_name -> google.protobuf.Timestamp
+	62,  // 56: appointment.WaitlistEntry.window_end:type_name -> google.protobuf.Timestamp
+	6,   // 57: appointment.WaitlistEntry.status:type_name -> appointment.WaitlistStatus
+	47,  // 58: appointment.WaitlistEntry.offer:type_name -> appointment.WaitlistOffer
+	62,  // 59: appointment.WaitlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 60: appointment.WaitlistEntry.updated_at:type_name -> google.protobuf.Timestamp
+	62,  // 61: appointment.WaitlistOffer.start_time:type_name -> google.protobuf.Timestamp
+	62,  // 62: appointment.WaitlistOffer.end_time:type_name -> google.protobuf.Timestamp
+	7,   // 63: appointment.WaitlistOffer.status:type_name -> appointment.WaitlistOfferStatus
+	62,  // 64: appointment.WaitlistOffer.expires_at:type_name -> google.protobuf.Timestamp
+	62,  // 65: appointment.WaitlistOffer.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 66: appointment.WaitlistOffer.responded_at:type_name -> google.protobuf.Timestamp
+	27,  // 67: appointment.JoinWaitlistRequest.contact_information:type_name -> appointment.ContactInformation
+	62,  // 68: appointment.JoinWaitlistRequest.window_start:type_name -> google.protobuf.Timestamp
+	62,  // 69: appointment.JoinWaitlistRequest.window_end:type_name -> google.protobuf.Timestamp
+	46,  // 70: appointment.AcceptWaitlistOfferResponse.entry:type_name -> appointment.WaitlistEntry
+	10,  // 71: appointment.AcceptWaitlistOfferResponse.appointment:type_name -> appointment.Appointment
+	27,  // 72: appointment.SlotHold.contact_information:type_name -> appointment.ContactInformation
+	62,  // 73: appointment.SlotHold.start_time:type_name -> google.protobuf.Timestamp
+	62,  // 74: appointment.SlotHold.end_time:type_name -> google.protobuf.Timestamp
+	62,  // 75: appointment.SlotHold.expires_at:type_name -> google.protobuf.Timestamp
+	62,  // 76: appointment.SlotHold.created_at:type_name -> google.protobuf.Timestamp
+	27,  // 77: appointment.HoldSlotRequest.contact_information:type_name -> appointment.ContactInformation
+	62,  // 78: appointment.HoldSlotRequest.start_time:type_name -> google.protobuf.Timestamp
+	62,  // 79: appointment.HoldSlotRequest.end_time:type_name -> google.protobuf.Timestamp
+	43,  // 80: appointment.ConfirmHoldRequest.invitees:type_name -> appointment.Invitee
+	62,  // 81: appointment.RescheduleAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
+	62,  // 82: appointment.RescheduleAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
+	62,  // 83: appointment.Reschedule.previous_start_time:type_name -> google.protobuf.Timestamp
+	62,  // 84: appointment.Reschedule.previous_end_time:type_name -> google.protobuf.Timestamp
+	62,  // 85: appointment.Reschedule.start_time:type_name -> google.protobuf.Timestamp
+	62,  // 86: appointment.Reschedule.end_time:type_name -> google.protobuf.Timestamp
+	62,  // 87: appointment.Reschedule.created_at:type_name -> google.protobuf.Timestamp
+	58,  // 88: appointment.ListReschedulesResponse.reschedules:type_name -> appointment.Reschedule
+	8,   // 89: appointment.Cancellation.reason:type_name -> appointment.CancellationReason
+	9,   // 90: appointment.Cancellation.cancelled_by:type_name -> appointment.CancelledBy
+	11,  // 91: appointment.AppointmentService.GetAppointment:input_type -> appointment.GetAppointmentRequest
+	12,  // 92: appointment.AppointmentService.GetUserAppointments:input_type -> appointment.GetUserAppointmentRequest
+	14,  // 93: appointment.AppointmentService.CreateAppointment:input_type -> appointment.CreateAppointmentRequest
+	15,  // 94: appointment.AppointmentService.UpdateAppointment:input_type -> appointment.UpdateAppointmentRequest
+	16,  // 95: appointment.AppointmentService.DeleteAppointment:input_type -> appointment.DeleteAppointmentRequest
+	21,  // 96: appointment.AppointmentService.SearchAppointments:input_type -> appointment.SearchAppointmentsRequest
+	24,  // 97: appointment.AppointmentService.ImportCalendar:input_type -> appointment.ImportCalendarRequest
+	18,  // 98: appointment.AppointmentService.ListDeletedAppointments:input_type -> appointment.ListDeletedAppointmentsRequest
+	20,  // 99: appointment.AppointmentService.RestoreAppointment:input_type -> appointment.RestoreAppointmentRequest
+	31,  // 100: appointment.AppointmentService.ListAppointmentTypes:input_type -> appointment.ListAppointmentTypesRequest
+	33,  // 101: appointment.AppointmentService.GetAppointmentType:input_type -> appointment.GetAppointmentTypeRequest
+	36,  // 102: appointment.AppointmentService.ListGroupSessions:input_type -> appointment.ListGroupSessionsRequest
+	38,  // 103: appointment.AppointmentService.JoinGroupSession:input_type -> appointment.JoinGroupSessionRequest
+	40,  // 104: appointment.AppointmentService.LeaveGroupSession:input_type -> appointment.LeaveGroupSessionRequest
+	41,  // 105: appointment.AppointmentService.GetGroupSessionRoster:input_type -> appointment.GetGroupSessionRosterRequest
+	45,  // 106: appointment.AppointmentService.UpdateRsvpStatus:input_type -> appointment.UpdateRsvpStatusRequest
+	48,  // 107: appointment.AppointmentService.JoinWaitlist:input_type -> appointment.JoinWaitlistRequest
+	49,  // 108: appointment.AppointmentService.GetWaitlistEntry:input_type -> appointment.GetWaitlistEntryRequest
+	50,  // 109: appointment.AppointmentService.LeaveWaitlist:input_type -> appointment.LeaveWaitlistRequest
+	51,  // 110: appointment.AppointmentService.AcceptWaitlistOffer:input_type -> appointment.AcceptWaitlistOfferRequest
+	53,  // 111: appointment.AppointmentService.DeclineWaitlistOffer:input_type -> appointment.DeclineWaitlistOfferRequest
+	55,  // 112: appointment.AppointmentService.HoldSlot:input_type -> appointment.HoldSlotRequest
+	56,  // 113: appointment.AppointmentService.ConfirmHold:input_type -> appointment.ConfirmHoldRequest
+	57,  // 114: appointment.AppointmentService.RescheduleAppointment:input_type -> appointment.RescheduleAppointmentRequest
+	59,  // 115: appointment.AppointmentService.ListReschedules:input_type -> appointment.ListReschedulesRequest
+	10,  // 116: appointment.AppointmentService.GetAppointment:output_type -> appointment.Appointment
+	13,  // 117: appointment.AppointmentService.GetUserAppointments:output_type -> appointment.GetUserAppointmentResponse
+	10,  // 118: appointment.AppointmentService.CreateAppointment:output_type -> appointment.Appointment
+	10,  // 119: appointment.AppointmentService.UpdateAppointment:output_type -> appointment.Appointment
+	17,  // 120: appointment.AppointmentService.DeleteAppointment:output_type -> appointment.DeleteAppointmentResponse
+	22,  // 121: appointment.AppointmentService.SearchAppointments:output_type -> appointment.SearchAppointmentsResponse
+	25,  // 122: appointment.AppointmentService.ImportCalendar:output_type -> appointment.ImportCalendarResponse
+	19,  // 123: appointment.AppointmentService.ListDeletedAppointments:output_type -> appointment.ListDeletedAppointmentsResponse
+	10,  // 124: appointment.AppointmentService.RestoreAppointment:output_type -> appointment.Appointment
+	32,  // 125: appointment.AppointmentService.ListAppointmentTypes:output_type -> appointment.ListAppointmentTypesResponse
+	30,  // 126: appointment.AppointmentService.GetAppointmentType:output_type -> appointment.AppointmentType
+	37,  // 127: appointment.AppointmentService.ListGroupSessions:output_type -> appointment.ListGroupSessionsResponse
+	39,  // 128: appointment.AppointmentService.JoinGroupSession:output_type -> appointment.JoinGroupSessionResponse
+	34,  // 129: appointment.AppointmentService.LeaveGroupSession:output_type -> appointment.GroupSession
+	42,  // 130: appointment.AppointmentService.GetGroupSessionRoster:output_type -> appointment.GetGroupSessionRosterResponse
+	44,  // 131: appointment.AppointmentService.UpdateRsvpStatus:output_type -> appointment.Participant
+	46,  // 132: appointment.AppointmentService.JoinWaitlist:output_type -> appointment.WaitlistEntry
+	46,  // 133: appointment.AppointmentService.GetWaitlistEntry:output_type -> appointment.WaitlistEntry
+	46,  // 134: appointment.AppointmentService.LeaveWaitlist:output_type -> appointment.WaitlistEntry
+	52,  // 135: appointment.AppointmentService.AcceptWaitlistOffer:output_type -> appointment.AcceptWaitlistOfferResponse
+	46,  // 136: appointment.AppointmentService.DeclineWaitlistOffer:output_type -> appointment.WaitlistEntry
+	54,  // 137: appointment.AppointmentService.HoldSlot:output_type -> appointment.SlotHold
+	10,  // 138: appointment.AppointmentService.ConfirmHold:output_type -> appointment.Appointment
+	10,  // 139: appointment.AppointmentService.RescheduleAppointment:output_type -> appointment.Appointment
+	60,  // 140: appointment.AppointmentService.ListReschedules:output_type -> appointment.ListReschedulesResponse
+	116, // [116:141] is the sub-list for method output_type
+	91,  // [91:116] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_appointment_proto_init() }
@@ -663,13 +4692,14 @@ func file_appointment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_appointment_proto_rawDesc), len(file_appointment_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      10,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_appointment_proto_goTypes,
 		DependencyIndexes: file_appointment_proto_depIdxs,
+		EnumInfos:         file_appointment_proto_enumTypes,
 		MessageInfos:      file_appointment_proto_msgTypes,
 	}.Build()
 	File_appointment_proto = out.File
@@ -48,6 +48,66 @@ const (
 	// AppointmentServiceDeleteAppointmentProcedure is the fully-qualified name of the
 	// AppointmentService's DeleteAppointment RPC.
 	AppointmentServiceDeleteAppointmentProcedure = "/appointment.AppointmentService/DeleteAppointment"
+	// AppointmentServiceSearchAppointmentsProcedure is the fully-qualified name of the
+	// AppointmentService's SearchAppointments RPC.
+	AppointmentServiceSearchAppointmentsProcedure = "/appointment.AppointmentService/SearchAppointments"
+	// AppointmentServiceImportCalendarProcedure is the fully-qualified name of the AppointmentService's
+	// ImportCalendar RPC.
+	AppointmentServiceImportCalendarProcedure = "/appointment.AppointmentService/ImportCalendar"
+	// AppointmentServiceListDeletedAppointmentsProcedure is the fully-qualified name of the
+	// AppointmentService's ListDeletedAppointments RPC.
+	AppointmentServiceListDeletedAppointmentsProcedure = "/appointment.AppointmentService/ListDeletedAppointments"
+	// AppointmentServiceRestoreAppointmentProcedure is the fully-qualified name of the
+	// AppointmentService's RestoreAppointment RPC.
+	AppointmentServiceRestoreAppointmentProcedure = "/appointment.AppointmentService/RestoreAppointment"
+	// AppointmentServiceListAppointmentTypesProcedure is the fully-qualified name of the
+	// AppointmentService's ListAppointmentTypes RPC.
+	AppointmentServiceListAppointmentTypesProcedure = "/appointment.AppointmentService/ListAppointmentTypes"
+	// AppointmentServiceGetAppointmentTypeProcedure is the fully-qualified name of the
+	// AppointmentService's GetAppointmentType RPC.
+	AppointmentServiceGetAppointmentTypeProcedure = "/appointment.AppointmentService/GetAppointmentType"
+	// AppointmentServiceListGroupSessionsProcedure is the fully-qualified name of the
+	// AppointmentService's ListGroupSessions RPC.
+	AppointmentServiceListGroupSessionsProcedure = "/appointment.AppointmentService/ListGroupSessions"
+	// AppointmentServiceJoinGroupSessionProcedure is the fully-qualified name of the
+	// AppointmentService's JoinGroupSession RPC.
+	AppointmentServiceJoinGroupSessionProcedure = "/appointment.AppointmentService/JoinGroupSession"
+	// AppointmentServiceLeaveGroupSessionProcedure is the fully-qualified name of the
+	// AppointmentService's LeaveGroupSession RPC.
+	AppointmentServiceLeaveGroupSessionProcedure = "/appointment.AppointmentService/LeaveGroupSession"
+	// AppointmentServiceGetGroupSessionRosterProcedure is the fully-qualified name of the
+	// AppointmentService's GetGroupSessionRoster RPC.
+	AppointmentServiceGetGroupSessionRosterProcedure = "/appointment.AppointmentService/GetGroupSessionRoster"
+	// AppointmentServiceUpdateRsvpStatusProcedure is the fully-qualified name of the
+	// AppointmentService's UpdateRsvpStatus RPC.
+	AppointmentServiceUpdateRsvpStatusProcedure = "/appointment.AppointmentService/UpdateRsvpStatus"
+	// AppointmentServiceJoinWaitlistProcedure is the fully-qualified name of the AppointmentService's
+	// JoinWaitlist RPC.
+	AppointmentServiceJoinWaitlistProcedure = "/appointment.AppointmentService/JoinWaitlist"
+	// AppointmentServiceGetWaitlistEntryProcedure is the fully-qualified name of the
+	// AppointmentService's GetWaitlistEntry RPC.
+	AppointmentServiceGetWaitlistEntryProcedure = "/appointment.AppointmentService/GetWaitlistEntry"
+	// AppointmentServiceLeaveWaitlistProcedure is the fully-qualified name of the AppointmentService's
+	// LeaveWaitlist RPC.
+	AppointmentServiceLeaveWaitlistProcedure = "/appointment.AppointmentService/LeaveWaitlist"
+	// AppointmentServiceAcceptWaitlistOfferProcedure is the fully-qualified name of the
+	// AppointmentService's AcceptWaitlistOffer RPC.
+	AppointmentServiceAcceptWaitlistOfferProcedure = "/appointment.AppointmentService/AcceptWaitlistOffer"
+	// AppointmentServiceDeclineWaitlistOfferProcedure is the fully-qualified name of the
+	// AppointmentService's DeclineWaitlistOffer RPC.
+	AppointmentServiceDeclineWaitlistOfferProcedure = "/appointment.AppointmentService/DeclineWaitlistOffer"
+	// AppointmentServiceHoldSlotProcedure is the fully-qualified name of the AppointmentService's
+	// HoldSlot RPC.
+	AppointmentServiceHoldSlotProcedure = "/appointment.AppointmentService/HoldSlot"
+	// AppointmentServiceConfirmHoldProcedure is the fully-qualified name of the AppointmentService's
+	// ConfirmHold RPC.
+	AppointmentServiceConfirmHoldProcedure = "/appointment.AppointmentService/ConfirmHold"
+	// AppointmentServiceRescheduleAppointmentProcedure is the fully-qualified name of the
+	// AppointmentService's RescheduleAppointment RPC.
+	AppointmentServiceRescheduleAppointmentProcedure = "/appointment.AppointmentService/RescheduleAppointment"
+	// AppointmentServiceListReschedulesProcedure is the fully-qualified name of the
+	// AppointmentService's ListReschedules RPC.
+	AppointmentServiceListReschedulesProcedure = "/appointment.AppointmentService/ListReschedules"
 )
 
 // AppointmentServiceClient is a client for the appointment.AppointmentService service.
@@ -57,6 +117,26 @@ type AppointmentServiceClient interface {
 	CreateAppointment(context.Context, *connect.Request[proto.CreateAppointmentRequest]) (*connect.Response[proto.Appointment], error)
 	UpdateAppointment(context.Context, *connect.Request[proto.UpdateAppointmentRequest]) (*connect.Response[proto.Appointment], error)
 	DeleteAppointment(context.Context, *connect.Request[proto.DeleteAppointmentRequest]) (*connect.Response[proto.DeleteAppointmentResponse], error)
+	SearchAppointments(context.Context, *connect.Request[proto.SearchAppointmentsRequest]) (*connect.Response[proto.SearchAppointmentsResponse], error)
+	ImportCalendar(context.Context) *connect.ClientStreamForClient[proto.ImportCalendarRequest, proto.ImportCalendarResponse]
+	ListDeletedAppointments(context.Context, *connect.Request[proto.ListDeletedAppointmentsRequest]) (*connect.Response[proto.ListDeletedAppointmentsResponse], error)
+	RestoreAppointment(context.Context, *connect.Request[proto.RestoreAppointmentRequest]) (*connect.Response[proto.Appointment], error)
+	ListAppointmentTypes(context.Context, *connect.Request[proto.ListAppointmentTypesRequest]) (*connect.Response[proto.ListAppointmentTypesResponse], error)
+	GetAppointmentType(context.Context, *connect.Request[proto.GetAppointmentTypeRequest]) (*connect.Response[proto.AppointmentType], error)
+	ListGroupSessions(context.Context, *connect.Request[proto.ListGroupSessionsRequest]) (*connect.Response[proto.ListGroupSessionsResponse], error)
+	JoinGroupSession(context.Context, *connect.Request[proto.JoinGroupSessionRequest]) (*connect.Response[proto.JoinGroupSessionResponse], error)
+	LeaveGroupSession(context.Context, *connect.Request[proto.LeaveGroupSessionRequest]) (*connect.Response[proto.GroupSession], error)
+	GetGroupSessionRoster(context.Context, *connect.Request[proto.GetGroupSessionRosterRequest]) (*connect.Response[proto.GetGroupSessionRosterResponse], error)
+	UpdateRsvpStatus(context.Context, *connect.Request[proto.UpdateRsvpStatusRequest]) (*connect.Response[proto.Participant], error)
+	JoinWaitlist(context.Context, *connect.Request[proto.JoinWaitlistRequest]) (*connect.Response[proto.WaitlistEntry], error)
+	GetWaitlistEntry(context.Context, *connect.Request[proto.GetWaitlistEntryRequest]) (*connect.Response[proto.WaitlistEntry], error)
+	LeaveWaitlist(context.Context, *connect.Request[proto.LeaveWaitlistRequest]) (*connect.Response[proto.WaitlistEntry], error)
+	AcceptWaitlistOffer(context.Context, *connect.Request[proto.AcceptWaitlistOfferRequest]) (*connect.Response[proto.AcceptWaitlistOfferResponse], error)
+	DeclineWaitlistOffer(context.Context, *connect.Request[proto.DeclineWaitlistOfferRequest]) (*connect.Response[proto.WaitlistEntry], error)
+	HoldSlot(context.Context, *connect.Request[proto.HoldSlotRequest]) (*connect.Response[proto.SlotHold], error)
+	ConfirmHold(context.Context, *connect.Request[proto.ConfirmHoldRequest]) (*connect.Response[proto.Appointment], error)
+	RescheduleAppointment(context.Context, *connect.Request[proto.RescheduleAppointmentRequest]) (*connect.Response[proto.Appointment], error)
+	ListReschedules(context.Context, *connect.Request[proto.ListReschedulesRequest]) (*connect.Response[proto.ListReschedulesResponse], error)
 }
 
 // NewAppointmentServiceClient constructs a client for the appointment.AppointmentService service.
@@ -100,16 +180,156 @@ func NewAppointmentServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(appointmentServiceMethods.ByName("DeleteAppointment")),
 			connect.WithClientOptions(opts...),
 		),
+		searchAppointments: connect.NewClient[proto.SearchAppointmentsRequest, proto.SearchAppointmentsResponse](
+			httpClient,
+			baseURL+AppointmentServiceSearchAppointmentsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("SearchAppointments")),
+			connect.WithClientOptions(opts...),
+		),
+		importCalendar: connect.NewClient[proto.ImportCalendarRequest, proto.ImportCalendarResponse](
+			httpClient,
+			baseURL+AppointmentServiceImportCalendarProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ImportCalendar")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedAppointments: connect.NewClient[proto.ListDeletedAppointmentsRequest, proto.ListDeletedAppointmentsResponse](
+			httpClient,
+			baseURL+AppointmentServiceListDeletedAppointmentsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ListDeletedAppointments")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreAppointment: connect.NewClient[proto.RestoreAppointmentRequest, proto.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceRestoreAppointmentProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("RestoreAppointment")),
+			connect.WithClientOptions(opts...),
+		),
+		listAppointmentTypes: connect.NewClient[proto.ListAppointmentTypesRequest, proto.ListAppointmentTypesResponse](
+			httpClient,
+			baseURL+AppointmentServiceListAppointmentTypesProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ListAppointmentTypes")),
+			connect.WithClientOptions(opts...),
+		),
+		getAppointmentType: connect.NewClient[proto.GetAppointmentTypeRequest, proto.AppointmentType](
+			httpClient,
+			baseURL+AppointmentServiceGetAppointmentTypeProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("GetAppointmentType")),
+			connect.WithClientOptions(opts...),
+		),
+		listGroupSessions: connect.NewClient[proto.ListGroupSessionsRequest, proto.ListGroupSessionsResponse](
+			httpClient,
+			baseURL+AppointmentServiceListGroupSessionsProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ListGroupSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		joinGroupSession: connect.NewClient[proto.JoinGroupSessionRequest, proto.JoinGroupSessionResponse](
+			httpClient,
+			baseURL+AppointmentServiceJoinGroupSessionProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("JoinGroupSession")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveGroupSession: connect.NewClient[proto.LeaveGroupSessionRequest, proto.GroupSession](
+			httpClient,
+			baseURL+AppointmentServiceLeaveGroupSessionProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("LeaveGroupSession")),
+			connect.WithClientOptions(opts...),
+		),
+		getGroupSessionRoster: connect.NewClient[proto.GetGroupSessionRosterRequest, proto.GetGroupSessionRosterResponse](
+			httpClient,
+			baseURL+AppointmentServiceGetGroupSessionRosterProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("GetGroupSessionRoster")),
+			connect.WithClientOptions(opts...),
+		),
+		updateRsvpStatus: connect.NewClient[proto.UpdateRsvpStatusRequest, proto.Participant](
+			httpClient,
+			baseURL+AppointmentServiceUpdateRsvpStatusProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("UpdateRsvpStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		joinWaitlist: connect.NewClient[proto.JoinWaitlistRequest, proto.WaitlistEntry](
+			httpClient,
+			baseURL+AppointmentServiceJoinWaitlistProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("JoinWaitlist")),
+			connect.WithClientOptions(opts...),
+		),
+		getWaitlistEntry: connect.NewClient[proto.GetWaitlistEntryRequest, proto.WaitlistEntry](
+			httpClient,
+			baseURL+AppointmentServiceGetWaitlistEntryProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("GetWaitlistEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveWaitlist: connect.NewClient[proto.LeaveWaitlistRequest, proto.WaitlistEntry](
+			httpClient,
+			baseURL+AppointmentServiceLeaveWaitlistProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("LeaveWaitlist")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptWaitlistOffer: connect.NewClient[proto.AcceptWaitlistOfferRequest, proto.AcceptWaitlistOfferResponse](
+			httpClient,
+			baseURL+AppointmentServiceAcceptWaitlistOfferProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("AcceptWaitlistOffer")),
+			connect.WithClientOptions(opts...),
+		),
+		declineWaitlistOffer: connect.NewClient[proto.DeclineWaitlistOfferRequest, proto.WaitlistEntry](
+			httpClient,
+			baseURL+AppointmentServiceDeclineWaitlistOfferProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("DeclineWaitlistOffer")),
+			connect.WithClientOptions(opts...),
+		),
+		holdSlot: connect.NewClient[proto.HoldSlotRequest, proto.SlotHold](
+			httpClient,
+			baseURL+AppointmentServiceHoldSlotProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("HoldSlot")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmHold: connect.NewClient[proto.ConfirmHoldRequest, proto.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceConfirmHoldProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ConfirmHold")),
+			connect.WithClientOptions(opts...),
+		),
+		rescheduleAppointment: connect.NewClient[proto.RescheduleAppointmentRequest, proto.Appointment](
+			httpClient,
+			baseURL+AppointmentServiceRescheduleAppointmentProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("RescheduleAppointment")),
+			connect.WithClientOptions(opts...),
+		),
+		listReschedules: connect.NewClient[proto.ListReschedulesRequest, proto.ListReschedulesResponse](
+			httpClient,
+			baseURL+AppointmentServiceListReschedulesProcedure,
+			connect.WithSchema(appointmentServiceMethods.ByName("ListReschedules")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // appointmentServiceClient implements AppointmentServiceClient.
 type appointmentServiceClient struct {
-	getAppointment      *connect.Client[proto.GetAppointmentRequest, proto.Appointment]
-	getUserAppointments *connect.Client[proto.GetUserAppointmentRequest, proto.GetUserAppointmentResponse]
-	createAppointment   *connect.Client[proto.CreateAppointmentRequest, proto.Appointment]
-	updateAppointment   *connect.Client[proto.UpdateAppointmentRequest, proto.Appointment]
-	deleteAppointment   *connect.Client[proto.DeleteAppointmentRequest, proto.DeleteAppointmentResponse]
+	getAppointment          *connect.Client[proto.GetAppointmentRequest, proto.Appointment]
+	getUserAppointments     *connect.Client[proto.GetUserAppointmentRequest, proto.GetUserAppointmentResponse]
+	createAppointment       *connect.Client[proto.CreateAppointmentRequest, proto.Appointment]
+	updateAppointment       *connect.Client[proto.UpdateAppointmentRequest, proto.Appointment]
+	deleteAppointment       *connect.Client[proto.DeleteAppointmentRequest, proto.DeleteAppointmentResponse]
+	searchAppointments      *connect.Client[proto.SearchAppointmentsRequest, proto.SearchAppointmentsResponse]
+	importCalendar          *connect.Client[proto.ImportCalendarRequest, proto.ImportCalendarResponse]
+	listDeletedAppointments *connect.Client[proto.ListDeletedAppointmentsRequest, proto.ListDeletedAppointmentsResponse]
+	restoreAppointment      *connect.Client[proto.RestoreAppointmentRequest, proto.Appointment]
+	listAppointmentTypes    *connect.Client[proto.ListAppointmentTypesRequest, proto.ListAppointmentTypesResponse]
+	getAppointmentType      *connect.Client[proto.GetAppointmentTypeRequest, proto.AppointmentType]
+	listGroupSessions       *connect.Client[proto.ListGroupSessionsRequest, proto.ListGroupSessionsResponse]
+	joinGroupSession        *connect.Client[proto.JoinGroupSessionRequest, proto.JoinGroupSessionResponse]
+	leaveGroupSession       *connect.Client[proto.LeaveGroupSessionRequest, proto.GroupSession]
+	getGroupSessionRoster   *connect.Client[proto.GetGroupSessionRosterRequest, proto.GetGroupSessionRosterResponse]
+	updateRsvpStatus        *connect.Client[proto.UpdateRsvpStatusRequest, proto.Participant]
+	joinWaitlist            *connect.Client[proto.JoinWaitlistRequest, proto.WaitlistEntry]
+	getWaitlistEntry        *connect.Client[proto.GetWaitlistEntryRequest, proto.WaitlistEntry]
+	leaveWaitlist           *connect.Client[proto.LeaveWaitlistRequest, proto.WaitlistEntry]
+	acceptWaitlistOffer     *connect.Client[proto.AcceptWaitlistOfferRequest, proto.AcceptWaitlistOfferResponse]
+	declineWaitlistOffer    *connect.Client[proto.DeclineWaitlistOfferRequest, proto.WaitlistEntry]
+	holdSlot                *connect.Client[proto.HoldSlotRequest, proto.SlotHold]
+	confirmHold             *connect.Client[proto.ConfirmHoldRequest, proto.Appointment]
+	rescheduleAppointment   *connect.Client[proto.RescheduleAppointmentRequest, proto.Appointment]
+	listReschedules         *connect.Client[proto.ListReschedulesRequest, proto.ListReschedulesResponse]
 }
 
 // GetAppointment calls appointment.AppointmentService.GetAppointment.
@@ -137,6 +357,106 @@ func (c *appointmentServiceClient) DeleteAppointment(ctx context.Context, req *c
 	return c.deleteAppointment.CallUnary(ctx, req)
 }
 
+// SearchAppointments calls appointment.AppointmentService.SearchAppointments.
+func (c *appointmentServiceClient) SearchAppointments(ctx context.Context, req *connect.Request[proto.SearchAppointmentsRequest]) (*connect.Response[proto.SearchAppointmentsResponse], error) {
+	return c.searchAppointments.CallUnary(ctx, req)
+}
+
+// ImportCalendar calls appointment.AppointmentService.ImportCalendar.
+func (c *appointmentServiceClient) ImportCalendar(ctx context.Context) *connect.ClientStreamForClient[proto.ImportCalendarRequest, proto.ImportCalendarResponse] {
+	return c.importCalendar.CallClientStream(ctx)
+}
+
+// ListDeletedAppointments calls appointment.AppointmentService.ListDeletedAppointments.
+func (c *appointmentServiceClient) ListDeletedAppointments(ctx context.Context, req *connect.Request[proto.ListDeletedAppointmentsRequest]) (*connect.Response[proto.ListDeletedAppointmentsResponse], error) {
+	return c.listDeletedAppointments.CallUnary(ctx, req)
+}
+
+// RestoreAppointment calls appointment.AppointmentService.RestoreAppointment.
+func (c *appointmentServiceClient) RestoreAppointment(ctx context.Context, req *connect.Request[proto.RestoreAppointmentRequest]) (*connect.Response[proto.Appointment], error) {
+	return c.restoreAppointment.CallUnary(ctx, req)
+}
+
+// ListAppointmentTypes calls appointment.AppointmentService.ListAppointmentTypes.
+func (c *appointmentServiceClient) ListAppointmentTypes(ctx context.Context, req *connect.Request[proto.ListAppointmentTypesRequest]) (*connect.Response[proto.ListAppointmentTypesResponse], error) {
+	return c.listAppointmentTypes.CallUnary(ctx, req)
+}
+
+// GetAppointmentType calls appointment.AppointmentService.GetAppointmentType.
+func (c *appointmentServiceClient) GetAppointmentType(ctx context.Context, req *connect.Request[proto.GetAppointmentTypeRequest]) (*connect.Response[proto.AppointmentType], error) {
+	return c.getAppointmentType.CallUnary(ctx, req)
+}
+
+// ListGroupSessions calls appointment.AppointmentService.ListGroupSessions.
+func (c *appointmentServiceClient) ListGroupSessions(ctx context.Context, req *connect.Request[proto.ListGroupSessionsRequest]) (*connect.Response[proto.ListGroupSessionsResponse], error) {
+	return c.listGroupSessions.CallUnary(ctx, req)
+}
+
+// JoinGroupSession calls appointment.AppointmentService.JoinGroupSession.
+func (c *appointmentServiceClient) JoinGroupSession(ctx context.Context, req *connect.Request[proto.JoinGroupSessionRequest]) (*connect.Response[proto.JoinGroupSessionResponse], error) {
+	return c.joinGroupSession.CallUnary(ctx, req)
+}
+
+// LeaveGroupSession calls appointment.AppointmentService.LeaveGroupSession.
+func (c *appointmentServiceClient) LeaveGroupSession(ctx context.Context, req *connect.Request[proto.LeaveGroupSessionRequest]) (*connect.Response[proto.GroupSession], error) {
+	return c.leaveGroupSession.CallUnary(ctx, req)
+}
+
+// GetGroupSessionRoster calls appointment.AppointmentService.GetGroupSessionRoster.
+func (c *appointmentServiceClient) GetGroupSessionRoster(ctx context.Context, req *connect.Request[proto.GetGroupSessionRosterRequest]) (*connect.Response[proto.GetGroupSessionRosterResponse], error) {
+	return c.getGroupSessionRoster.CallUnary(ctx, req)
+}
+
+// UpdateRsvpStatus calls appointment.AppointmentService.UpdateRsvpStatus.
+func (c *appointmentServiceClient) UpdateRsvpStatus(ctx context.Context, req *connect.Request[proto.UpdateRsvpStatusRequest]) (*connect.Response[proto.Participant], error) {
+	return c.updateRsvpStatus.CallUnary(ctx, req)
+}
+
+// JoinWaitlist calls appointment.AppointmentService.JoinWaitlist.
+func (c *appointmentServiceClient) JoinWaitlist(ctx context.Context, req *connect.Request[proto.JoinWaitlistRequest]) (*connect.Response[proto.WaitlistEntry], error) {
+	return c.joinWaitlist.CallUnary(ctx, req)
+}
+
+// GetWaitlistEntry calls appointment.AppointmentService.GetWaitlistEntry.
+func (c *appointmentServiceClient) GetWaitlistEntry(ctx context.Context, req *connect.Request[proto.GetWaitlistEntryRequest]) (*connect.Response[proto.WaitlistEntry], error) {
+	return c.getWaitlistEntry.CallUnary(ctx, req)
+}
+
+// LeaveWaitlist calls appointment.AppointmentService.LeaveWaitlist.
+func (c *appointmentServiceClient) LeaveWaitlist(ctx context.Context, req *connect.Request[proto.LeaveWaitlistRequest]) (*connect.Response[proto.WaitlistEntry], error) {
+	return c.leaveWaitlist.CallUnary(ctx, req)
+}
+
+// AcceptWaitlistOffer calls appointment.AppointmentService.AcceptWaitlistOffer.
+func (c *appointmentServiceClient) AcceptWaitlistOffer(ctx context.Context, req *connect.Request[proto.AcceptWaitlistOfferRequest]) (*connect.Response[proto.AcceptWaitlistOfferResponse], error) {
+	return c.acceptWaitlistOffer.CallUnary(ctx, req)
+}
+
+// DeclineWaitlistOffer calls appointment.AppointmentService.DeclineWaitlistOffer.
+func (c *appointmentServiceClient) DeclineWaitlistOffer(ctx context.Context, req *connect.Request[proto.DeclineWaitlistOfferRequest]) (*connect.Response[proto.WaitlistEntry], error) {
+	return c.declineWaitlistOffer.CallUnary(ctx, req)
+}
+
+// HoldSlot calls appointment.AppointmentService.HoldSlot.
+func (c *appointmentServiceClient) HoldSlot(ctx context.Context, req *connect.Request[proto.HoldSlotRequest]) (*connect.Response[proto.SlotHold], error) {
+	return c.holdSlot.CallUnary(ctx, req)
+}
+
+// ConfirmHold calls appointment.AppointmentService.ConfirmHold.
+func (c *appointmentServiceClient) ConfirmHold(ctx context.Context, req *connect.Request[proto.ConfirmHoldRequest]) (*connect.Response[proto.Appointment], error) {
+	return c.confirmHold.CallUnary(ctx, req)
+}
+
+// RescheduleAppointment calls appointment.AppointmentService.RescheduleAppointment.
+func (c *appointmentServiceClient) RescheduleAppointment(ctx context.Context, req *connect.Request[proto.RescheduleAppointmentRequest]) (*connect.Response[proto.Appointment], error) {
+	return c.rescheduleAppointment.CallUnary(ctx, req)
+}
+
+// ListReschedules calls appointment.AppointmentService.ListReschedules.
+func (c *appointmentServiceClient) ListReschedules(ctx context.Context, req *connect.Request[proto.ListReschedulesRequest]) (*connect.Response[proto.ListReschedulesResponse], error) {
+	return c.listReschedules.CallUnary(ctx, req)
+}
+
 // AppointmentServiceHandler is an implementation of the appointment.AppointmentService service.
 type AppointmentServiceHandler interface {
 	GetAppointment(context.Context, *connect.Request[proto.GetAppointmentRequest]) (*connect.Response[proto.Appointment], error)
@@ -144,6 +464,26 @@ type AppointmentServiceHandler interface {
 	CreateAppointment(context.Context, *connect.Request[proto.CreateAppointmentRequest]) (*connect.Response[proto.Appointment], error)
 	UpdateAppointment(context.Context, *connect.Request[proto.UpdateAppointmentRequest]) (*connect.Response[proto.Appointment], error)
 	DeleteAppointment(context.Context, *connect.Request[proto.DeleteAppointmentRequest]) (*connect.Response[proto.DeleteAppointmentResponse], error)
+	SearchAppointments(context.Context, *connect.Request[proto.SearchAppointmentsRequest]) (*connect.Response[proto.SearchAppointmentsResponse], error)
+	ImportCalendar(context.Context, *connect.ClientStream[proto.ImportCalendarRequest]) (*connect.Response[proto.ImportCalendarResponse], error)
+	ListDeletedAppointments(context.Context, *connect.Request[proto.ListDeletedAppointmentsRequest]) (*connect.Response[proto.ListDeletedAppointmentsResponse], error)
+	RestoreAppointment(context.Context, *connect.Request[proto.RestoreAppointmentRequest]) (*connect.Response[proto.Appointment], error)
+	ListAppointmentTypes(context.Context, *connect.Request[proto.ListAppointmentTypesRequest]) (*connect.Response[proto.ListAppointmentTypesResponse], error)
+	GetAppointmentType(context.Context, *connect.Request[proto.GetAppointmentTypeRequest]) (*connect.Response[proto.AppointmentType], error)
+	ListGroupSessions(context.Context, *connect.Request[proto.ListGroupSessionsRequest]) (*connect.Response[proto.ListGroupSessionsResponse], error)
+	JoinGroupSession(context.Context, *connect.Request[proto.JoinGroupSessionRequest]) (*connect.Response[proto.JoinGroupSessionResponse], error)
+	LeaveGroupSession(context.Context, *connect.Request[proto.LeaveGroupSessionRequest]) (*connect.Response[proto.GroupSession], error)
+	GetGroupSessionRoster(context.Context, *connect.Request[proto.GetGroupSessionRosterRequest]) (*connect.Response[proto.GetGroupSessionRosterResponse], error)
+	UpdateRsvpStatus(context.Context, *connect.Request[proto.UpdateRsvpStatusRequest]) (*connect.Response[proto.Participant], error)
+	JoinWaitlist(context.Context, *connect.Request[proto.JoinWaitlistRequest]) (*connect.Response[proto.WaitlistEntry], error)
+	GetWaitlistEntry(context.Context, *connect.Request[proto.GetWaitlistEntryRequest]) (*connect.Response[proto.WaitlistEntry], error)
+	LeaveWaitlist(context.Context, *connect.Request[proto.LeaveWaitlistRequest]) (*connect.Response[proto.WaitlistEntry], error)
+	AcceptWaitlistOffer(context.Context, *connect.Request[proto.AcceptWaitlistOfferRequest]) (*connect.Response[proto.AcceptWaitlistOfferResponse], error)
+	DeclineWaitlistOffer(context.Context, *connect.Request[proto.DeclineWaitlistOfferRequest]) (*connect.Response[proto.WaitlistEntry], error)
+	HoldSlot(context.Context, *connect.Request[proto.HoldSlotRequest]) (*connect.Response[proto.SlotHold], error)
+	ConfirmHold(context.Context, *connect.Request[proto.ConfirmHoldRequest]) (*connect.Response[proto.Appointment], error)
+	RescheduleAppointment(context.Context, *connect.Request[proto.RescheduleAppointmentRequest]) (*connect.Response[proto.Appointment], error)
+	ListReschedules(context.Context, *connect.Request[proto.ListReschedulesRequest]) (*connect.Response[proto.ListReschedulesResponse], error)
 }
 
 // NewAppointmentServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -183,6 +523,126 @@ func NewAppointmentServiceHandler(svc AppointmentServiceHandler, opts ...connect
 		connect.WithSchema(appointmentServiceMethods.ByName("DeleteAppointment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	appointmentServiceSearchAppointmentsHandler := connect.NewUnaryHandler(
+		AppointmentServiceSearchAppointmentsProcedure,
+		svc.SearchAppointments,
+		connect.WithSchema(appointmentServiceMethods.ByName("SearchAppointments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceImportCalendarHandler := connect.NewClientStreamHandler(
+		AppointmentServiceImportCalendarProcedure,
+		svc.ImportCalendar,
+		connect.WithSchema(appointmentServiceMethods.ByName("ImportCalendar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceListDeletedAppointmentsHandler := connect.NewUnaryHandler(
+		AppointmentServiceListDeletedAppointmentsProcedure,
+		svc.ListDeletedAppointments,
+		connect.WithSchema(appointmentServiceMethods.ByName("ListDeletedAppointments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceRestoreAppointmentHandler := connect.NewUnaryHandler(
+		AppointmentServiceRestoreAppointmentProcedure,
+		svc.RestoreAppointment,
+		connect.WithSchema(appointmentServiceMethods.ByName("RestoreAppointment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceListAppointmentTypesHandler := connect.NewUnaryHandler(
+		AppointmentServiceListAppointmentTypesProcedure,
+		svc.ListAppointmentTypes,
+		connect.WithSchema(appointmentServiceMethods.ByName("ListAppointmentTypes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceGetAppointmentTypeHandler := connect.NewUnaryHandler(
+		AppointmentServiceGetAppointmentTypeProcedure,
+		svc.GetAppointmentType,
+		connect.WithSchema(appointmentServiceMethods.ByName("GetAppointmentType")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceListGroupSessionsHandler := connect.NewUnaryHandler(
+		AppointmentServiceListGroupSessionsProcedure,
+		svc.ListGroupSessions,
+		connect.WithSchema(appointmentServiceMethods.ByName("ListGroupSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceJoinGroupSessionHandler := connect.NewUnaryHandler(
+		AppointmentServiceJoinGroupSessionProcedure,
+		svc.JoinGroupSession,
+		connect.WithSchema(appointmentServiceMethods.ByName("JoinGroupSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceLeaveGroupSessionHandler := connect.NewUnaryHandler(
+		AppointmentServiceLeaveGroupSessionProcedure,
+		svc.LeaveGroupSession,
+		connect.WithSchema(appointmentServiceMethods.ByName("LeaveGroupSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceGetGroupSessionRosterHandler := connect.NewUnaryHandler(
+		AppointmentServiceGetGroupSessionRosterProcedure,
+		svc.GetGroupSessionRoster,
+		connect.WithSchema(appointmentServiceMethods.ByName("GetGroupSessionRoster")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceUpdateRsvpStatusHandler := connect.NewUnaryHandler(
+		AppointmentServiceUpdateRsvpStatusProcedure,
+		svc.UpdateRsvpStatus,
+		connect.WithSchema(appointmentServiceMethods.ByName("UpdateRsvpStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceJoinWaitlistHandler := connect.NewUnaryHandler(
+		AppointmentServiceJoinWaitlistProcedure,
+		svc.JoinWaitlist,
+		connect.WithSchema(appointmentServiceMethods.ByName("JoinWaitlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceGetWaitlistEntryHandler := connect.NewUnaryHandler(
+		AppointmentServiceGetWaitlistEntryProcedure,
+		svc.GetWaitlistEntry,
+		connect.WithSchema(appointmentServiceMethods.ByName("GetWaitlistEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceLeaveWaitlistHandler := connect.NewUnaryHandler(
+		AppointmentServiceLeaveWaitlistProcedure,
+		svc.LeaveWaitlist,
+		connect.WithSchema(appointmentServiceMethods.ByName("LeaveWaitlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceAcceptWaitlistOfferHandler := connect.NewUnaryHandler(
+		AppointmentServiceAcceptWaitlistOfferProcedure,
+		svc.AcceptWaitlistOffer,
+		connect.WithSchema(appointmentServiceMethods.ByName("AcceptWaitlistOffer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceDeclineWaitlistOfferHandler := connect.NewUnaryHandler(
+		AppointmentServiceDeclineWaitlistOfferProcedure,
+		svc.DeclineWaitlistOffer,
+		connect.WithSchema(appointmentServiceMethods.ByName("DeclineWaitlistOffer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceHoldSlotHandler := connect.NewUnaryHandler(
+		AppointmentServiceHoldSlotProcedure,
+		svc.HoldSlot,
+		connect.WithSchema(appointmentServiceMethods.ByName("HoldSlot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceConfirmHoldHandler := connect.NewUnaryHandler(
+		AppointmentServiceConfirmHoldProcedure,
+		svc.ConfirmHold,
+		connect.WithSchema(appointmentServiceMethods.ByName("ConfirmHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceRescheduleAppointmentHandler := connect.NewUnaryHandler(
+		AppointmentServiceRescheduleAppointmentProcedure,
+		svc.RescheduleAppointment,
+		connect.WithSchema(appointmentServiceMethods.ByName("RescheduleAppointment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appointmentServiceListReschedulesHandler := connect.NewUnaryHandler(
+		AppointmentServiceListReschedulesProcedure,
+		svc.ListReschedules,
+		connect.WithSchema(appointmentServiceMethods.ByName("ListReschedules")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/appointment.AppointmentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AppointmentServiceGetAppointmentProcedure:
@@ -195,6 +655,46 @@ func NewAppointmentServiceHandler(svc AppointmentServiceHandler, opts ...connect
 			appointmentServiceUpdateAppointmentHandler.ServeHTTP(w, r)
 		case AppointmentServiceDeleteAppointmentProcedure:
 			appointmentServiceDeleteAppointmentHandler.ServeHTTP(w, r)
+		case AppointmentServiceSearchAppointmentsProcedure:
+			appointmentServiceSearchAppointmentsHandler.ServeHTTP(w, r)
+		case AppointmentServiceImportCalendarProcedure:
+			appointmentServiceImportCalendarHandler.ServeHTTP(w, r)
+		case AppointmentServiceListDeletedAppointmentsProcedure:
+			appointmentServiceListDeletedAppointmentsHandler.ServeHTTP(w, r)
+		case AppointmentServiceRestoreAppointmentProcedure:
+			appointmentServiceRestoreAppointmentHandler.ServeHTTP(w, r)
+		case AppointmentServiceListAppointmentTypesProcedure:
+			appointmentServiceListAppointmentTypesHandler.ServeHTTP(w, r)
+		case AppointmentServiceGetAppointmentTypeProcedure:
+			appointmentServiceGetAppointmentTypeHandler.ServeHTTP(w, r)
+		case AppointmentServiceListGroupSessionsProcedure:
+			appointmentServiceListGroupSessionsHandler.ServeHTTP(w, r)
+		case AppointmentServiceJoinGroupSessionProcedure:
+			appointmentServiceJoinGroupSessionHandler.ServeHTTP(w, r)
+		case AppointmentServiceLeaveGroupSessionProcedure:
+			appointmentServiceLeaveGroupSessionHandler.ServeHTTP(w, r)
+		case AppointmentServiceGetGroupSessionRosterProcedure:
+			appointmentServiceGetGroupSessionRosterHandler.ServeHTTP(w, r)
+		case AppointmentServiceUpdateRsvpStatusProcedure:
+			appointmentServiceUpdateRsvpStatusHandler.ServeHTTP(w, r)
+		case AppointmentServiceJoinWaitlistProcedure:
+			appointmentServiceJoinWaitlistHandler.ServeHTTP(w, r)
+		case AppointmentServiceGetWaitlistEntryProcedure:
+			appointmentServiceGetWaitlistEntryHandler.ServeHTTP(w, r)
+		case AppointmentServiceLeaveWaitlistProcedure:
+			appointmentServiceLeaveWaitlistHandler.ServeHTTP(w, r)
+		case AppointmentServiceAcceptWaitlistOfferProcedure:
+			appointmentServiceAcceptWaitlistOfferHandler.ServeHTTP(w, r)
+		case AppointmentServiceDeclineWaitlistOfferProcedure:
+			appointmentServiceDeclineWaitlistOfferHandler.ServeHTTP(w, r)
+		case AppointmentServiceHoldSlotProcedure:
+			appointmentServiceHoldSlotHandler.ServeHTTP(w, r)
+		case AppointmentServiceConfirmHoldProcedure:
+			appointmentServiceConfirmHoldHandler.ServeHTTP(w, r)
+		case AppointmentServiceRescheduleAppointmentProcedure:
+			appointmentServiceRescheduleAppointmentHandler.ServeHTTP(w, r)
+		case AppointmentServiceListReschedulesProcedure:
+			appointmentServiceListReschedulesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -223,3 +723,83 @@ func (UnimplementedAppointmentServiceHandler) UpdateAppointment(context.Context,
 func (UnimplementedAppointmentServiceHandler) DeleteAppointment(context.Context, *connect.Request[proto.DeleteAppointmentRequest]) (*connect.Response[proto.DeleteAppointmentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.DeleteAppointment is not implemented"))
 }
+
+func (UnimplementedAppointmentServiceHandler) SearchAppointments(context.Context, *connect.Request[proto.SearchAppointmentsRequest]) (*connect.Response[proto.SearchAppointmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.SearchAppointments is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ImportCalendar(context.Context, *connect.ClientStream[proto.ImportCalendarRequest]) (*connect.Response[proto.ImportCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ImportCalendar is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ListDeletedAppointments(context.Context, *connect.Request[proto.ListDeletedAppointmentsRequest]) (*connect.Response[proto.ListDeletedAppointmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ListDeletedAppointments is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) RestoreAppointment(context.Context, *connect.Request[proto.RestoreAppointmentRequest]) (*connect.Response[proto.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.RestoreAppointment is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ListAppointmentTypes(context.Context, *connect.Request[proto.ListAppointmentTypesRequest]) (*connect.Response[proto.ListAppointmentTypesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ListAppointmentTypes is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) GetAppointmentType(context.Context, *connect.Request[proto.GetAppointmentTypeRequest]) (*connect.Response[proto.AppointmentType], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.GetAppointmentType is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ListGroupSessions(context.Context, *connect.Request[proto.ListGroupSessionsRequest]) (*connect.Response[proto.ListGroupSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ListGroupSessions is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) JoinGroupSession(context.Context, *connect.Request[proto.JoinGroupSessionRequest]) (*connect.Response[proto.JoinGroupSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.JoinGroupSession is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) LeaveGroupSession(context.Context, *connect.Request[proto.LeaveGroupSessionRequest]) (*connect.Response[proto.GroupSession], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.LeaveGroupSession is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) GetGroupSessionRoster(context.Context, *connect.Request[proto.GetGroupSessionRosterRequest]) (*connect.Response[proto.GetGroupSessionRosterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.GetGroupSessionRoster is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) UpdateRsvpStatus(context.Context, *connect.Request[proto.UpdateRsvpStatusRequest]) (*connect.Response[proto.Participant], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.UpdateRsvpStatus is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) JoinWaitlist(context.Context, *connect.Request[proto.JoinWaitlistRequest]) (*connect.Response[proto.WaitlistEntry], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.JoinWaitlist is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) GetWaitlistEntry(context.Context, *connect.Request[proto.GetWaitlistEntryRequest]) (*connect.Response[proto.WaitlistEntry], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.GetWaitlistEntry is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) LeaveWaitlist(context.Context, *connect.Request[proto.LeaveWaitlistRequest]) (*connect.Response[proto.WaitlistEntry], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.LeaveWaitlist is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) AcceptWaitlistOffer(context.Context, *connect.Request[proto.AcceptWaitlistOfferRequest]) (*connect.Response[proto.AcceptWaitlistOfferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.AcceptWaitlistOffer is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) DeclineWaitlistOffer(context.Context, *connect.Request[proto.DeclineWaitlistOfferRequest]) (*connect.Response[proto.WaitlistEntry], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.DeclineWaitlistOffer is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) HoldSlot(context.Context, *connect.Request[proto.HoldSlotRequest]) (*connect.Response[proto.SlotHold], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.HoldSlot is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ConfirmHold(context.Context, *connect.Request[proto.ConfirmHoldRequest]) (*connect.Response[proto.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ConfirmHold is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) RescheduleAppointment(context.Context, *connect.Request[proto.RescheduleAppointmentRequest]) (*connect.Response[proto.Appointment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.RescheduleAppointment is not implemented"))
+}
+
+func (UnimplementedAppointmentServiceHandler) ListReschedules(context.Context, *connect.Request[proto.ListReschedulesRequest]) (*connect.Response[proto.ListReschedulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("appointment.AppointmentService.ListReschedules is not implemented"))
+}
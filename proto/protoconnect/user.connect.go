@@ -35,11 +35,28 @@ const (
 const (
 	// UserServiceGetUserProcedure is the fully-qualified name of the UserService's GetUser RPC.
 	UserServiceGetUserProcedure = "/user.UserService/GetUser"
+	// UserServiceCreateFeedTokenProcedure is the fully-qualified name of the UserService's
+	// CreateFeedToken RPC.
+	UserServiceCreateFeedTokenProcedure = "/user.UserService/CreateFeedToken"
+	// UserServiceListFeedTokensProcedure is the fully-qualified name of the UserService's
+	// ListFeedTokens RPC.
+	UserServiceListFeedTokensProcedure = "/user.UserService/ListFeedTokens"
+	// UserServiceRevokeFeedTokenProcedure is the fully-qualified name of the UserService's
+	// RevokeFeedToken RPC.
+	UserServiceRevokeFeedTokenProcedure = "/user.UserService/RevokeFeedToken"
+	// UserServiceSetUserTimeZoneProcedure is the fully-qualified name of the UserService's
+	// SetUserTimeZone RPC.
+	UserServiceSetUserTimeZoneProcedure = "/user.UserService/SetUserTimeZone"
 )
 
 // UserServiceClient is a client for the user.UserService service.
 type UserServiceClient interface {
 	GetUser(context.Context, *connect.Request[proto.GetUserRequest]) (*connect.Response[proto.GetUserResponse], error)
+	// Feed tokens can only be managed with the admin token as a bearer token.
+	CreateFeedToken(context.Context, *connect.Request[proto.CreateFeedTokenRequest]) (*connect.Response[proto.CreateFeedTokenResponse], error)
+	ListFeedTokens(context.Context, *connect.Request[proto.ListFeedTokensRequest]) (*connect.Response[proto.ListFeedTokensResponse], error)
+	RevokeFeedToken(context.Context, *connect.Request[proto.RevokeFeedTokenRequest]) (*connect.Response[proto.RevokeFeedTokenResponse], error)
+	SetUserTimeZone(context.Context, *connect.Request[proto.SetUserTimeZoneRequest]) (*connect.Response[proto.User], error)
 }
 
 // NewUserServiceClient constructs a client for the user.UserService service. By default, it uses
@@ -59,12 +76,40 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("GetUser")),
 			connect.WithClientOptions(opts...),
 		),
+		createFeedToken: connect.NewClient[proto.CreateFeedTokenRequest, proto.CreateFeedTokenResponse](
+			httpClient,
+			baseURL+UserServiceCreateFeedTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listFeedTokens: connect.NewClient[proto.ListFeedTokensRequest, proto.ListFeedTokensResponse](
+			httpClient,
+			baseURL+UserServiceListFeedTokensProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListFeedTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeFeedToken: connect.NewClient[proto.RevokeFeedTokenRequest, proto.RevokeFeedTokenResponse](
+			httpClient,
+			baseURL+UserServiceRevokeFeedTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		setUserTimeZone: connect.NewClient[proto.SetUserTimeZoneRequest, proto.User](
+			httpClient,
+			baseURL+UserServiceSetUserTimeZoneProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SetUserTimeZone")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	getUser *connect.Client[proto.GetUserRequest, proto.GetUserResponse]
+	getUser         *connect.Client[proto.GetUserRequest, proto.GetUserResponse]
+	createFeedToken *connect.Client[proto.CreateFeedTokenRequest, proto.CreateFeedTokenResponse]
+	listFeedTokens  *connect.Client[proto.ListFeedTokensRequest, proto.ListFeedTokensResponse]
+	revokeFeedToken *connect.Client[proto.RevokeFeedTokenRequest, proto.RevokeFeedTokenResponse]
+	setUserTimeZone *connect.Client[proto.SetUserTimeZoneRequest, proto.User]
 }
 
 // GetUser calls user.UserService.GetUser.
@@ -72,9 +117,34 @@ func (c *userServiceClient) GetUser(ctx context.Context, req *connect.Request[pr
 	return c.getUser.CallUnary(ctx, req)
 }
 
+// CreateFeedToken calls user.UserService.CreateFeedToken.
+func (c *userServiceClient) CreateFeedToken(ctx context.Context, req *connect.Request[proto.CreateFeedTokenRequest]) (*connect.Response[proto.CreateFeedTokenResponse], error) {
+	return c.createFeedToken.CallUnary(ctx, req)
+}
+
+// ListFeedTokens calls user.UserService.ListFeedTokens.
+func (c *userServiceClient) ListFeedTokens(ctx context.Context, req *connect.Request[proto.ListFeedTokensRequest]) (*connect.Response[proto.ListFeedTokensResponse], error) {
+	return c.listFeedTokens.CallUnary(ctx, req)
+}
+
+// RevokeFeedToken calls user.UserService.RevokeFeedToken.
+func (c *userServiceClient) RevokeFeedToken(ctx context.Context, req *connect.Request[proto.RevokeFeedTokenRequest]) (*connect.Response[proto.RevokeFeedTokenResponse], error) {
+	return c.revokeFeedToken.CallUnary(ctx, req)
+}
+
+// SetUserTimeZone calls user.UserService.SetUserTimeZone.
+func (c *userServiceClient) SetUserTimeZone(ctx context.Context, req *connect.Request[proto.SetUserTimeZoneRequest]) (*connect.Response[proto.User], error) {
+	return c.setUserTimeZone.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the user.UserService service.
 type UserServiceHandler interface {
 	GetUser(context.Context, *connect.Request[proto.GetUserRequest]) (*connect.Response[proto.GetUserResponse], error)
+	// Feed tokens can only be managed with the admin token as a bearer token.
+	CreateFeedToken(context.Context, *connect.Request[proto.CreateFeedTokenRequest]) (*connect.Response[proto.CreateFeedTokenResponse], error)
+	ListFeedTokens(context.Context, *connect.Request[proto.ListFeedTokensRequest]) (*connect.Response[proto.ListFeedTokensResponse], error)
+	RevokeFeedToken(context.Context, *connect.Request[proto.RevokeFeedTokenRequest]) (*connect.Response[proto.RevokeFeedTokenResponse], error)
+	SetUserTimeZone(context.Context, *connect.Request[proto.SetUserTimeZoneRequest]) (*connect.Response[proto.User], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -90,10 +160,42 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("GetUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceCreateFeedTokenHandler := connect.NewUnaryHandler(
+		UserServiceCreateFeedTokenProcedure,
+		svc.CreateFeedToken,
+		connect.WithSchema(userServiceMethods.ByName("CreateFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListFeedTokensHandler := connect.NewUnaryHandler(
+		UserServiceListFeedTokensProcedure,
+		svc.ListFeedTokens,
+		connect.WithSchema(userServiceMethods.ByName("ListFeedTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeFeedTokenHandler := connect.NewUnaryHandler(
+		UserServiceRevokeFeedTokenProcedure,
+		svc.RevokeFeedToken,
+		connect.WithSchema(userServiceMethods.ByName("RevokeFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetUserTimeZoneHandler := connect.NewUnaryHandler(
+		UserServiceSetUserTimeZoneProcedure,
+		svc.SetUserTimeZone,
+		connect.WithSchema(userServiceMethods.ByName("SetUserTimeZone")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceGetUserProcedure:
 			userServiceGetUserHandler.ServeHTTP(w, r)
+		case UserServiceCreateFeedTokenProcedure:
+			userServiceCreateFeedTokenHandler.ServeHTTP(w, r)
+		case UserServiceListFeedTokensProcedure:
+			userServiceListFeedTokensHandler.ServeHTTP(w, r)
+		case UserServiceRevokeFeedTokenProcedure:
+			userServiceRevokeFeedTokenHandler.ServeHTTP(w, r)
+		case UserServiceSetUserTimeZoneProcedure:
+			userServiceSetUserTimeZoneHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -106,3 +208,19 @@ type UnimplementedUserServiceHandler struct{}
 func (UnimplementedUserServiceHandler) GetUser(context.Context, *connect.Request[proto.GetUserRequest]) (*connect.Response[proto.GetUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.UserService.GetUser is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) CreateFeedToken(context.Context, *connect.Request[proto.CreateFeedTokenRequest]) (*connect.Response[proto.CreateFeedTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.UserService.CreateFeedToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListFeedTokens(context.Context, *connect.Request[proto.ListFeedTokensRequest]) (*connect.Response[proto.ListFeedTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.UserService.ListFeedTokens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeFeedToken(context.Context, *connect.Request[proto.RevokeFeedTokenRequest]) (*connect.Response[proto.RevokeFeedTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.UserService.RevokeFeedToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetUserTimeZone(context.Context, *connect.Request[proto.SetUserTimeZoneRequest]) (*connect.Response[proto.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.UserService.SetUserTimeZone is not implemented"))
+}
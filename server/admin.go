@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log"
+	"net"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/retention"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	protoconnect "github.com/folucode/appointment-scheduler/proto/protoconnect"
+	"github.com/google/uuid"
+)
+
+type AdminServer struct {
+	protoconnect.UnimplementedAdminServiceHandler
+	Storage *db.Database
+	Purger  *retention.Purger
+}
+
+// newAdminAuthInterceptor only lets through requests carrying the configured
+// admin token as a bearer token. With no token configured every call is
+// rejected, so the admin API is off unless explicitly enabled. Given
+// procedures, only those need the token and the rest pass through.
+func newAdminAuthInterceptor(token string, procedures ...string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if len(procedures) > 0 && !slices.Contains(procedures, req.Spec().Procedure) {
+				return next(ctx, req)
+			}
+			if token == "" {
+				return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin API is disabled"))
+			}
+
+			supplied := strings.TrimPrefix(req.Header().Get("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(supplied), []byte(token)) != 1 {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid admin token"))
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
+
+func (s *AdminServer) AddDenylistEntry(
+	ctx context.Context,
+	req *connect.Request[pb.AddDenylistEntryRequest],
+) (*connect.Response[pb.DenylistEntry], error) {
+	log.Printf("Incoming Request to add denylist entry: %+v", req.Msg)
+
+	value := strings.TrimSpace(req.Msg.Value)
+	if value == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("value is required"))
+	}
+
+	switch req.Msg.Kind {
+	case pb.DenylistKind_DENYLIST_KIND_EMAIL:
+		if !strings.Contains(value, "@") {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("value must be an email address"))
+		}
+	case pb.DenylistKind_DENYLIST_KIND_IP:
+		if net.ParseIP(value) == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("value must be an IP address"))
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("kind must be email or ip"))
+	}
+
+	entry, err := s.Storage.AddDenylistEntry(ctx, &pb.DenylistEntry{
+		Id:     uuid.NewString(),
+		Kind:   req.Msg.Kind,
+		Value:  value,
+		Reason: req.Msg.Reason,
+	})
+	if err != nil {
+		log.Printf("Error adding denylist entry: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to add denylist entry"))
+	}
+
+	return connect.NewResponse(entry), nil
+}
+
+func (s *AdminServer) RemoveDenylistEntry(
+	ctx context.Context,
+	req *connect.Request[pb.RemoveDenylistEntryRequest],
+) (*connect.Response[pb.RemoveDenylistEntryResponse], error) {
+	log.Printf("Incoming Request to remove denylist entry: %+v", req.Msg)
+
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	success, err := s.Storage.RemoveDenylistEntry(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.RemoveDenylistEntryResponse{
+		Success: success,
+	}), nil
+}
+
+func (s *AdminServer) ListDenylistEntries(
+	ctx context.Context,
+	req *connect.Request[pb.ListDenylistEntriesRequest],
+) (*connect.Response[pb.ListDenylistEntriesResponse], error) {
+	log.Printf("Incoming Request to list denylist entries")
+
+	entries, err := s.Storage.ListDenylistEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.ListDenylistEntriesResponse{
+		Entries: entries,
+	}), nil
+}
+
+func (s *AdminServer) ListAppointments(
+	ctx context.Context,
+	req *connect.Request[pb.ListAppointmentsRequest],
+) (*connect.Response[pb.ListAppointmentsResponse], error) {
+	log.Printf("Incoming Request to list appointments: %+v", req.Msg)
+
+	appts, nextPageToken, total, err := s.Storage.ListAppointments(ctx, db.ListAppointmentsOptions{
+		Filter:    req.Msg.Filter,
+		OrderBy:   req.Msg.OrderBy,
+		PageSize:  int(req.Msg.PageSize),
+		PageToken: req.Msg.PageToken,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidFilter) || errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		log.Printf("Error listing appointments: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list appointments"))
+	}
+
+	return connect.NewResponse(&pb.ListAppointmentsResponse{
+		Appointments:  appts,
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(total),
+	}), nil
+}
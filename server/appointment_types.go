@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// appointmentTypeChecks validate each field of an AppointmentType by its
+// update mask path.
+var appointmentTypeChecks = map[string]func(t *pb.AppointmentType) error{
+	"name": func(t *pb.AppointmentType) error {
+		if strings.TrimSpace(t.Name) == "" {
+			return errors.New("name is required")
+		}
+		return nil
+	},
+	"description": func(t *pb.AppointmentType) error { return nil },
+	"duration": func(t *pb.AppointmentType) error {
+		if d := t.Duration.AsDuration(); d <= 0 || d%time.Second != 0 {
+			return errors.New("duration must be a positive whole number of seconds")
+		}
+		return nil
+	},
+	"buffer_before": func(t *pb.AppointmentType) error { return validateBuffer(t.BufferBefore) },
+	"buffer_after":  func(t *pb.AppointmentType) error { return validateBuffer(t.BufferAfter) },
+	"color": func(t *pb.AppointmentType) error {
+		if t.Color != "" && !colorPattern.MatchString(t.Color) {
+			return errors.New(`color must look like "#3366ff"`)
+		}
+		return nil
+	},
+	"active": func(t *pb.AppointmentType) error { return nil },
+}
+
+func validateBuffer(d *durationpb.Duration) error {
+	if d == nil {
+		return nil
+	}
+	if b := d.AsDuration(); b < 0 || b%time.Second != 0 {
+		return errors.New("buffers must be a non-negative whole number of seconds")
+	}
+	return nil
+}
+
+// validateAppointmentType checks the fields of t named by paths. Unknown
+// paths are left for the database layer to reject.
+func validateAppointmentType(t *pb.AppointmentType, paths []string) error {
+	for _, path := range paths {
+		if check, ok := appointmentTypeChecks[path]; ok {
+			if err := check(t); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *AppointmentServer) ListAppointmentTypes(
+	ctx context.Context,
+	req *connect.Request[pb.ListAppointmentTypesRequest],
+) (*connect.Response[pb.ListAppointmentTypesResponse], error) {
+	log.Printf("Incoming Request to list appointment types: %+v", req.Msg)
+
+	types, err := s.Storage.ListAppointmentTypes(ctx, req.Msg.IncludeInactive)
+	if err != nil {
+		log.Printf("Error listing appointment types: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list appointment types"))
+	}
+
+	return connect.NewResponse(&pb.ListAppointmentTypesResponse{
+		AppointmentTypes: types,
+	}), nil
+}
+
+func (s *AppointmentServer) GetAppointmentType(
+	ctx context.Context,
+	req *connect.Request[pb.GetAppointmentTypeRequest],
+) (*connect.Response[pb.AppointmentType], error) {
+	log.Printf("Incoming Request to get appointment type: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.Id) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id must be a UUID"))
+	}
+
+	t, err := s.Storage.GetAppointmentType(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, db.ErrAppointmentTypeNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		log.Printf("Error loading appointment type: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to load appointment type"))
+	}
+
+	return connect.NewResponse(t), nil
+}
+
+func (s *AdminServer) CreateAppointmentType(
+	ctx context.Context,
+	req *connect.Request[pb.CreateAppointmentTypeRequest],
+) (*connect.Response[pb.AppointmentType], error) {
+	log.Printf("Incoming Request to create appointment type: %+v", req.Msg)
+
+	t := req.Msg.AppointmentType
+	if t == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("appointment_type is required"))
+	}
+
+	paths := make([]string, 0, len(appointmentTypeChecks))
+	for path := range appointmentTypeChecks {
+		paths = append(paths, path)
+	}
+	if err := validateAppointmentType(t, paths); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	t.Id = uuid.NewString()
+	t.Name = strings.TrimSpace(t.Name)
+
+	created, err := s.Storage.CreateAppointmentType(ctx, t)
+	if err != nil {
+		if errors.Is(err, db.ErrAppointmentTypeExists) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		log.Printf("Error creating appointment type: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create appointment type"))
+	}
+
+	return connect.NewResponse(created), nil
+}
+
+func (s *AdminServer) UpdateAppointmentType(
+	ctx context.Context,
+	req *connect.Request[pb.UpdateAppointmentTypeRequest],
+) (*connect.Response[pb.AppointmentType], error) {
+	log.Printf("Incoming Request to update appointment type: %+v", req.Msg)
+
+	t := req.Msg.AppointmentType
+	if t == nil || uuid.Validate(t.Id) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("appointment_type.id must be a UUID"))
+	}
+
+	paths := req.Msg.UpdateMask.GetPaths()
+	if err := validateAppointmentType(t, paths); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	t.Name = strings.TrimSpace(t.Name)
+
+	updated, err := s.Storage.UpdateAppointmentType(ctx, t, paths)
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrInvalidUpdateMask):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, db.ErrAppointmentTypeNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, db.ErrAppointmentTypeExists):
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		log.Printf("Error updating appointment type: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update appointment type"))
+	}
+
+	return connect.NewResponse(updated), nil
+}
+
+func (s *AdminServer) DeleteAppointmentType(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteAppointmentTypeRequest],
+) (*connect.Response[pb.DeleteAppointmentTypeResponse], error) {
+	log.Printf("Incoming Request to delete appointment type: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.Id) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id must be a UUID"))
+	}
+
+	success, err := s.Storage.DeleteAppointmentType(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, db.ErrAppointmentTypeInUse) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		log.Printf("Error deleting appointment type: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete appointment type"))
+	}
+
+	return connect.NewResponse(&pb.DeleteAppointmentTypeResponse{
+		Success: success,
+	}), nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/ratelimit"
+	pb "github.com/folucode/appointment-scheduler/proto"
+)
+
+// newAuditInterceptor attributes the changes a request makes to actor in the
+// audit log.
+func newAuditInterceptor(actor string, trustProxy bool) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			ctx = db.WithAudit(ctx, db.AuditContext{
+				Actor:     actor,
+				IP:        ratelimit.ClientIP(req, trustProxy),
+				Procedure: req.Spec().Procedure,
+			})
+			return next(ctx, req)
+		}
+	}
+}
+
+// withHTTPAudit is newAuditInterceptor for plain HTTP handlers. The
+// procedure is the request method under prefix, such as "caldav PUT".
+func withHTTPAudit(next http.Handler, prefix string, trustProxy bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := db.WithAudit(r.Context(), db.AuditContext{
+			Actor:     "anonymous",
+			IP:        ratelimit.RemoteIP(r.Header, r.RemoteAddr, trustProxy),
+			Procedure: prefix + " " + r.Method,
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// withAuditActor attributes the changes made with ctx to actor, once the
+// caller has been authenticated.
+func withAuditActor(ctx context.Context, actor string) context.Context {
+	audit := db.AuditFromContext(ctx)
+	audit.Actor = actor
+	return db.WithAudit(ctx, audit)
+}
+
+func (s *AdminServer) ListAuditEvents(
+	ctx context.Context,
+	req *connect.Request[pb.ListAuditEventsRequest],
+) (*connect.Response[pb.ListAuditEventsResponse], error) {
+	log.Printf("Incoming Request to list audit events: %+v", req.Msg)
+
+	filter := db.AuditFilter{
+		EntityType: req.Msg.EntityType,
+		EntityId:   req.Msg.EntityId,
+		Actor:      req.Msg.Actor,
+		PageSize:   int(req.Msg.PageSize),
+		PageToken:  req.Msg.PageToken,
+	}
+	if req.Msg.From != nil {
+		filter.From = req.Msg.From.AsTime()
+	}
+	if req.Msg.To != nil {
+		filter.To = req.Msg.To.AsTime()
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("from must be before to"))
+	}
+
+	events, nextPageToken, err := s.Storage.ListAuditEvents(ctx, filter)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		log.Printf("Error listing audit events: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list audit events"))
+	}
+
+	return connect.NewResponse(&pb.ListAuditEventsResponse{
+		Events:        events,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (s *AdminServer) VerifyAuditLog(
+	ctx context.Context,
+	req *connect.Request[pb.VerifyAuditLogRequest],
+) (*connect.Response[pb.VerifyAuditLogResponse], error) {
+	log.Printf("Incoming Request to verify audit log")
+
+	result, err := s.Storage.VerifyAuditLog(ctx)
+	if err != nil {
+		log.Printf("Error verifying audit log: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to verify audit log"))
+	}
+
+	if !result.Valid {
+		log.Printf("Audit log chain is broken at sequence %d", result.FirstInvalid)
+	}
+
+	return connect.NewResponse(&pb.VerifyAuditLogResponse{
+		Valid:                result.Valid,
+		Checked:              result.Checked,
+		FirstInvalidSequence: result.FirstInvalid,
+		HeadHash:             result.HeadHash,
+	}), nil
+}
+
+func (s *AdminServer) GetAppointmentAsOf(
+	ctx context.Context,
+	req *connect.Request[pb.GetAppointmentAsOfRequest],
+) (*connect.Response[pb.Appointment], error) {
+	log.Printf("Incoming Request to get appointment as of: %+v", req.Msg)
+
+	if req.Msg.Id == "" || req.Msg.AsOf == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id and as_of are required"))
+	}
+
+	appt, err := s.Storage.GetAppointmentAsOf(ctx, req.Msg.Id, req.Msg.AsOf.AsTime())
+	if err != nil {
+		if errors.Is(err, db.ErrAppointmentNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("no record of this appointment at that time"))
+		}
+		log.Printf("Error reading appointment history: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to read appointment history"))
+	}
+
+	return connect.NewResponse(appt), nil
+}
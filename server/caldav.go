@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/folucode/appointment-scheduler/internal/caldav"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/ical"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const caldavPrefix = "/caldav"
+
+// caldavStore maps CalDAV calendar objects onto the appointments table.
+// Writes go through the same overlap constraint as CreateAppointment and
+// deletes are the usual soft delete. It shares the appointment service's
+// storage and reminders.
+type caldavStore struct {
+	*AppointmentServer
+}
+
+func newCaldavHandler(appts *AppointmentServer) *caldav.Handler {
+	return &caldav.Handler{
+		Store:  &caldavStore{appts},
+		Prefix: caldavPrefix,
+		ProdID: calendarProdID,
+	}
+}
+
+// Authenticate expects the user's email as the username and a CalDAV scoped
+// token as the password.
+func (s *caldavStore) Authenticate(ctx context.Context, username, password string) (string, error) {
+	owner, err := s.Storage.FindUserByFeedToken(ctx, hashFeedToken(password), pb.TokenScope_TOKEN_SCOPE_CALDAV)
+	if err != nil {
+		if errors.Is(err, db.ErrUserNotFound) {
+			return "", caldav.ErrUnauthorized
+		}
+		return "", err
+	}
+
+	if !strings.EqualFold(owner.Email, username) {
+		return "", caldav.ErrUnauthorized
+	}
+
+	return owner.Id, nil
+}
+
+func (s *caldavStore) ListObjects(ctx context.Context, userID string, start, end time.Time) ([]*caldav.Object, error) {
+	entries, err := s.Storage.ListCalendarEntries(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*caldav.Object, len(entries))
+	for i, entry := range entries {
+		objects[i] = calendarObject(entry)
+	}
+
+	return objects, nil
+}
+
+func (s *caldavStore) GetObject(ctx context.Context, userID, name string) (*caldav.Object, error) {
+	entry, err := s.Storage.GetCalendarEntry(ctx, userID, name)
+	if err != nil {
+		if errors.Is(err, db.ErrAppointmentNotFound) {
+			return nil, caldav.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return calendarObject(entry), nil
+}
+
+func (s *caldavStore) PutObject(ctx context.Context, userID, name string, ev ical.Event, ifMatch string) (*caldav.Object, bool, error) {
+	ctx = withAuditActor(ctx, "user:"+userID)
+
+	existing, err := s.Storage.GetCalendarEntry(ctx, userID, name)
+	if err != nil && !errors.Is(err, db.ErrAppointmentNotFound) {
+		return nil, false, err
+	}
+
+	created := existing == nil
+	if created {
+		if ifMatch != "" {
+			return nil, false, caldav.ErrPreconditionFailed
+		}
+		err = s.createObject(ctx, userID, name, ev)
+	} else {
+		err = s.updateObject(ctx, existing, name, ev, ifMatch)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	obj, err := s.GetObject(ctx, userID, name)
+	return obj, created, err
+}
+
+func (s *caldavStore) createObject(ctx context.Context, userID, name string, ev ical.Event) error {
+	user, err := s.Storage.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	appt := calendarAppointment(ev)
+	appt.Id = uuid.NewString()
+	appt.UserId = userID
+	appt.ContactInformation = &pb.ContactInformation{
+		Name:  user.Name,
+		Email: user.Email,
+	}
+
+	return caldavError(s.Storage.CreateCalendarAppointment(ctx, appt, name, ev.UID, time.Now()), "")
+}
+
+func (s *caldavStore) updateObject(ctx context.Context, existing *db.CalendarEntry, name string, ev ical.Event, ifMatch string) error {
+	unmodifiedSince, err := etagTime(ifMatch)
+	if err != nil {
+		return err
+	}
+
+	appt := calendarAppointment(ev)
+	appt.Id = existing.Appointment.Id
+	appt.UserId = existing.Appointment.UserId
+	appt.ContactInformation = existing.Appointment.ContactInformation
+
+	if err := s.Storage.UpdateCalendarAppointment(ctx, appt, name, unmodifiedSince, time.Now()); err != nil {
+		return caldavError(err, ifMatch)
+	}
+	return nil
+}
+
+func (s *caldavStore) DeleteObject(ctx context.Context, userID, name, ifMatch string) error {
+	ctx = withAuditActor(ctx, "user:"+userID)
+
+	unmodifiedSince, err := etagTime(ifMatch)
+	if err != nil {
+		return err
+	}
+
+	return caldavError(s.Storage.DeleteCalendarAppointment(ctx, userID, name, unmodifiedSince, time.Now()), ifMatch)
+}
+
+func (s *caldavStore) CTag(ctx context.Context, userID string) (string, error) {
+	lastModified, err := s.Storage.CalendarLastModified(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	return formatETag(lastModified), nil
+}
+
+// caldavError translates errors from a write. An object that disappeared
+// under an If-Match fails the precondition rather than being reported
+// missing.
+func caldavError(err error, ifMatch string) error {
+	switch {
+	case errors.Is(err, db.ErrAppointmentModified):
+		return caldav.ErrPreconditionFailed
+	case errors.Is(err, db.ErrAppointmentNotFound) && ifMatch != "":
+		return caldav.ErrPreconditionFailed
+	case errors.Is(err, db.ErrAppointmentNotFound):
+		return caldav.ErrNotFound
+	case errors.Is(err, db.ErrAppointmentConflict), errors.Is(err, db.ErrCalendarObjectExists):
+		return fmt.Errorf("%w: %w", caldav.ErrConflict, err)
+	case errors.Is(err, db.ErrBookingPolicy), errors.Is(err, db.ErrCancellationCutoff):
+		return fmt.Errorf("%w: %w", caldav.ErrForbidden, err)
+	}
+	return err
+}
+
+func calendarObject(entry *db.CalendarEntry) *caldav.Object {
+	ev := calendarEvent(entry)
+	ev.Status = ""
+
+	return &caldav.Object{
+		Name:  entry.ResourceName,
+		ETag:  formatETag(entry.UpdatedAt),
+		Event: ev,
+	}
+}
+
+func calendarAppointment(ev ical.Event) *pb.Appointment {
+	start := ev.Start.UTC()
+
+	return &pb.Appointment{
+		Title:       ev.Summary,
+		Description: ev.Description,
+		StartTime:   timestamppb.New(start),
+		EndTime:     timestamppb.New(ev.End.UTC()),
+		Date:        timestamppb.New(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+// ETags are derived from updated_at, which every write bumps.
+func formatETag(t time.Time) string {
+	return fmt.Sprintf(`"%x"`, t.UnixNano())
+}
+
+func etagTime(etag string) (*time.Time, error) {
+	if etag == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(etag, "W/"), `"`), 16, 64)
+	if err != nil {
+		return nil, caldav.ErrPreconditionFailed
+	}
+
+	t := time.Unix(0, n)
+	return &t, nil
+}
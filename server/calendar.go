@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/ical"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+const calendarProdID = "-//folucode//Appointment Scheduler//EN"
+
+func newFeedToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *UserServer) CreateFeedToken(
+	ctx context.Context,
+	req *connect.Request[pb.CreateFeedTokenRequest],
+) (*connect.Response[pb.CreateFeedTokenResponse], error) {
+	log.Printf("Incoming Request to create a feed token for user: %s", req.Msg.UserId)
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user ID not supplied"))
+	}
+
+	scope := req.Msg.Scope
+	switch scope {
+	case pb.TokenScope_TOKEN_SCOPE_UNSPECIFIED:
+		scope = pb.TokenScope_TOKEN_SCOPE_FEED
+	case pb.TokenScope_TOKEN_SCOPE_FEED, pb.TokenScope_TOKEN_SCOPE_CALDAV:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("scope must be feed or caldav"))
+	}
+
+	secret, err := newFeedToken()
+	if err != nil {
+		log.Printf("Error generating feed token: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create feed token"))
+	}
+
+	token, err := s.Storage.CreateFeedToken(ctx, uuid.NewString(), req.Msg.UserId, hashFeedToken(secret), scope)
+	if err != nil {
+		log.Printf("Error saving feed token: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create feed token"))
+	}
+
+	publicURL := strings.TrimSuffix(s.PublicURL, "/")
+	if scope == pb.TokenScope_TOKEN_SCOPE_CALDAV {
+		return connect.NewResponse(&pb.CreateFeedTokenResponse{
+			Token:     token,
+			Secret:    secret,
+			CaldavUrl: publicURL + caldavPrefix + "/",
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.CreateFeedTokenResponse{
+		Token:   token,
+		FeedUrl: publicURL + "/calendar/" + secret + ".ics",
+	}), nil
+}
+
+func (s *UserServer) ListFeedTokens(
+	ctx context.Context,
+	req *connect.Request[pb.ListFeedTokensRequest],
+) (*connect.Response[pb.ListFeedTokensResponse], error) {
+	log.Printf("Incoming Request to list feed tokens: %+v", req.Msg)
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user ID not supplied"))
+	}
+
+	tokens, err := s.Storage.ListFeedTokens(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.ListFeedTokensResponse{
+		Tokens: tokens,
+	}), nil
+}
+
+func (s *UserServer) RevokeFeedToken(
+	ctx context.Context,
+	req *connect.Request[pb.RevokeFeedTokenRequest],
+) (*connect.Response[pb.RevokeFeedTokenResponse], error) {
+	log.Printf("Incoming Request to revoke feed token: %+v", req.Msg)
+
+	if req.Msg.Id == "" || req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("token ID and user ID are required"))
+	}
+
+	success, err := s.Storage.RevokeFeedToken(ctx, req.Msg.Id, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.RevokeFeedTokenResponse{
+		Success: success,
+	}), nil
+}
+
+// calendarFeedHandler serves GET /calendar/{token}.ics as an RFC 5545 feed of
+// the token owner's appointments. Deleted appointments stay in the feed as
+// cancelled events so subscribed calendars remove them.
+func calendarFeedHandler(storage *db.Database) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		secret, ok := strings.CutSuffix(r.PathValue("file"), ".ics")
+		if !ok || secret == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		user, err := storage.FindUserByFeedToken(r.Context(), hashFeedToken(secret), pb.TokenScope_TOKEN_SCOPE_FEED)
+		if err != nil {
+			if !errors.Is(err, db.ErrUserNotFound) {
+				log.Printf("Error looking up feed token: %v", err)
+				http.Error(w, "failed to load calendar", http.StatusInternalServerError)
+				return
+			}
+			http.NotFound(w, r)
+			return
+		}
+
+		entries, err := storage.GetCalendarEntries(r.Context(), user.Id)
+		if err != nil {
+			log.Printf("Error loading calendar entries: %v", err)
+			http.Error(w, "failed to load calendar", http.StatusInternalServerError)
+			return
+		}
+
+		cal := ical.Calendar{
+			ProdID: calendarProdID,
+			Name:   user.Name + "'s appointments",
+			Method: "PUBLISH",
+		}
+		for _, entry := range entries {
+			cal.Events = append(cal.Events, calendarEvent(entry))
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Cache-Control", "private, max-age=300")
+		if err := ical.Encode(w, cal, time.Now()); err != nil {
+			log.Printf("Error writing calendar feed: %v", err)
+		}
+	}
+}
+
+func calendarEvent(entry *db.CalendarEntry) ical.Event {
+	appt := entry.Appointment
+
+	status := ical.StatusConfirmed
+	if entry.DeletedAt != nil {
+		status = ical.StatusCancelled
+	}
+
+	uid := entry.ICalUID
+	if uid == "" {
+		uid = appt.Id + "@appointment-scheduler"
+	}
+
+	return ical.Event{
+		UID:          uid,
+		Sequence:     int(entry.UpdatedAt.Sub(entry.CreatedAt) / time.Second),
+		Summary:      appt.Title,
+		Description:  appt.Description,
+		Start:        appt.StartTime.AsTime(),
+		End:          appt.EndTime.AsTime(),
+		Created:      entry.CreatedAt,
+		LastModified: entry.UpdatedAt,
+		Status:       status,
+		Attendee:     appt.ContactInformation.Email,
+	}
+}
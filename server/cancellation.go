@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+const maxCancellationNoteLength = 1000
+
+// newCancellation checks the reason and note given for cancelling an
+// appointment.
+func newCancellation(reason pb.CancellationReason, note string, by pb.CancelledBy) (*pb.Cancellation, error) {
+	if _, ok := pb.CancellationReason_name[int32(reason)]; !ok {
+		return nil, errors.New("unknown cancellation reason")
+	}
+	if len(note) > maxCancellationNoteLength {
+		return nil, errors.New("note is too long")
+	}
+
+	return &pb.Cancellation{Reason: reason, Note: note, CancelledBy: by}, nil
+}
+
+func (s *AdminServer) CancelAppointment(
+	ctx context.Context,
+	req *connect.Request[pb.CancelAppointmentRequest],
+) (*connect.Response[pb.Appointment], error) {
+	log.Printf("Incoming Request to cancel appointment as the provider: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.Id) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id must be a UUID"))
+	}
+	cancellation, err := newCancellation(req.Msg.Reason, req.Msg.Note, pb.CancelledBy_CANCELLED_BY_PROVIDER)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	appt, err := s.Storage.DeleteAppointment(ctx, req.Msg.Id, cancellation, time.Now())
+	if err != nil {
+		if errors.Is(err, db.ErrAppointmentNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("no active appointment with this id"))
+		}
+		log.Printf("Error cancelling appointment: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to cancel appointment"))
+	}
+
+	return connect.NewResponse(appt), nil
+}
+
+func (s *AdminServer) CountLateCancellations(
+	ctx context.Context,
+	req *connect.Request[pb.CountLateCancellationsRequest],
+) (*connect.Response[pb.CountLateCancellationsResponse], error) {
+	log.Printf("Incoming Request to count late cancellations: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.UserId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id must be a UUID"))
+	}
+
+	var since time.Time
+	if req.Msg.Since != nil {
+		since = req.Msg.Since.AsTime()
+	}
+
+	count, err := s.Storage.CountLateCancellations(ctx, req.Msg.UserId, since)
+	if err != nil {
+		if errors.Is(err, db.ErrUserNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		log.Printf("Error counting late cancellations: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to count late cancellations"))
+	}
+
+	return connect.NewResponse(&pb.CountLateCancellationsResponse{Count: int32(count)}), nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+func (s *AppointmentServer) HoldSlot(
+	ctx context.Context,
+	req *connect.Request[pb.HoldSlotRequest],
+) (*connect.Response[pb.SlotHold], error) {
+	log.Printf("Incoming Request to hold a slot: %+v", req.Msg)
+
+	contact := req.Msg.ContactInformation
+	if contact.GetName() == "" || contact.GetEmail() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("contact_information needs a name and an email"))
+	}
+	if req.Msg.StartTime == nil || req.Msg.EndTime == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start_time and end_time are required"))
+	}
+	if !req.Msg.StartTime.AsTime().Before(req.Msg.EndTime.AsTime()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start_time must be before end_time"))
+	}
+	if req.Msg.ReplacesHoldId != "" && uuid.Validate(req.Msg.ReplacesHoldId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("replaces_hold_id must be a UUID"))
+	}
+
+	timeZone, err := s.bookingTimeZone(ctx, req.Msg.TimeZone, contact)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.Storage.CreateUser(ctx, &pb.User{
+		Id:       uuid.NewString(),
+		Name:     contact.Name,
+		Email:    contact.Email,
+		TimeZone: timeZone,
+	})
+	if err != nil {
+		log.Printf("Error creating user: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to process user information"))
+	}
+
+	hold, err := s.Storage.HoldSlot(ctx, &pb.Appointment{
+		Id:                 uuid.NewString(),
+		UserId:             user.Id,
+		ContactInformation: &pb.ContactInformation{Name: contact.Name, Email: contact.Email},
+		StartTime:          req.Msg.StartTime,
+		EndTime:            req.Msg.EndTime,
+		TimeZone:           timeZone,
+	}, req.Msg.ReplacesHoldId, time.Now())
+	if err != nil {
+		if errors.Is(err, db.ErrHoldNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("no hold with this id for this contact"))
+		}
+		return nil, bookingError(err)
+	}
+
+	return connect.NewResponse(hold), nil
+}
+
+func (s *AppointmentServer) ConfirmHold(
+	ctx context.Context,
+	req *connect.Request[pb.ConfirmHoldRequest],
+) (*connect.Response[pb.Appointment], error) {
+	log.Printf("Incoming Request to confirm a hold: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.HoldId) != nil || uuid.Validate(req.Msg.UserId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("hold_id and user_id must be UUIDs"))
+	}
+	if err := validateInvitees(nil, req.Msg.Invitees); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	appt := &pb.Appointment{
+		Title:        req.Msg.Title,
+		Description:  req.Msg.Description,
+		Participants: inviteParticipants(req.Msg.Invitees),
+	}
+	if err := s.Storage.ConfirmHold(ctx, req.Msg.HoldId, req.Msg.UserId, appt, time.Now()); err != nil {
+		switch {
+		case errors.Is(err, db.ErrHoldNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, db.ErrHoldExpired):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, bookingError(err)
+	}
+
+	return connect.NewResponse(appt), nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/ical"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+const maxImportBytes = 10 << 20
+
+func (s *AppointmentServer) ImportCalendar(
+	ctx context.Context,
+	stream *connect.ClientStream[pb.ImportCalendarRequest],
+) (*connect.Response[pb.ImportCalendarResponse], error) {
+	var userId string
+	var dryRun bool
+	var data bytes.Buffer
+
+	for first := true; stream.Receive(); first = false {
+		msg := stream.Msg()
+		if first {
+			userId, dryRun = msg.UserId, msg.DryRun
+		}
+
+		if data.Len()+len(msg.Chunk) > maxImportBytes {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("calendar file exceeds %d bytes", maxImportBytes))
+		}
+		data.Write(msg.Chunk)
+	}
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Incoming Request to import a calendar for user %s (%d bytes, dry run: %t)", userId, data.Len(), dryRun)
+
+	if userId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user ID not supplied"))
+	}
+
+	user, err := s.Storage.GetUser(ctx, userId)
+	if err != nil {
+		if errors.Is(err, db.ErrUserNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, err
+	}
+
+	events, err := ical.Decode(&data)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Results are reported in file order, so rejected events keep their
+	// place among the ones handed to the database.
+	results := make([]*pb.ImportEventResult, len(events))
+	var candidates []*db.ImportCandidate
+	var positions []int
+	seen := make(map[string]bool)
+
+	for i, ev := range events {
+		if ev.Err == nil && seen[ev.UID] {
+			results[i] = &pb.ImportEventResult{
+				Uid:     ev.UID,
+				Summary: ev.Summary,
+				Status:  pb.ImportEventStatus_IMPORT_EVENT_STATUS_DUPLICATE,
+				Message: "UID appears more than once in the file",
+			}
+			continue
+		}
+
+		reason := importRejection(ev)
+		if reason != "" {
+			results[i] = &pb.ImportEventResult{
+				Uid:     ev.UID,
+				Summary: ev.Summary,
+				Status:  pb.ImportEventStatus_IMPORT_EVENT_STATUS_INVALID,
+				Message: reason,
+			}
+			continue
+		}
+		seen[ev.UID] = true
+
+		appt := calendarAppointment(ev.Event)
+		appt.Id = uuid.NewString()
+		appt.UserId = user.Id
+		appt.ContactInformation = &pb.ContactInformation{
+			Name:  user.Name,
+			Email: user.Email,
+		}
+		candidates = append(candidates, &db.ImportCandidate{
+			UID:         ev.UID,
+			Appointment: appt,
+		})
+		positions = append(positions, i)
+	}
+
+	imported, err := s.Storage.ImportAppointments(ctx, candidates, dryRun)
+	if err != nil {
+		log.Printf("Error importing calendar: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to import calendar"))
+	}
+	for i, result := range imported {
+		results[positions[i]] = result
+	}
+
+	res := &pb.ImportCalendarResponse{
+		Results: results,
+		DryRun:  dryRun,
+	}
+	for _, r := range results {
+		switch r.Status {
+		case pb.ImportEventStatus_IMPORT_EVENT_STATUS_IMPORTED:
+			res.ImportedCount++
+		case pb.ImportEventStatus_IMPORT_EVENT_STATUS_DUPLICATE:
+			res.DuplicateCount++
+		default:
+			res.RejectedCount++
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func importRejection(ev ical.ParsedEvent) string {
+	switch {
+	case ev.Err != nil:
+		return ev.Err.Error()
+	case ev.Recurring:
+		return "recurring events are not supported"
+	case ev.Status == ical.StatusCancelled:
+		return "event is cancelled"
+	case ev.Summary == "":
+		return "missing SUMMARY"
+	}
+	return ""
+}
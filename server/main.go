@@ -9,6 +9,12 @@ import (
 	"time"
 
 	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/holds"
+	"github.com/folucode/appointment-scheduler/internal/ratelimit"
+	"github.com/folucode/appointment-scheduler/internal/reminders"
+	"github.com/folucode/appointment-scheduler/internal/timezone"
+	"github.com/folucode/appointment-scheduler/internal/waitlist"
+	"github.com/folucode/appointment-scheduler/internal/webhook"
 	pb "github.com/folucode/appointment-scheduler/proto"
 	protoconnect "github.com/folucode/appointment-scheduler/proto/protoconnect"
 	"github.com/joho/godotenv"
@@ -27,7 +33,8 @@ type AppointmentServer struct {
 
 type UserServer struct {
 	protoconnect.UnimplementedUserServiceHandler
-	Storage *db.Database
+	Storage   *db.Database
+	PublicURL string
 }
 
 func (s *AppointmentServer) CreateAppointment(
@@ -36,20 +43,39 @@ func (s *AppointmentServer) CreateAppointment(
 ) (*connect.Response[pb.Appointment], error) {
 	log.Printf("Incoming Request to create an appointment: %+v", req.Msg)
 
-	now := time.Now()
-	todayMidnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch {
+	case req.Msg.AppointmentTypeId != "":
+		if uuid.Validate(req.Msg.AppointmentTypeId) != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("appointment_type_id must be a UUID"))
+		}
+		if req.Msg.StartTime == nil || req.Msg.EndTime != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("an appointment type needs start_time and sets end_time itself"))
+		}
+	case req.Msg.StartTime == nil || req.Msg.EndTime == nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start_time and end_time are required"))
+	}
+	if req.Msg.Capacity < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("capacity must not be negative"))
+	}
+	if err := validateInvitees(req.Msg.ContactInformation, req.Msg.Invitees); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
-	requestDate := req.Msg.Date.AsTime()
-	requestDateMidnight := time.Date(requestDate.Year(), requestDate.Month(), requestDate.Day(), 0, 0, 0, 0, requestDate.Location())
+	date := req.Msg.Date
+	if date == nil {
+		date = req.Msg.StartTime
+	}
 
-	if requestDateMidnight.Before(todayMidnight) {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("date cannot be in the past"))
+	timeZone, err := s.bookingTimeZone(ctx, req.Msg.TimeZone, req.Msg.ContactInformation)
+	if err != nil {
+		return nil, err
 	}
 
 	user, err := s.Storage.CreateUser(ctx, &pb.User{
-		Id:    uuid.NewString(),
-		Name:  req.Msg.ContactInformation.Name,
-		Email: req.Msg.ContactInformation.Email,
+		Id:       uuid.NewString(),
+		Name:     req.Msg.ContactInformation.Name,
+		Email:    req.Msg.ContactInformation.Email,
+		TimeZone: timeZone,
 	})
 
 	if err != nil {
@@ -60,27 +86,92 @@ func (s *AppointmentServer) CreateAppointment(
 	newAppt := &pb.Appointment{
 		Id:          uuid.NewString(),
 		Title:       req.Msg.Title,
-		Date:        req.Msg.Date,
+		Date:        date,
 		Description: req.Msg.Description,
 		UserId:      user.Id,
 		ContactInformation: &pb.ContactInformation{
 			Name:  req.Msg.ContactInformation.Name,
 			Email: req.Msg.ContactInformation.Email,
 		},
-		StartTime: req.Msg.StartTime,
-		EndTime:   req.Msg.EndTime,
+		StartTime:         req.Msg.StartTime,
+		EndTime:           req.Msg.EndTime,
+		TimeZone:          timeZone,
+		AppointmentTypeId: req.Msg.AppointmentTypeId,
+		Capacity:          req.Msg.Capacity,
+		Participants:      inviteParticipants(req.Msg.Invitees),
 	}
 
-	err = s.Storage.CreateAppointment(ctx, newAppt)
+	err = s.Storage.BookAppointment(ctx, newAppt, time.Now())
 
 	if err != nil {
-		log.Printf("Error saving to database: %v", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, bookingError(err)
 	}
 
 	return connect.NewResponse(newAppt), nil
 }
 
+// bookingError maps the errors of booking an appointment to their codes,
+// attaching the broken rules of a booking policy violation.
+func bookingError(err error) error {
+	var violation *db.BookingPolicyError
+	if errors.As(err, &violation) {
+		connectErr := connect.NewError(connect.CodeFailedPrecondition, err)
+		if detail, detailErr := connect.NewErrorDetail(&pb.BookingPolicyViolations{Violations: violation.Violations}); detailErr == nil {
+			connectErr.AddDetail(detail)
+		}
+		return connectErr
+	}
+	switch {
+	case errors.Is(err, db.ErrAppointmentTypeNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, db.ErrAppointmentTypeInactive):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, db.ErrAppointmentConflict):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	}
+	log.Printf("Error saving to database: %v", err)
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// bookingTimeZone returns the zone a booking is made in, in which the booking
+// policy judges its days: the one requested, else the saved zone of the user
+// booking, else the schedule's.
+func (s *AppointmentServer) bookingTimeZone(ctx context.Context, requested string, contact *pb.ContactInformation) (string, error) {
+	name := requested
+	if name == "" {
+		name = s.defaultTimeZone(ctx, contact.GetEmail())
+	}
+
+	if _, err := timezone.Load(name); err != nil {
+		if requested != "" {
+			return "", connect.NewError(connect.CodeInvalidArgument, errors.New("time_zone must be an IANA time zone"))
+		}
+		log.Printf("Error loading time zone %q, using %s: %v", name, timezone.Default, err)
+		name = timezone.Default
+	}
+
+	return name, nil
+}
+
+// defaultTimeZone looks up the saved zone for email, falling back to the
+// schedule's and then to UTC.
+func (s *AppointmentServer) defaultTimeZone(ctx context.Context, email string) string {
+	user, err := s.Storage.FindUserByEmail(ctx, email)
+	if err == nil && user.TimeZone != "" {
+		return user.TimeZone
+	}
+	if err != nil && !errors.Is(err, db.ErrUserNotFound) {
+		log.Printf("Error looking up user time zone: %v", err)
+	}
+
+	schedule, err := s.Storage.GetSchedule(ctx)
+	if err != nil {
+		log.Printf("Error loading schedule time zone: %v", err)
+		return timezone.Default
+	}
+	return schedule.TimeZone
+}
+
 func (s *AppointmentServer) GetUserAppointments(
 	ctx context.Context,
 	req *connect.Request[pb.GetUserAppointmentRequest],
@@ -93,13 +184,34 @@ func (s *AppointmentServer) GetUserAppointments(
 		}), errors.New("user ID not supplied")
 	}
 
-	data, err := s.Storage.GetAppointments(ctx, req.Msg.UserId)
+	filter := db.AppointmentFilter{
+		Scope:      req.Msg.Scope,
+		Descending: req.Msg.SortDirection == pb.SortDirection_SORT_DIRECTION_DESCENDING,
+		PageSize:   int(req.Msg.PageSize),
+		PageToken:  req.Msg.PageToken,
+	}
+	if req.Msg.From != nil {
+		filter.From = req.Msg.From.AsTime()
+	}
+	if req.Msg.To != nil {
+		filter.To = req.Msg.To.AsTime()
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("from must be before to"))
+	}
+
+	data, nextPageToken, err := s.Storage.GetAppointments(ctx, req.Msg.UserId, filter)
 	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 		return nil, err
 	}
 
 	res := connect.NewResponse(&pb.GetUserAppointmentResponse{
-		Appointments: data,
+		Appointments:  data,
+		NextPageToken: nextPageToken,
 	})
 
 	return res, nil
@@ -112,14 +224,109 @@ func (s *AppointmentServer) DeleteAppointment(ctx context.Context, req *connect.
 		return &connect.Response[pb.DeleteAppointmentResponse]{}, nil
 	}
 
-	success, err := s.Storage.DeleteAppointment(ctx, req.Msg.Id)
+	cancellation, err := newCancellation(req.Msg.Reason, req.Msg.Note, pb.CancelledBy_CANCELLED_BY_CLIENT)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
+	appt, err := s.Storage.DeleteAppointment(ctx, req.Msg.Id, cancellation, time.Now())
 	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrAppointmentNotFound):
+			return connect.NewResponse(&pb.DeleteAppointmentResponse{Success: false}), nil
+		case errors.Is(err, db.ErrCancellationCutoff):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
 		return nil, err
 	}
 
 	return connect.NewResponse(&pb.DeleteAppointmentResponse{
-		Success: success,
+		Success:     true,
+		Appointment: appt,
+	}), nil
+}
+
+func (s *AppointmentServer) ListDeletedAppointments(
+	ctx context.Context,
+	req *connect.Request[pb.ListDeletedAppointmentsRequest],
+) (*connect.Response[pb.ListDeletedAppointmentsResponse], error) {
+	log.Printf("Incoming Request to list deleted appointments: %+v", req.Msg)
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user ID not supplied"))
+	}
+
+	data, nextPageToken, err := s.Storage.ListDeletedAppointments(ctx, req.Msg.UserId, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		log.Printf("Error listing deleted appointments: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list deleted appointments"))
+	}
+
+	return connect.NewResponse(&pb.ListDeletedAppointmentsResponse{
+		Appointments:  data,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (s *AppointmentServer) RestoreAppointment(
+	ctx context.Context,
+	req *connect.Request[pb.RestoreAppointmentRequest],
+) (*connect.Response[pb.Appointment], error) {
+	log.Printf("Incoming Request to restore appointment: %+v", req.Msg)
+
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	appt, err := s.Storage.RestoreAppointment(ctx, req.Msg.Id)
+	if err != nil {
+		var conflict *db.AppointmentConflictError
+		switch {
+		case errors.Is(err, db.ErrAppointmentNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("no deleted appointment with this id"))
+		case errors.Is(err, db.ErrUserErased):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("appointments of an erased user cannot be restored"))
+		case errors.As(err, &conflict):
+			connectErr := connect.NewError(connect.CodeAlreadyExists, err)
+			if detail, detailErr := connect.NewErrorDetail(conflict.Blocking); detailErr == nil {
+				connectErr.AddDetail(detail)
+			}
+			return nil, connectErr
+		case errors.Is(err, db.ErrAppointmentConflict):
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		log.Printf("Error restoring appointment: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to restore appointment"))
+	}
+
+	return connect.NewResponse(appt), nil
+}
+
+func (s *AppointmentServer) SearchAppointments(
+	ctx context.Context,
+	req *connect.Request[pb.SearchAppointmentsRequest],
+) (*connect.Response[pb.SearchAppointmentsResponse], error) {
+	log.Printf("Incoming Request to search appointments: %+v", req.Msg)
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user ID not supplied"))
+	}
+
+	results, nextPageToken, err := s.Storage.SearchAppointments(ctx, req.Msg.UserId, req.Msg.Query, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		log.Printf("Error searching appointments: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to search appointments"))
+	}
+
+	return connect.NewResponse(&pb.SearchAppointmentsResponse{
+		Results:       results,
+		NextPageToken: nextPageToken,
 	}), nil
 }
 
@@ -146,11 +353,81 @@ func main() {
 		log.Fatalf("Could not connect to database: %v", err)
 	}
 
-	apptPath, apptHandler := protoconnect.NewAppointmentServiceHandler(&AppointmentServer{Storage: database})
-	userPath, userHandler := protoconnect.NewUserServiceHandler(&UserServer{Storage: database})
+	rateLimits := os.Getenv("RATE_LIMITS")
+	if rateLimits == "" {
+		rateLimits = "CreateAppointment=5/1m,HoldSlot=10/1m,JoinGroupSession=5/1m,JoinWaitlist=5/1m,RescheduleAppointment=10/1m,DeleteAppointment=20/1m,ImportCalendar=5/1m,CalDAV=120/1m"
+	}
+
+	limits, err := ratelimit.ParseLimits(rateLimits)
+	if err != nil {
+		log.Fatalf("Could not parse RATE_LIMITS: %v", err)
+	}
+
+	trustProxy := os.Getenv("TRUST_PROXY") == "true"
+	audit := connect.WithInterceptors(newAuditInterceptor("anonymous", trustProxy))
+	rateLimiter := ratelimit.NewInterceptor(ratelimit.NewLimiter(), ratelimit.Options{
+		Limits:     limits,
+		Denylist:   database,
+		TrustProxy: trustProxy,
+	})
+	limiter := connect.WithInterceptors(rateLimiter)
+
+	notifier, err := newNotifier()
+	if err != nil {
+		log.Fatalf("Could not configure notifications: %v", err)
+	}
+
+	reminderOffsets := os.Getenv("REMINDER_OFFSETS")
+	if reminderOffsets == "" {
+		reminderOffsets = "24h,1h"
+	}
+
+	database.ReminderOffsets, err = reminders.ParseOffsets(reminderOffsets)
+	if err != nil {
+		log.Fatalf("Could not parse REMINDER_OFFSETS: %v", err)
+	}
+
+	go reminders.NewWorker(database, notifier, reminders.Options{}).Run(context.Background())
+	go webhook.NewDispatcher(database, &http.Client{}, webhook.Options{}).Run(context.Background())
+	go newOutboxRelay(database, notifier).Run(context.Background())
+	go waitlist.NewSweeper(database, waitlist.Options{}).Run(context.Background())
+	go holds.NewSweeper(database, holds.Options{}).Run(context.Background())
+
+	purger, err := newPurger(database)
+	if err != nil {
+		log.Fatalf("Could not configure retention: %v", err)
+	}
+
+	go purger.Run(context.Background())
+
+	apptServer := &AppointmentServer{Storage: database}
+	apptPath, apptHandler := protoconnect.NewAppointmentServiceHandler(apptServer, limiter, audit)
+	publicURL := os.Getenv("PUBLIC_URL")
+	if publicURL == "" {
+		publicURL = "http://localhost:8080"
+	}
+
+	// Feed and CalDAV tokens grant access to a user's calendar, so until users
+	// can sign in only an admin may issue, list or revoke them.
+	adminToken := os.Getenv("ADMIN_TOKEN")
+	feedTokenAuth := connect.WithInterceptors(newAdminAuthInterceptor(adminToken,
+		protoconnect.UserServiceCreateFeedTokenProcedure,
+		protoconnect.UserServiceListFeedTokensProcedure,
+		protoconnect.UserServiceRevokeFeedTokenProcedure,
+	))
+
+	userPath, userHandler := protoconnect.NewUserServiceHandler(&UserServer{Storage: database, PublicURL: publicURL}, limiter, audit, feedTokenAuth)
+	adminPath, adminHandler := protoconnect.NewAdminServiceHandler(
+		&AdminServer{Storage: database, Purger: purger},
+		connect.WithInterceptors(newAdminAuthInterceptor(adminToken), newAuditInterceptor("admin", trustProxy)),
+	)
 
 	mux.Handle(apptPath, apptHandler)
 	mux.Handle(userPath, userHandler)
+	mux.Handle(adminPath, adminHandler)
+	mux.Handle("GET /calendar/{file}", calendarFeedHandler(database))
+	mux.Handle(caldavPrefix+"/", rateLimiter.Handler("CalDAV", withHTTPAudit(newCaldavHandler(apptServer), "caldav", trustProxy)))
+	mux.Handle("/.well-known/caldav", http.RedirectHandler(caldavPrefix+"/", http.StatusMovedPermanently))
 
 	c := cors.New(cors.Options{
 		AllowedOrigins: []string{"http://localhost:5173"},
@@ -159,8 +436,10 @@ func main() {
 			"Content-Type",
 			"Connect-Protocol-Version",
 			"Connect-Timeout-Ms",
+			"Authorization",
 		},
-		Debug: true,
+		ExposedHeaders: []string{"Retry-After"},
+		Debug:          true,
 	})
 
 	addr := ":8080"
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/mail"
+	"os"
+
+	"github.com/folucode/appointment-scheduler/internal/notify"
+)
+
+// newNotifier configures email notifications from the environment. Without
+// SMTP_ADDR messages are only logged.
+func newNotifier() (*notify.Notifier, error) {
+	templates, err := notify.LoadTemplates(os.Getenv("NOTIFY_TEMPLATE_DIR"))
+	if err != nil {
+		return nil, err
+	}
+
+	addr := os.Getenv("SMTP_ADDR")
+	if addr == "" {
+		log.Println("SMTP_ADDR not set, notifications will be logged instead of sent")
+		return notify.New(notify.LogSender{}, templates, notify.Options{}), nil
+	}
+
+	from, err := mail.ParseAddress(os.Getenv("MAIL_FROM"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAIL_FROM: %w", err)
+	}
+
+	sender := &notify.SMTPSender{
+		Addr:     addr,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     *from,
+	}
+
+	return notify.New(sender, templates, notify.Options{}), nil
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/notify"
+	"github.com/folucode/appointment-scheduler/internal/outbox"
+	"github.com/folucode/appointment-scheduler/internal/webhook"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var notificationKinds = map[string]notify.Kind{
+	db.EventAppointmentCreated:     notify.KindConfirmation,
+	db.EventAppointmentUpdated:     notify.KindUpdate,
+	db.EventAppointmentCancelled:   notify.KindCancellation,
+	db.EventAppointmentRestored:    notify.KindConfirmation,
+	db.EventAppointmentRescheduled: notify.KindUpdate,
+	db.EventWaitlistOffered:        notify.KindWaitlistOffer,
+}
+
+// notificationSink emails the contact of an appointment when it is booked,
+// changed, moved, cancelled or restored, and someone on the waitlist when a
+// slot is offered to them.
+type notificationSink struct {
+	notifier *notify.Notifier
+}
+
+func (s *notificationSink) Name() string { return "notifications" }
+
+func (s *notificationSink) Publish(ctx context.Context, event *db.OutboxEvent) error {
+	kind, ok := notificationKinds[event.Type]
+	if !ok {
+		return nil
+	}
+
+	var appt pb.Appointment
+	var err error
+	if event.Type == db.EventWaitlistOffered {
+		err = unmarshalWaitlistOffer(event.Payload, &appt)
+	} else {
+		err = protojson.Unmarshal(event.Payload, &appt)
+	}
+	if err != nil {
+		log.Printf("Skipping notification for malformed event %s: %v", event.Id, err)
+		return nil
+	}
+
+	err = s.notifier.Send(ctx, kind, &appt)
+	if err != nil && notify.Permanent(err) {
+		log.Printf("Giving up on %s notification for appointment %s: %v", kind, appt.Id, err)
+		return nil
+	}
+
+	return err
+}
+
+// unmarshalWaitlistOffer reads the waitlist entry in payload into appt as
+// the appointment it is being offered, identified by the offer's id.
+func unmarshalWaitlistOffer(payload []byte, appt *pb.Appointment) error {
+	var entry pb.WaitlistEntry
+	if err := protojson.Unmarshal(payload, &entry); err != nil {
+		return err
+	}
+	if entry.Offer == nil {
+		return errors.New("waitlist entry has no offer")
+	}
+
+	appt.Id = entry.Offer.Id
+	appt.UserId = entry.UserId
+	appt.Title = entry.Title
+	appt.Description = entry.Description
+	appt.ContactInformation = entry.ContactInformation
+	appt.StartTime = entry.Offer.StartTime
+	appt.EndTime = entry.Offer.EndTime
+	appt.Date = entry.Offer.StartTime
+	appt.TimeZone = entry.TimeZone
+	return nil
+}
+
+// webhookSink queues appointment events for the webhooks subscribed to them.
+// Deliveries reuse the outbox event id, so a receiver can tell a replayed
+// event from a new one.
+type webhookSink struct {
+	storage *db.Database
+}
+
+func (s *webhookSink) Name() string { return "webhooks" }
+
+func (s *webhookSink) Publish(ctx context.Context, event *db.OutboxEvent) error {
+	if event.AggregateType != "appointment" {
+		return nil
+	}
+
+	var appt pb.Appointment
+	if err := protojson.Unmarshal(event.Payload, &appt); err != nil {
+		log.Printf("Skipping webhooks for malformed event %s: %v", event.Id, err)
+		return nil
+	}
+
+	payload, err := webhook.Payload(event.Id, event.Type, &appt, event.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.storage.EnqueueWebhookEvent(ctx, event.Id, event.Type, payload)
+	return err
+}
+
+func newOutboxRelay(database *db.Database, notifier *notify.Notifier) *outbox.Relay {
+	sinks := []outbox.Sink{
+		&notificationSink{notifier: notifier},
+		&webhookSink{storage: database},
+	}
+
+	return outbox.NewRelay(database, sinks, outbox.Options{})
+}
+
+func outboxEventProto(e *db.OutboxEvent) *pb.OutboxEvent {
+	return &pb.OutboxEvent{
+		Id:            e.Id,
+		Sequence:      e.Sequence,
+		AggregateType: e.AggregateType,
+		AggregateId:   e.AggregateId,
+		EventType:     e.Type,
+		Payload:       string(e.Payload),
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+	}
+}
+
+func outboxError(err error, message string) error {
+	switch {
+	case errors.Is(err, db.ErrOutboxSinkNotFound), errors.Is(err, db.ErrOutboxEventNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, db.ErrOutboxEventNotNext):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, db.ErrInvalidPageToken):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	log.Printf("Error: %s: %v", message, err)
+	return connect.NewError(connect.CodeInternal, errors.New(message))
+}
+
+func (s *AdminServer) ListOutboxSinks(
+	ctx context.Context,
+	req *connect.Request[pb.ListOutboxSinksRequest],
+) (*connect.Response[pb.ListOutboxSinksResponse], error) {
+	log.Printf("Incoming Request to list outbox sinks")
+
+	sinks, err := s.Storage.ListOutboxSinks(ctx)
+	if err != nil {
+		return nil, outboxError(err, "failed to list outbox sinks")
+	}
+
+	return connect.NewResponse(&pb.ListOutboxSinksResponse{
+		Sinks: sinks,
+	}), nil
+}
+
+func (s *AdminServer) ListOutboxEvents(
+	ctx context.Context,
+	req *connect.Request[pb.ListOutboxEventsRequest],
+) (*connect.Response[pb.ListOutboxEventsResponse], error) {
+	log.Printf("Incoming Request to list outbox events: %+v", req.Msg)
+
+	events, nextPageToken, err := s.Storage.ListOutboxEvents(ctx, req.Msg.Sink, req.Msg.AggregateId, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, outboxError(err, "failed to list outbox events")
+	}
+
+	res := &pb.ListOutboxEventsResponse{
+		Events:        make([]*pb.OutboxEvent, 0, len(events)),
+		NextPageToken: nextPageToken,
+	}
+	for _, e := range events {
+		res.Events = append(res.Events, outboxEventProto(e))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *AdminServer) ReplayOutboxEvents(
+	ctx context.Context,
+	req *connect.Request[pb.ReplayOutboxEventsRequest],
+) (*connect.Response[pb.OutboxSink], error) {
+	log.Printf("Incoming Request to replay outbox events: %+v", req.Msg)
+
+	if req.Msg.Sink == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("sink is required"))
+	}
+
+	sink, err := s.Storage.ReplayOutboxEvents(ctx, req.Msg.Sink, req.Msg.FromEventId)
+	if err != nil {
+		return nil, outboxError(err, "failed to replay outbox events")
+	}
+
+	return connect.NewResponse(sink), nil
+}
+
+func (s *AdminServer) SkipOutboxEvent(
+	ctx context.Context,
+	req *connect.Request[pb.SkipOutboxEventRequest],
+) (*connect.Response[pb.OutboxSink], error) {
+	log.Printf("Incoming Request to skip outbox event: %+v", req.Msg)
+
+	if req.Msg.Sink == "" || req.Msg.EventId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("sink and event_id are required"))
+	}
+
+	sink, err := s.Storage.SkipOutboxEvent(ctx, req.Msg.Sink, req.Msg.EventId)
+	if err != nil {
+		return nil, outboxError(err, "failed to skip outbox event")
+	}
+
+	return connect.NewResponse(sink), nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+const maxInvitees = 50
+
+// validateInvitees checks that every invitee has a name and an email, is
+// either required or optional, and is invited once, separately from the
+// organizer.
+func validateInvitees(organizer *pb.ContactInformation, invitees []*pb.Invitee) error {
+	if len(invitees) > maxInvitees {
+		return fmt.Errorf("at most %d people can be invited", maxInvitees)
+	}
+
+	seen := map[string]bool{strings.ToLower(strings.TrimSpace(organizer.GetEmail())): true}
+	for _, invitee := range invitees {
+		contact := invitee.ContactInformation
+		if contact.GetName() == "" || contact.GetEmail() == "" {
+			return errors.New("every invitee needs a name and an email")
+		}
+		if invitee.Role == pb.ParticipantRole_PARTICIPANT_ROLE_ORGANIZER {
+			return errors.New("invitees must be required or optional")
+		}
+
+		email := strings.ToLower(strings.TrimSpace(contact.Email))
+		if seen[email] {
+			return fmt.Errorf("%s is invited more than once", contact.Email)
+		}
+		seen[email] = true
+	}
+
+	return nil
+}
+
+// inviteParticipants turns invitees into participants known by name and
+// email. Booking resolves them to users, creating any that do not exist yet.
+func inviteParticipants(invitees []*pb.Invitee) []*pb.Participant {
+	participants := make([]*pb.Participant, 0, len(invitees))
+	for _, invitee := range invitees {
+		role := invitee.Role
+		if role == pb.ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED {
+			role = pb.ParticipantRole_PARTICIPANT_ROLE_REQUIRED
+		}
+		participants = append(participants, &pb.Participant{
+			Name:  invitee.ContactInformation.Name,
+			Email: invitee.ContactInformation.Email,
+			Role:  role,
+		})
+	}
+
+	return participants
+}
+
+func (s *AppointmentServer) UpdateRsvpStatus(
+	ctx context.Context,
+	req *connect.Request[pb.UpdateRsvpStatusRequest],
+) (*connect.Response[pb.Participant], error) {
+	log.Printf("Incoming Request to update RSVP status: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.AppointmentId) != nil || uuid.Validate(req.Msg.UserId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("appointment_id and user_id must be UUIDs"))
+	}
+	if req.Msg.RsvpStatus == pb.RsvpStatus_RSVP_STATUS_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("rsvp_status is required"))
+	}
+
+	participant, err := s.Storage.UpdateRsvpStatus(ctx, req.Msg.AppointmentId, req.Msg.UserId, req.Msg.RsvpStatus)
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrAppointmentNotFound), errors.Is(err, db.ErrParticipantNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, db.ErrOrganizerRsvp):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, db.ErrAppointmentConflict):
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		log.Printf("Error updating RSVP status: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update RSVP status"))
+	}
+
+	return connect.NewResponse(participant), nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var exportJSON = protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}
+
+func (s *AdminServer) ExportUserData(
+	ctx context.Context,
+	req *connect.Request[pb.ExportUserDataRequest],
+) (*connect.Response[pb.ExportUserDataResponse], error) {
+	log.Printf("Incoming Request to export user data: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.UserId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id must be a UUID"))
+	}
+
+	export, err := s.Storage.ExportUserData(ctx, req.Msg.UserId)
+	if err != nil {
+		if errors.Is(err, db.ErrUserNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		log.Printf("Error exporting user data: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to export user data"))
+	}
+
+	bundle := &pb.UserDataExport{
+		User:         export.User,
+		CreatedAt:    timestamppb.New(export.CreatedAt),
+		Appointments: export.Appointments,
+		ExportedAt:   timestamppb.Now(),
+	}
+	if export.ErasedAt != nil {
+		bundle.ErasedAt = timestamppb.New(*export.ErasedAt)
+	}
+
+	res, err := encodeUserDataExport(bundle, req.Msg.Format)
+	if err != nil {
+		log.Printf("Error encoding user data export: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to export user data"))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// encodeUserDataExport renders bundle as a single JSON document, or as a ZIP
+// archive holding the user and their appointments as separate files.
+func encodeUserDataExport(bundle *pb.UserDataExport, format pb.ExportFormat) (*pb.ExportUserDataResponse, error) {
+	name := fmt.Sprintf("user-%s-%s", bundle.User.Id, bundle.ExportedAt.AsTime().Format("20060102T150405Z"))
+
+	if format != pb.ExportFormat_EXPORT_FORMAT_ZIP {
+		data, err := exportJSON.Marshal(bundle)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ExportUserDataResponse{Data: data, ContentType: "application/json", Filename: name + ".json"}, nil
+	}
+
+	appointments := &pb.UserDataExport{Appointments: bundle.Appointments}
+	user := proto.Clone(bundle).(*pb.UserDataExport)
+	user.Appointments = nil
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	modified := bundle.ExportedAt.AsTime()
+
+	for _, file := range []struct {
+		name string
+		msg  proto.Message
+	}{
+		{"user.json", user},
+		{"appointments.json", appointments},
+	} {
+		data, err := exportJSON.Marshal(file.msg)
+		if err != nil {
+			return nil, err
+		}
+
+		w, err := archive.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportUserDataResponse{Data: buf.Bytes(), ContentType: "application/zip", Filename: name + ".zip"}, nil
+}
+
+func (s *AdminServer) EraseUser(
+	ctx context.Context,
+	req *connect.Request[pb.EraseUserRequest],
+) (*connect.Response[pb.EraseUserResponse], error) {
+	log.Printf("Incoming Request to erase user: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.UserId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id must be a UUID"))
+	}
+
+	erasedAt, err := s.Storage.EraseUser(ctx, req.Msg.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrUserNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, db.ErrUserOnLegalHold):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		log.Printf("Error erasing user: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to erase user"))
+	}
+
+	return connect.NewResponse(&pb.EraseUserResponse{
+		ErasedAt: timestamppb.New(erasedAt),
+	}), nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+func (s *AppointmentServer) RescheduleAppointment(
+	ctx context.Context,
+	req *connect.Request[pb.RescheduleAppointmentRequest],
+) (*connect.Response[pb.Appointment], error) {
+	log.Printf("Incoming Request to reschedule appointment: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.Id) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id must be a UUID"))
+	}
+	if req.Msg.StartTime == nil || req.Msg.EndTime == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start_time and end_time are required"))
+	}
+	if !req.Msg.StartTime.AsTime().Before(req.Msg.EndTime.AsTime()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start_time must be before end_time"))
+	}
+
+	appt, err := s.Storage.RescheduleAppointment(ctx, req.Msg.Id, req.Msg.StartTime.AsTime(), req.Msg.EndTime.AsTime(),
+		req.Msg.Reason, time.Now())
+	if err != nil {
+		var conflict *db.AppointmentConflictError
+		switch {
+		case errors.Is(err, db.ErrAppointmentNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("no active appointment with this id"))
+		case errors.As(err, &conflict):
+			connectErr := connect.NewError(connect.CodeAlreadyExists, err)
+			if detail, detailErr := connect.NewErrorDetail(conflict.Blocking); detailErr == nil {
+				connectErr.AddDetail(detail)
+			}
+			return nil, connectErr
+		}
+		return nil, bookingError(err)
+	}
+
+	return connect.NewResponse(appt), nil
+}
+
+func (s *AppointmentServer) ListReschedules(
+	ctx context.Context,
+	req *connect.Request[pb.ListReschedulesRequest],
+) (*connect.Response[pb.ListReschedulesResponse], error) {
+	log.Printf("Incoming Request to list reschedules: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.AppointmentId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("appointment_id must be a UUID"))
+	}
+
+	reschedules, err := s.Storage.ListReschedules(ctx, req.Msg.AppointmentId)
+	if err != nil {
+		if errors.Is(err, db.ErrAppointmentNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		log.Printf("Error listing reschedules: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list reschedules"))
+	}
+
+	return connect.NewResponse(&pb.ListReschedulesResponse{Reschedules: reschedules}), nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/retention"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AdminServer) PurgeDeletedAppointments(
+	ctx context.Context,
+	req *connect.Request[pb.PurgeDeletedAppointmentsRequest],
+) (*connect.Response[pb.PurgeDeletedAppointmentsResponse], error) {
+	log.Printf("Incoming Request to purge deleted appointments: %+v", req.Msg)
+
+	policy := s.Purger.Policy()
+	if req.Msg.OlderThanDays < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("older_than_days cannot be negative"))
+	}
+	if req.Msg.OlderThanDays > 0 {
+		policy.After = time.Duration(req.Msg.OlderThanDays) * 24 * time.Hour
+	}
+	if policy.After <= 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("no retention period is configured, so older_than_days is required"))
+	}
+
+	switch req.Msg.Mode {
+	case pb.PurgeMode_PURGE_MODE_DELETE:
+		policy.Archive = false
+	case pb.PurgeMode_PURGE_MODE_ARCHIVE:
+		policy.Archive = true
+	}
+
+	purge := s.Purger.Purge
+	if req.Msg.DryRun {
+		purge = s.Purger.DryRun
+	}
+
+	result, err := purge(ctx, policy)
+	if err != nil {
+		log.Printf("Error purging deleted appointments: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to purge deleted appointments"))
+	}
+
+	mode := pb.PurgeMode_PURGE_MODE_DELETE
+	if policy.Archive {
+		mode = pb.PurgeMode_PURGE_MODE_ARCHIVE
+	}
+
+	return connect.NewResponse(&pb.PurgeDeletedAppointmentsResponse{
+		Cutoff:      timestamppb.New(result.Cutoff),
+		Mode:        mode,
+		PurgedCount: int32(result.Purged),
+		HeldCount:   int32(result.Held),
+		DryRun:      req.Msg.DryRun,
+	}), nil
+}
+
+func (s *AdminServer) SetLegalHold(
+	ctx context.Context,
+	req *connect.Request[pb.SetLegalHoldRequest],
+) (*connect.Response[pb.LegalHold], error) {
+	log.Printf("Incoming Request to set legal hold: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.EntityId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("entity_id must be a UUID"))
+	}
+
+	err := s.Storage.SetLegalHold(ctx, req.Msg.EntityType, req.Msg.EntityId, req.Msg.Hold)
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrInvalidLegalHold):
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("entity_type must be user or appointment"))
+		case errors.Is(err, db.ErrUserNotFound), errors.Is(err, db.ErrAppointmentNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		log.Printf("Error setting legal hold: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to set legal hold"))
+	}
+
+	return connect.NewResponse(&pb.LegalHold{
+		EntityType: req.Msg.EntityType,
+		EntityId:   req.Msg.EntityId,
+		Hold:       req.Msg.Hold,
+	}), nil
+}
+
+func (s *AdminServer) ListLegalHolds(
+	ctx context.Context,
+	req *connect.Request[pb.ListLegalHoldsRequest],
+) (*connect.Response[pb.ListLegalHoldsResponse], error) {
+	log.Printf("Incoming Request to list legal holds")
+
+	holds, err := s.Storage.ListLegalHolds(ctx)
+	if err != nil {
+		log.Printf("Error listing legal holds: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list legal holds"))
+	}
+
+	return connect.NewResponse(&pb.ListLegalHoldsResponse{
+		Holds: holds,
+	}), nil
+}
+
+func newPurger(database *db.Database) (*retention.Purger, error) {
+	policy, err := retention.ParsePolicy(os.Getenv("RETENTION_DAYS"), os.Getenv("RETENTION_MODE"))
+	if err != nil {
+		return nil, err
+	}
+
+	return retention.NewPurger(database, policy, retention.Options{}), nil
+}
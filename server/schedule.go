@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/policy"
+	"github.com/folucode/appointment-scheduler/internal/timezone"
+	pb "github.com/folucode/appointment-scheduler/proto"
+)
+
+func (s *AdminServer) GetSchedule(
+	ctx context.Context,
+	req *connect.Request[pb.GetScheduleRequest],
+) (*connect.Response[pb.Schedule], error) {
+	log.Printf("Incoming Request to get the schedule: %+v", req.Msg)
+
+	schedule, err := s.Storage.GetSchedule(ctx)
+	if err != nil {
+		log.Printf("Error loading schedule: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to load schedule"))
+	}
+
+	return connect.NewResponse(schedule), nil
+}
+
+func (s *AdminServer) UpdateSchedule(
+	ctx context.Context,
+	req *connect.Request[pb.UpdateScheduleRequest],
+) (*connect.Response[pb.Schedule], error) {
+	log.Printf("Incoming Request to update the schedule: %+v", req.Msg)
+
+	if req.Msg.Schedule == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("schedule is required"))
+	}
+
+	paths := req.Msg.UpdateMask.GetPaths()
+	for _, path := range paths {
+		switch {
+		case path == "time_zone":
+			if _, err := timezone.Load(req.Msg.Schedule.TimeZone); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("time_zone must be an IANA time zone"))
+			}
+		case path == "buffer_before" || path == "buffer_after":
+			for _, buffer := range []time.Duration{req.Msg.Schedule.BufferBefore.AsDuration(), req.Msg.Schedule.BufferAfter.AsDuration()} {
+				if buffer < 0 || buffer%time.Second != 0 {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("buffers must be a non-negative whole number of seconds"))
+				}
+			}
+		case path == "waitlist_offer_ttl":
+			if ttl := req.Msg.Schedule.WaitlistOfferTtl.AsDuration(); ttl <= 0 || ttl%time.Second != 0 {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("waitlist_offer_ttl must be a positive whole number of seconds"))
+			}
+		case path == "hold_ttl":
+			if ttl := req.Msg.Schedule.HoldTtl.AsDuration(); ttl <= 0 || ttl%time.Second != 0 {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("hold_ttl must be a positive whole number of seconds"))
+			}
+		case path == "cancellation_cutoff":
+			if cutoff := req.Msg.Schedule.CancellationCutoff.AsDuration(); cutoff < 0 || cutoff%time.Second != 0 {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cancellation_cutoff must be a non-negative whole number of seconds"))
+			}
+		case path == "late_cancellation_window":
+			if window := req.Msg.Schedule.LateCancellationWindow.AsDuration(); window < 0 || window%time.Second != 0 {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("late_cancellation_window must be a non-negative whole number of seconds"))
+			}
+		case path == "policy" || strings.HasPrefix(path, "policy."):
+			if err := policy.FromProto(req.Msg.Schedule.Policy).Validate(); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+		}
+	}
+
+	schedule, err := s.Storage.UpdateSchedule(ctx, req.Msg.Schedule, paths)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidUpdateMask) || errors.Is(err, db.ErrInvalidBookingPolicy) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		log.Printf("Error updating schedule: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update schedule"))
+	}
+
+	return connect.NewResponse(schedule), nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+// groupSessionError maps the errors of seating attendees to their codes.
+func groupSessionError(err error, action string) error {
+	switch {
+	case errors.Is(err, db.ErrAppointmentNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, db.ErrNotGroupSession),
+		errors.Is(err, db.ErrSessionStarted),
+		errors.Is(err, db.ErrSessionFull):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, db.ErrAlreadyAttending):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, db.ErrNotAttending):
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	log.Printf("Error trying to %s: %v", action, err)
+	return connect.NewError(connect.CodeInternal, errors.New("failed to "+action))
+}
+
+func (s *AppointmentServer) ListGroupSessions(
+	ctx context.Context,
+	req *connect.Request[pb.ListGroupSessionsRequest],
+) (*connect.Response[pb.ListGroupSessionsResponse], error) {
+	log.Printf("Incoming Request to list group sessions: %+v", req.Msg)
+
+	filter := db.GroupSessionFilter{
+		From:          time.Now(),
+		AvailableOnly: req.Msg.AvailableOnly,
+		PageSize:      int(req.Msg.PageSize),
+		PageToken:     req.Msg.PageToken,
+	}
+	if req.Msg.From != nil {
+		filter.From = req.Msg.From.AsTime()
+	}
+	if req.Msg.To != nil {
+		filter.To = req.Msg.To.AsTime()
+		if !filter.From.Before(filter.To) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("from must be before to"))
+		}
+	}
+
+	sessions, nextPageToken, err := s.Storage.ListGroupSessions(ctx, filter)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		log.Printf("Error listing group sessions: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list group sessions"))
+	}
+
+	return connect.NewResponse(&pb.ListGroupSessionsResponse{
+		Sessions:      sessions,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (s *AppointmentServer) JoinGroupSession(
+	ctx context.Context,
+	req *connect.Request[pb.JoinGroupSessionRequest],
+) (*connect.Response[pb.JoinGroupSessionResponse], error) {
+	log.Printf("Incoming Request to join group session: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.AppointmentId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("appointment_id must be a UUID"))
+	}
+	contact := req.Msg.ContactInformation
+	if contact.GetName() == "" || contact.GetEmail() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("contact_information needs a name and an email"))
+	}
+
+	user, err := s.Storage.CreateUser(ctx, &pb.User{
+		Id:       uuid.NewString(),
+		Name:     contact.Name,
+		Email:    contact.Email,
+		TimeZone: s.defaultTimeZone(ctx, contact.Email),
+	})
+	if err != nil {
+		log.Printf("Error creating user: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to process user information"))
+	}
+
+	session, attendee, err := s.Storage.JoinGroupSession(ctx, req.Msg.AppointmentId, user.Id, time.Now())
+	if err != nil {
+		return nil, groupSessionError(err, "join group session")
+	}
+
+	return connect.NewResponse(&pb.JoinGroupSessionResponse{
+		Session:  session,
+		Attendee: attendee,
+	}), nil
+}
+
+func (s *AppointmentServer) LeaveGroupSession(
+	ctx context.Context,
+	req *connect.Request[pb.LeaveGroupSessionRequest],
+) (*connect.Response[pb.GroupSession], error) {
+	log.Printf("Incoming Request to leave group session: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.AppointmentId) != nil || uuid.Validate(req.Msg.UserId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("appointment_id and user_id must be UUIDs"))
+	}
+
+	session, err := s.Storage.LeaveGroupSession(ctx, req.Msg.AppointmentId, req.Msg.UserId)
+	if err != nil {
+		return nil, groupSessionError(err, "leave group session")
+	}
+
+	return connect.NewResponse(session), nil
+}
+
+func (s *AppointmentServer) GetGroupSessionRoster(
+	ctx context.Context,
+	req *connect.Request[pb.GetGroupSessionRosterRequest],
+) (*connect.Response[pb.GetGroupSessionRosterResponse], error) {
+	log.Printf("Incoming Request to get group session roster: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.AppointmentId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("appointment_id must be a UUID"))
+	}
+
+	session, attendees, err := s.Storage.GetGroupSessionRoster(ctx, req.Msg.AppointmentId)
+	if err != nil {
+		return nil, groupSessionError(err, "load group session roster")
+	}
+
+	return connect.NewResponse(&pb.GetGroupSessionRosterResponse{
+		Session:   session,
+		Attendees: attendees,
+	}), nil
+}
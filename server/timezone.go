@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/timezone"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+func (s *UserServer) SetUserTimeZone(
+	ctx context.Context,
+	req *connect.Request[pb.SetUserTimeZoneRequest],
+) (*connect.Response[pb.User], error) {
+	log.Printf("Incoming Request to set user time zone: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.UserId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id must be a UUID"))
+	}
+	if _, err := timezone.Load(req.Msg.TimeZone); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("time_zone must be an IANA time zone"))
+	}
+
+	user, err := s.Storage.SetUserTimeZone(ctx, req.Msg.UserId, req.Msg.TimeZone)
+	if err != nil {
+		if errors.Is(err, db.ErrUserNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		log.Printf("Error setting user time zone: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to set time zone"))
+	}
+
+	return connect.NewResponse(user), nil
+}
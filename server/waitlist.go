@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+// waitlistError maps the errors of managing a waitlist entry to their codes.
+func waitlistError(err error, action string) error {
+	switch {
+	case errors.Is(err, db.ErrWaitlistEntryNotFound), errors.Is(err, db.ErrWaitlistOfferNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, db.ErrWaitlistEntryClosed),
+		errors.Is(err, db.ErrWaitlistOfferClosed),
+		errors.Is(err, db.ErrWaitlistOfferExpired):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	log.Printf("Error trying to %s: %v", action, err)
+	return connect.NewError(connect.CodeInternal, errors.New("failed to "+action))
+}
+
+func (s *AppointmentServer) JoinWaitlist(
+	ctx context.Context,
+	req *connect.Request[pb.JoinWaitlistRequest],
+) (*connect.Response[pb.WaitlistEntry], error) {
+	log.Printf("Incoming Request to join the waitlist: %+v", req.Msg)
+
+	contact := req.Msg.ContactInformation
+	if contact.GetName() == "" || contact.GetEmail() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("contact_information needs a name and an email"))
+	}
+	if req.Msg.WindowStart == nil || req.Msg.WindowEnd == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("window_start and window_end are required"))
+	}
+	windowStart, windowEnd := req.Msg.WindowStart.AsTime(), req.Msg.WindowEnd.AsTime()
+	if !windowStart.Before(windowEnd) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("window_start must be before window_end"))
+	}
+	if !windowEnd.After(time.Now()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("window_end must be in the future"))
+	}
+
+	user, err := s.Storage.CreateUser(ctx, &pb.User{
+		Id:       uuid.NewString(),
+		Name:     contact.Name,
+		Email:    contact.Email,
+		TimeZone: s.defaultTimeZone(ctx, contact.Email),
+	})
+	if err != nil {
+		log.Printf("Error creating user: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to process user information"))
+	}
+
+	entry, err := s.Storage.JoinWaitlist(ctx, &pb.WaitlistEntry{
+		Id:          uuid.NewString(),
+		UserId:      user.Id,
+		WindowStart: req.Msg.WindowStart,
+		WindowEnd:   req.Msg.WindowEnd,
+		Title:       req.Msg.Title,
+		Description: req.Msg.Description,
+	})
+	if err != nil {
+		return nil, waitlistError(err, "join the waitlist")
+	}
+
+	return connect.NewResponse(entry), nil
+}
+
+func (s *AppointmentServer) GetWaitlistEntry(
+	ctx context.Context,
+	req *connect.Request[pb.GetWaitlistEntryRequest],
+) (*connect.Response[pb.WaitlistEntry], error) {
+	log.Printf("Incoming Request to get waitlist entry: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.Id) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id must be a UUID"))
+	}
+
+	entry, err := s.Storage.GetWaitlistEntry(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, waitlistError(err, "load waitlist entry")
+	}
+
+	return connect.NewResponse(entry), nil
+}
+
+func (s *AppointmentServer) LeaveWaitlist(
+	ctx context.Context,
+	req *connect.Request[pb.LeaveWaitlistRequest],
+) (*connect.Response[pb.WaitlistEntry], error) {
+	log.Printf("Incoming Request to leave the waitlist: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.Id) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id must be a UUID"))
+	}
+
+	entry, err := s.Storage.LeaveWaitlist(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, waitlistError(err, "leave the waitlist")
+	}
+
+	return connect.NewResponse(entry), nil
+}
+
+func (s *AppointmentServer) AcceptWaitlistOffer(
+	ctx context.Context,
+	req *connect.Request[pb.AcceptWaitlistOfferRequest],
+) (*connect.Response[pb.AcceptWaitlistOfferResponse], error) {
+	log.Printf("Incoming Request to accept waitlist offer: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.OfferId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("offer_id must be a UUID"))
+	}
+
+	entry, appt, err := s.Storage.AcceptWaitlistOffer(ctx, req.Msg.OfferId, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrWaitlistEntryNotFound),
+			errors.Is(err, db.ErrWaitlistOfferNotFound),
+			errors.Is(err, db.ErrWaitlistOfferClosed),
+			errors.Is(err, db.ErrWaitlistOfferExpired):
+			return nil, waitlistError(err, "accept waitlist offer")
+		}
+		return nil, bookingError(err)
+	}
+
+	return connect.NewResponse(&pb.AcceptWaitlistOfferResponse{
+		Entry:       entry,
+		Appointment: appt,
+	}), nil
+}
+
+func (s *AppointmentServer) DeclineWaitlistOffer(
+	ctx context.Context,
+	req *connect.Request[pb.DeclineWaitlistOfferRequest],
+) (*connect.Response[pb.WaitlistEntry], error) {
+	log.Printf("Incoming Request to decline waitlist offer: %+v", req.Msg)
+
+	if uuid.Validate(req.Msg.OfferId) != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("offer_id must be a UUID"))
+	}
+
+	entry, err := s.Storage.DeclineWaitlistOffer(ctx, req.Msg.OfferId)
+	if err != nil {
+		return nil, waitlistError(err, "decline waitlist offer")
+	}
+
+	return connect.NewResponse(entry), nil
+}
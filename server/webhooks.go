@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/folucode/appointment-scheduler/internal/db"
+	"github.com/folucode/appointment-scheduler/internal/webhook"
+	pb "github.com/folucode/appointment-scheduler/proto"
+	"github.com/google/uuid"
+)
+
+func validateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+	return nil
+}
+
+func validateEventTypes(eventTypes []string) ([]string, error) {
+	var result []string
+	for _, t := range eventTypes {
+		if !slices.Contains(webhook.EventTypes, t) {
+			return nil, fmt.Errorf("unknown event type %q", t)
+		}
+		if !slices.Contains(result, t) {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+func (s *AdminServer) CreateWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.CreateWebhookRequest],
+) (*connect.Response[pb.CreateWebhookResponse], error) {
+	log.Printf("Incoming Request to create webhook for %s: %v", req.Msg.Url, req.Msg.EventTypes)
+
+	if err := validateWebhookURL(req.Msg.Url); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	eventTypes, err := validateEventTypes(req.Msg.EventTypes)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(eventTypes) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("at least one event type is required"))
+	}
+
+	secret := req.Msg.Secret
+	if secret == "" {
+		var err error
+		if secret, err = newFeedToken(); err != nil {
+			log.Printf("Error generating webhook secret: %v", err)
+			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create webhook"))
+		}
+	}
+
+	w, err := s.Storage.CreateWebhook(ctx, uuid.NewString(), req.Msg.Url, eventTypes, secret)
+	if err != nil {
+		log.Printf("Error creating webhook: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create webhook"))
+	}
+
+	return connect.NewResponse(&pb.CreateWebhookResponse{
+		Webhook: w,
+		Secret:  secret,
+	}), nil
+}
+
+func (s *AdminServer) ListWebhooks(
+	ctx context.Context,
+	req *connect.Request[pb.ListWebhooksRequest],
+) (*connect.Response[pb.ListWebhooksResponse], error) {
+	log.Printf("Incoming Request to list webhooks")
+
+	webhooks, err := s.Storage.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.ListWebhooksResponse{
+		Webhooks: webhooks,
+	}), nil
+}
+
+func (s *AdminServer) UpdateWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.UpdateWebhookRequest],
+) (*connect.Response[pb.Webhook], error) {
+	log.Printf("Incoming Request to update webhook: %+v", req.Msg)
+
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	if req.Msg.Url != "" {
+		if err := validateWebhookURL(req.Msg.Url); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	eventTypes, err := validateEventTypes(req.Msg.EventTypes)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	w, err := s.Storage.UpdateWebhook(ctx, req.Msg.Id, req.Msg.Url, eventTypes, req.Msg.Enabled)
+	if err != nil {
+		if errors.Is(err, db.ErrWebhookNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		log.Printf("Error updating webhook: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update webhook"))
+	}
+
+	return connect.NewResponse(w), nil
+}
+
+func (s *AdminServer) DeleteWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteWebhookRequest],
+) (*connect.Response[pb.DeleteWebhookResponse], error) {
+	log.Printf("Incoming Request to delete webhook: %+v", req.Msg)
+
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	success, err := s.Storage.DeleteWebhook(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.DeleteWebhookResponse{
+		Success: success,
+	}), nil
+}
+
+func (s *AdminServer) ListWebhookDeliveries(
+	ctx context.Context,
+	req *connect.Request[pb.ListWebhookDeliveriesRequest],
+) (*connect.Response[pb.ListWebhookDeliveriesResponse], error) {
+	log.Printf("Incoming Request to list webhook deliveries: %+v", req.Msg)
+
+	if req.Msg.WebhookId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("webhook_id is required"))
+	}
+
+	deliveries, nextPageToken, err := s.Storage.ListWebhookDeliveries(ctx, req.Msg.WebhookId, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		log.Printf("Error listing webhook deliveries: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list webhook deliveries"))
+	}
+
+	return connect.NewResponse(&pb.ListWebhookDeliveriesResponse{
+		Deliveries:    deliveries,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (s *AdminServer) RedeliverWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.RedeliverWebhookRequest],
+) (*connect.Response[pb.WebhookDelivery], error) {
+	log.Printf("Incoming Request to redeliver webhook: %+v", req.Msg)
+
+	if req.Msg.DeliveryId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("delivery_id is required"))
+	}
+
+	delivery, err := s.Storage.RedeliverWebhook(ctx, req.Msg.DeliveryId, uuid.NewString())
+	if err != nil {
+		if errors.Is(err, db.ErrWebhookDeliveryNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		log.Printf("Error redelivering webhook: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to redeliver webhook"))
+	}
+
+	return connect.NewResponse(delivery), nil
+}